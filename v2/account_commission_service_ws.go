@@ -0,0 +1,148 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountCommissionWsApiService queries commission rates of a symbol
+type AccountCommissionWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewAccountCommissionWsApiService init AccountCommissionWsApiService
+func (c *Client) NewAccountCommissionWsApiService() (*AccountCommissionWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountCommissionWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// AccountCommissionWsRequest parameters for 'account.commission' websocket API
+type AccountCommissionWsRequest struct {
+	symbol string
+}
+
+// NewAccountCommissionWsRequest init AccountCommissionWsRequest
+func NewAccountCommissionWsRequest() *AccountCommissionWsRequest {
+	return &AccountCommissionWsRequest{}
+}
+
+func (s *AccountCommissionWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountCommissionWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	return m
+}
+
+// Do - sends 'account.commission' request
+func (s *AccountCommissionWsApiService) Do(requestID string, request *AccountCommissionWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.AccountCommissionSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'account.commission' request and receives response
+func (s *AccountCommissionWsApiService) SyncDo(requestID string, request *AccountCommissionWsRequest) (*AccountCommissionWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.AccountCommissionSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountCommissionWsResponse := &AccountCommissionWsResponse{}
+	if err := json.Unmarshal(response, accountCommissionWsResponse); err != nil {
+		return nil, err
+	}
+
+	return accountCommissionWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountCommissionWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountCommissionWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountCommissionWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountCommissionWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *AccountCommissionWsRequest) Symbol(symbol string) *AccountCommissionWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// AccountCommissionWsResponse define 'account.commission' websocket API response
+type AccountCommissionWsResponse struct {
+	Id     string                  `json:"id"`
+	Status int                     `json:"status"`
+	Result CommissionRatesResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountCommissionServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *AccountCommissionWsApiService
+	request   *AccountCommissionWsRequest
+}
+
+func TestAccountCommissionServiceWs(t *testing.T) {
+	suite.Run(t, new(accountCommissionServiceWsTestSuite))
+}
+
+func (s *accountCommissionServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AccountCommissionWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewAccountCommissionWsRequest().
+		Symbol("BTCUSDT")
+}
+
+func (s *accountCommissionServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestAccountCommission() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"account.commission"`)
+		s.Contains(string(data), `"symbol":"BTCUSDT"`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestAccountCommission_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestAccountCommission_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountCommissionServiceWsTestSuite) TestAccountCommissionSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {
+			"symbol": "BTCUSDT",
+			"standardCommission": {
+				"maker": "0.00000010",
+				"taker": "0.00000020",
+				"buyer": "0.00000030",
+				"seller": "0.00000040"
+			},
+			"taxCommission": {
+				"maker": "0.00000112",
+				"taker": "0.00000114",
+				"buyer": "0.00000118",
+				"seller": "0.00000116"
+			},
+			"discount": {
+				"enabledForAccount": true,
+				"enabledForSymbol": true,
+				"discountAsset": "BNB",
+				"discount": "0.75000000"
+			}
+		}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Equal("BTCUSDT", response.Result.Symbol)
+	s.Equal("0.00000020", response.Result.StandardCommission.Taker)
+	s.True(response.Result.Discount.EnabledForAccount)
+}
@@ -0,0 +1,158 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountRateLimitsOrdersWsApiService queries the unfilled order count of the account
+type AccountRateLimitsOrdersWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewAccountRateLimitsOrdersWsApiService init AccountRateLimitsOrdersWsApiService
+func (c *Client) NewAccountRateLimitsOrdersWsApiService() (*AccountRateLimitsOrdersWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountRateLimitsOrdersWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// AccountRateLimitsOrdersWsRequest parameters for 'account.rateLimits.orders' websocket API
+type AccountRateLimitsOrdersWsRequest struct {
+	recvWindow *uint16
+}
+
+// NewAccountRateLimitsOrdersWsRequest init AccountRateLimitsOrdersWsRequest
+func NewAccountRateLimitsOrdersWsRequest() *AccountRateLimitsOrdersWsRequest {
+	return &AccountRateLimitsOrdersWsRequest{}
+}
+
+func (s *AccountRateLimitsOrdersWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountRateLimitsOrdersWsRequest) buildParams() params {
+	m := params{}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'account.rateLimits.orders' request
+func (s *AccountRateLimitsOrdersWsApiService) Do(requestID string, request *AccountRateLimitsOrdersWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.AccountRateLimitsOrdersSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'account.rateLimits.orders' request and receives response
+func (s *AccountRateLimitsOrdersWsApiService) SyncDo(requestID string, request *AccountRateLimitsOrdersWsRequest) (*AccountRateLimitsOrdersWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.AccountRateLimitsOrdersSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountRateLimitsOrdersWsResponse := &AccountRateLimitsOrdersWsResponse{}
+	if err := json.Unmarshal(response, accountRateLimitsOrdersWsResponse); err != nil {
+		return nil, err
+	}
+
+	return accountRateLimitsOrdersWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountRateLimitsOrdersWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountRateLimitsOrdersWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountRateLimitsOrdersWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountRateLimitsOrdersWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// RecvWindow set recvWindow
+func (s *AccountRateLimitsOrdersWsRequest) RecvWindow(recvWindow uint16) *AccountRateLimitsOrdersWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// OrderRateLimit define the usage of an order rate limit
+type OrderRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+	Count         int64  `json:"count"`
+}
+
+// AccountRateLimitsOrdersWsResponse define 'account.rateLimits.orders' websocket API response
+type AccountRateLimitsOrdersWsResponse struct {
+	Id     string            `json:"id"`
+	Status int               `json:"status"`
+	Result []*OrderRateLimit `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,90 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountRateLimitsOrdersServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *AccountRateLimitsOrdersWsApiService
+	request   *AccountRateLimitsOrdersWsRequest
+}
+
+func TestAccountRateLimitsOrdersServiceWs(t *testing.T) {
+	suite.Run(t, new(accountRateLimitsOrdersServiceWsTestSuite))
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AccountRateLimitsOrdersWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewAccountRateLimitsOrdersWsRequest()
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrders() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"account.rateLimits.orders"`)
+		s.Contains(string(data), `"signature":`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrders_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrders_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountRateLimitsOrdersServiceWsTestSuite) TestAccountRateLimitsOrdersSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": [
+			{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 50, "count": 0},
+			{"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 160000, "count": 0}
+		]
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Require().Len(response.Result, 2)
+	s.Equal("SECOND", response.Result[0].Interval)
+	s.Equal(int64(160000), response.Result[1].Limit)
+}
@@ -0,0 +1,781 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+var (
+	// AccountStateSnapshotRetryInterval is the delay before loading the account snapshot again after a failure
+	AccountStateSnapshotRetryInterval = time.Second
+	// AccountStateMaxBufferedEvents is the maximum number of user data events buffered while loading a snapshot
+	AccountStateMaxBufferedEvents = 10000
+	// AccountStateClosedOrderRetention is how long a closed order is remembered to discard its late events
+	AccountStateClosedOrderRetention = time.Hour
+)
+
+// AccountBalance define a balance of the account state
+type AccountBalance struct {
+	Asset  string
+	Free   string
+	Locked string
+	// UpdateTime is the time of the last event or snapshot which changed the balance
+	UpdateTime int64
+}
+
+// AccountFill define a trade of one of the orders of the account
+type AccountFill struct {
+	Symbol          string
+	OrderID         int64
+	ClientOrderID   string
+	TradeID         int64
+	Side            SideType
+	Price           string
+	Quantity        string
+	QuoteQuantity   string
+	Commission      string
+	CommissionAsset string
+	IsMaker         bool
+	Time            int64
+}
+
+// AccountStateChangeType define the type of an account state change
+type AccountStateChangeType string
+
+const (
+	AccountStateChangeTypeSnapshot  AccountStateChangeType = "SNAPSHOT"
+	AccountStateChangeTypeBalance   AccountStateChangeType = "BALANCE"
+	AccountStateChangeTypeOrder     AccountStateChangeType = "ORDER"
+	AccountStateChangeTypeOrderList AccountStateChangeType = "ORDER_LIST"
+	AccountStateChangeTypeFill      AccountStateChangeType = "FILL"
+)
+
+// AccountStateChange define a change of the account state, the field matching Type is set.
+// An order or an order list which is not open anymore is removed from the state after the change.
+type AccountStateChange struct {
+	Type      AccountStateChangeType
+	Balance   *AccountBalance
+	Order     *Order
+	OrderList *Oco
+	Fill      *AccountFill
+}
+
+// AccountStateHandler handle account state changes
+type AccountStateHandler func(change *AccountStateChange)
+
+// AccountStateServeFunc open the user data stream feeding an AccountState
+type AccountStateServeFunc func(handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error)
+
+// AccountStateDivergence is reported by the reconciliation when the local state differs from
+// the REST API, the local state is then replaced by the REST one
+type AccountStateDivergence struct {
+	// Balances hold the REST balances which differ from the local ones
+	Balances []AccountBalance
+	// MissingOrders are open according to the REST API but were not tracked
+	MissingOrders []*Order
+	// UnknownOrders were tracked as open but are not according to the REST API
+	UnknownOrders []*Order
+}
+
+// Error return the description of the divergence
+func (e *AccountStateDivergence) Error() string {
+	parts := make([]string, 0, 3)
+	if len(e.Balances) > 0 {
+		assets := make([]string, len(e.Balances))
+		for i, b := range e.Balances {
+			assets[i] = b.Asset
+		}
+		parts = append(parts, fmt.Sprintf("balances of %s", strings.Join(assets, ",")))
+	}
+	if len(e.MissingOrders) > 0 {
+		parts = append(parts, fmt.Sprintf("%d missing open order(s)", len(e.MissingOrders)))
+	}
+	if len(e.UnknownOrders) > 0 {
+		parts = append(parts, fmt.Sprintf("%d unknown open order(s)", len(e.UnknownOrders)))
+	}
+	return fmt.Sprintf("account state: diverged from REST: %s", strings.Join(parts, ", "))
+}
+
+type accountOrderKey struct {
+	symbol  string
+	orderID int64
+}
+
+// AccountState maintains the balances, open orders, open order lists and fills of the spot
+// account from a REST snapshot and the user data stream. Stale events are discarded with their
+// event and update times, and the state is reconciled periodically against the REST API.
+type AccountState struct {
+	c                 *Client
+	serve             AccountStateServeFunc
+	reconcileInterval time.Duration
+	maxFills          int
+	errHandler        ErrHandler
+
+	mu          sync.RWMutex
+	synced      int32
+	buffer      []*WsUserDataEvent
+	balances    map[string]*AccountBalance
+	orders      map[accountOrderKey]*Order
+	closed      map[accountOrderKey]int64
+	orderLists  map[int64]*Oco
+	fills       []*AccountFill
+	subscribers map[int]AccountStateHandler
+	nextSubID   int
+	changes     []*AccountStateChange
+	pending     chan struct{}
+
+	// dispatchMu keep the changes in order once mu is released
+	dispatchMu sync.Mutex
+
+	stopOnce sync.Once
+	cancel   context.CancelFunc
+	doneC    chan struct{}
+	stopC    chan struct{}
+}
+
+// NewAccountState init the state of the spot account, call Start to begin syncing
+func (c *Client) NewAccountState() *AccountState {
+	return &AccountState{
+		c:                 c,
+		serve:             c.WsUserDataServeSignature,
+		reconcileInterval: 5 * time.Minute,
+		maxFills:          1000,
+		balances:          make(map[string]*AccountBalance),
+		orders:            make(map[accountOrderKey]*Order),
+		closed:            make(map[accountOrderKey]int64),
+		orderLists:        make(map[int64]*Oco),
+		subscribers:       make(map[int]AccountStateHandler),
+		pending:           make(chan struct{}, 1),
+	}
+}
+
+// Stream set the user data stream feeding the state, WsUserDataServeSignature by default
+func (s *AccountState) Stream(serve AccountStateServeFunc) *AccountState {
+	s.serve = serve
+	return s
+}
+
+// ReconcileInterval set the interval of the reconciliation against the REST API, default 5
+// minutes, it is disabled when interval <= 0
+func (s *AccountState) ReconcileInterval(interval time.Duration) *AccountState {
+	s.reconcileInterval = interval
+	return s
+}
+
+// MaxFills set the number of fills kept in the history, default 1000
+func (s *AccountState) MaxFills(maxFills int) *AccountState {
+	s.maxFills = maxFills
+	return s
+}
+
+// OnError set the handler for stream, snapshot and AccountStateDivergence errors
+func (s *AccountState) OnError(errHandler ErrHandler) *AccountState {
+	s.errHandler = errHandler
+	return s
+}
+
+// Subscribe add a handler called after every change of the state, call unsubscribe to remove it.
+// The handlers are called one at a time, they may read the state.
+func (s *AccountState) Subscribe(handler AccountStateHandler) (unsubscribe func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.nextSubID
+	s.nextSubID++
+	s.subscribers[id] = handler
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers, id)
+	}
+}
+
+// Start open the user data stream and load the first snapshot
+func (s *AccountState) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	doneC, stopC, err := s.serve(s.onEvent, s.onStreamError)
+	if err != nil {
+		cancel()
+		return err
+	}
+	s.cancel = cancel
+	s.doneC = doneC
+	s.stopC = stopC
+	s.Resync()
+	go s.snapshotLoop(ctx)
+	go func() {
+		<-doneC
+		cancel()
+	}()
+	return nil
+}
+
+// Stop close the user data stream, it is safe to call Stop more than once
+func (s *AccountState) Stop() {
+	s.stopOnce.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+		}
+		if s.stopC != nil {
+			close(s.stopC)
+		}
+	})
+}
+
+// Done return a channel closed when the user data stream has terminated
+func (s *AccountState) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// Resync load the state from the REST API again, the events received meanwhile are buffered.
+// Call it when events may have been missed, e.g. from the resync handler of a UserDataStream.
+func (s *AccountState) Resync() {
+	// taken with mu so an event being applied is not dropped from the buffer
+	s.mu.Lock()
+	s.setSynced(false)
+	s.mu.Unlock()
+	select {
+	case s.pending <- struct{}{}:
+	default:
+	}
+}
+
+// IsSynced return true when the state has been loaded from a snapshot and is not resyncing
+func (s *AccountState) IsSynced() bool {
+	return atomic.LoadInt32(&s.synced) == 1
+}
+
+func (s *AccountState) setSynced(synced bool) {
+	var v int32
+	if synced {
+		v = 1
+	}
+	atomic.StoreInt32(&s.synced, v)
+}
+
+// Balance return the balance of asset
+func (s *AccountState) Balance(asset string) (AccountBalance, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.balances[asset]
+	if !ok {
+		return AccountBalance{}, false
+	}
+	return *b, true
+}
+
+// Balances return the balances of all the assets sorted by asset
+func (s *AccountState) Balances() []AccountBalance {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]AccountBalance, 0, len(s.balances))
+	for _, b := range s.balances {
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res
+}
+
+// OpenOrder return the open order of symbol with orderID
+func (s *AccountState) OpenOrder(symbol string, orderID int64) (*Order, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	o, ok := s.orders[accountOrderKey{symbol: symbol, orderID: orderID}]
+	if !ok {
+		return nil, false
+	}
+	order := *o
+	return &order, true
+}
+
+// OpenOrderByClientOrderID return the open order of symbol with clientOrderID
+func (s *AccountState) OpenOrderByClientOrderID(symbol string, clientOrderID string) (*Order, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, o := range s.orders {
+		if o.Symbol == symbol && o.ClientOrderID == clientOrderID {
+			order := *o
+			return &order, true
+		}
+	}
+	return nil, false
+}
+
+// OpenOrders return the open orders of symbol sorted by order id, of all the symbols if symbol is empty
+func (s *AccountState) OpenOrders(symbol string) []*Order {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]*Order, 0, len(s.orders))
+	for _, o := range s.orders {
+		if symbol != "" && o.Symbol != symbol {
+			continue
+		}
+		order := *o
+		res = append(res, &order)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Symbol != res[j].Symbol {
+			return res[i].Symbol < res[j].Symbol
+		}
+		return res[i].OrderID < res[j].OrderID
+	})
+	return res
+}
+
+// OrderList return the open order list with orderListID
+func (s *AccountState) OrderList(orderListID int64) (*Oco, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	l, ok := s.orderLists[orderListID]
+	if !ok {
+		return nil, false
+	}
+	return copyOco(l), true
+}
+
+// OrderLists return the open order lists sorted by order list id
+func (s *AccountState) OrderLists() []*Oco {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]*Oco, 0, len(s.orderLists))
+	for _, l := range s.orderLists {
+		res = append(res, copyOco(l))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].OrderListId < res[j].OrderListId })
+	return res
+}
+
+// Fills return the fills of symbol received since Start, oldest first, of all the symbols if symbol is empty
+func (s *AccountState) Fills(symbol string) []AccountFill {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]AccountFill, 0, len(s.fills))
+	for _, f := range s.fills {
+		if symbol == "" || f.Symbol == symbol {
+			res = append(res, *f)
+		}
+	}
+	return res
+}
+
+func copyOco(l *Oco) *Oco {
+	res := *l
+	res.Orders = make([]*Order, len(l.Orders))
+	for i, o := range l.Orders {
+		order := *o
+		res.Orders[i] = &order
+	}
+	return &res
+}
+
+// onStreamError resync the state when the user data stream reconnects, the events sent
+// while it was disconnected are lost
+func (s *AccountState) onStreamError(err error) {
+	var reconnected *websocket.ReconnectedEvent
+	if errors.As(err, &reconnected) {
+		s.Resync()
+	}
+	s.onError(err)
+}
+
+func (s *AccountState) onError(err error) {
+	if s.errHandler != nil {
+		s.errHandler(err)
+	}
+}
+
+// notify queue a change for the subscribers, must be called with mu held
+func (s *AccountState) notify(change *AccountStateChange) {
+	s.changes = append(s.changes, change)
+}
+
+// unlock release mu and call the subscribers with the queued changes
+func (s *AccountState) unlock() {
+	changes := s.changes
+	s.changes = nil
+	handlers := make([]AccountStateHandler, 0, len(s.subscribers))
+	for _, handler := range s.subscribers {
+		handlers = append(handlers, handler)
+	}
+	s.dispatchMu.Lock()
+	defer s.dispatchMu.Unlock()
+	s.mu.Unlock()
+	for _, change := range changes {
+		for _, handler := range handlers {
+			handler(change)
+		}
+	}
+}
+
+func (s *AccountState) onEvent(event *WsUserDataEvent) {
+	s.mu.Lock()
+	defer s.unlock()
+	if !s.IsSynced() {
+		if len(s.buffer) >= AccountStateMaxBufferedEvents {
+			s.buffer = s.buffer[1:]
+		}
+		s.buffer = append(s.buffer, event)
+		return
+	}
+	s.applyEvent(event)
+}
+
+// applyEvent apply a user data event to the synced state, must be called with mu held
+func (s *AccountState) applyEvent(event *WsUserDataEvent) {
+	switch event.Event {
+	case UserDataEventTypeOutboundAccountPosition:
+		s.applyAccountUpdate(&event.AccountUpdate)
+	case UserDataEventTypeBalanceUpdate:
+		s.applyBalanceUpdate(&event.BalanceUpdate)
+	case UserDataEventTypeExecutionReport:
+		s.applyOrderUpdate(&event.OrderUpdate)
+	case UserDataEventTypeListStatus:
+		s.applyOCOUpdate(&event.OCOUpdate)
+	}
+}
+
+func (s *AccountState) applyAccountUpdate(update *WsAccountUpdateList) {
+	for _, u := range update.WsAccountUpdates {
+		b, ok := s.balances[u.Asset]
+		if ok && b.UpdateTime > update.AccountUpdateTime {
+			continue
+		}
+		if !ok {
+			b = &AccountBalance{Asset: u.Asset}
+			s.balances[u.Asset] = b
+		}
+		b.Free = u.Free
+		b.Locked = u.Locked
+		b.UpdateTime = update.AccountUpdateTime
+		balance := *b
+		s.notify(&AccountStateChange{Type: AccountStateChangeTypeBalance, Balance: &balance})
+	}
+}
+
+// applyBalanceUpdate apply a deposit, withdrawal or transfer, the change is already part of a
+// balance updated at or after its time
+func (s *AccountState) applyBalanceUpdate(update *WsBalanceUpdate) {
+	b, ok := s.balances[update.Asset]
+	if ok && b.UpdateTime >= update.TransactionTime {
+		return
+	}
+	if !ok {
+		b = &AccountBalance{Asset: update.Asset, Free: "0", Locked: "0"}
+		s.balances[update.Asset] = b
+	}
+	free, err := decimal.NewFromString(b.Free)
+	if err != nil {
+		s.onError(err)
+		return
+	}
+	change, err := decimal.NewFromString(update.Change)
+	if err != nil {
+		s.onError(err)
+		return
+	}
+	b.Free = free.Add(change).String()
+	b.UpdateTime = update.TransactionTime
+	balance := *b
+	s.notify(&AccountStateChange{Type: AccountStateChangeTypeBalance, Balance: &balance})
+}
+
+func (s *AccountState) applyOrderUpdate(update *WsOrderUpdate) {
+	key := accountOrderKey{symbol: update.Symbol, orderID: update.Id}
+	if closedTime, ok := s.closed[key]; ok && closedTime >= update.TransactionTime {
+		return
+	}
+	o, ok := s.orders[key]
+	if ok && o.UpdateTime > update.TransactionTime {
+		return
+	}
+	if !ok {
+		o = &Order{
+			Symbol:  update.Symbol,
+			OrderID: update.Id,
+		}
+	}
+	clientOrderID := update.ClientOrderId
+	if update.OrigCustomOrderId != "" {
+		// the client order id of a canceled order is the one of the cancel request
+		clientOrderID = update.OrigCustomOrderId
+	}
+	o.OrderListId = update.OrderListId
+	o.ClientOrderID = clientOrderID
+	o.Price = update.Price
+	o.OrigQuantity = update.Volume
+	o.ExecutedQuantity = update.FilledVolume
+	o.CummulativeQuoteQuantity = update.FilledQuoteVolume
+	o.Status = OrderStatusType(update.Status)
+	o.TimeInForce = update.TimeInForce
+	o.Type = OrderType(update.Type)
+	o.Side = SideType(update.Side)
+	o.StopPrice = update.StopPrice
+	o.IcebergQuantity = update.IceBergVolume
+	o.Time = update.CreateTime
+	o.UpdateTime = update.TransactionTime
+	o.IsWorking = update.IsInOrderBook
+	o.WorkingTime = update.WorkingTime
+	o.OrigQuoteOrderQuantity = update.QuoteVolume
+	o.SelfTradePreventionMode = SelfTradePreventionMode(update.SelfTradePreventionMode)
+
+	if isOpenOrderStatus(o.Status) {
+		s.orders[key] = o
+	} else {
+		delete(s.orders, key)
+		s.closed[key] = o.UpdateTime
+	}
+	order := *o
+	s.notify(&AccountStateChange{Type: AccountStateChangeTypeOrder, Order: &order})
+
+	if update.ExecutionType == "TRADE" {
+		fill := &AccountFill{
+			Symbol:          update.Symbol,
+			OrderID:         update.Id,
+			ClientOrderID:   clientOrderID,
+			TradeID:         update.TradeId,
+			Side:            SideType(update.Side),
+			Price:           update.LatestPrice,
+			Quantity:        update.LatestVolume,
+			QuoteQuantity:   update.LatestQuoteVolume,
+			Commission:      update.FeeCost,
+			CommissionAsset: update.FeeAsset,
+			IsMaker:         update.IsMaker,
+			Time:            update.TransactionTime,
+		}
+		s.fills = append(s.fills, fill)
+		if s.maxFills > 0 && len(s.fills) > s.maxFills {
+			s.fills = s.fills[len(s.fills)-s.maxFills:]
+		}
+		f := *fill
+		s.notify(&AccountStateChange{Type: AccountStateChangeTypeFill, Fill: &f})
+	}
+}
+
+func (s *AccountState) applyOCOUpdate(update *WsOCOUpdate) {
+	l, ok := s.orderLists[update.OrderListId]
+	if ok && l.TransactionTime > update.TransactionTime {
+		return
+	}
+	l = &Oco{
+		Symbol:            update.Symbol,
+		OrderListId:       update.OrderListId,
+		ContingencyType:   update.ContingencyType,
+		ListStatusType:    update.ListStatusType,
+		ListOrderStatus:   update.ListOrderStatus,
+		ListClientOrderID: update.ClientOrderId,
+		TransactionTime:   update.TransactionTime,
+		Orders:            make([]*Order, len(update.Orders.WsOCOOrders)),
+	}
+	for i, o := range update.Orders.WsOCOOrders {
+		l.Orders[i] = &Order{
+			Symbol:        o.Symbol,
+			OrderID:       o.OrderId,
+			ClientOrderID: o.ClientOrderId,
+		}
+	}
+	if l.ListOrderStatus == "ALL_DONE" || l.ListOrderStatus == "REJECT" {
+		delete(s.orderLists, update.OrderListId)
+	} else {
+		s.orderLists[update.OrderListId] = l
+	}
+	s.notify(&AccountStateChange{Type: AccountStateChangeTypeOrderList, OrderList: copyOco(l)})
+}
+
+func isOpenOrderStatus(status OrderStatusType) bool {
+	return status == OrderStatusTypeNew || status == OrderStatusTypePartiallyFilled || status == OrderStatusTypePendingNew
+}
+
+func (s *AccountState) snapshotLoop(ctx context.Context) {
+	var reconcileC <-chan time.Time
+	if s.reconcileInterval > 0 {
+		ticker := time.NewTicker(s.reconcileInterval)
+		defer ticker.Stop()
+		reconcileC = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.pending:
+			for {
+				err := s.loadSnapshot(ctx)
+				if err == nil {
+					break
+				}
+				s.onError(err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(AccountStateSnapshotRetryInterval):
+				}
+			}
+		case <-reconcileC:
+			if !s.IsSynced() {
+				continue
+			}
+			if err := s.reconcile(ctx); err != nil {
+				s.onError(err)
+			}
+		}
+	}
+}
+
+type accountSnapshot struct {
+	requestTime int64
+	account     *Account
+	orders      []*Order
+	orderLists  []*Oco
+}
+
+// fetchSnapshot load the account, the open orders and the open order lists from the REST API
+func (s *AccountState) fetchSnapshot(ctx context.Context) (*accountSnapshot, error) {
+	snapshot := &accountSnapshot{requestTime: currentTimestamp() - s.c.timeOffset()}
+	var err error
+	snapshot.account, err = s.c.NewGetAccountService().OmitZeroBalances(true).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.orders, err = s.c.NewListOpenOrdersService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.orderLists, err = s.c.NewListOpenOcoService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// loadSnapshot replace the state with a REST snapshot and replay the buffered events on top of it
+func (s *AccountState) loadSnapshot(ctx context.Context) error {
+	snapshot, err := s.fetchSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.unlock()
+	if s.IsSynced() {
+		return nil
+	}
+	updateTime := int64(snapshot.account.UpdateTime)
+	s.balances = make(map[string]*AccountBalance, len(snapshot.account.Balances))
+	for _, b := range snapshot.account.Balances {
+		s.balances[b.Asset] = &AccountBalance{Asset: b.Asset, Free: b.Free, Locked: b.Locked, UpdateTime: updateTime}
+	}
+	s.orders = make(map[accountOrderKey]*Order, len(snapshot.orders))
+	for _, o := range snapshot.orders {
+		s.orders[accountOrderKey{symbol: o.Symbol, orderID: o.OrderID}] = o
+	}
+	s.orderLists = make(map[int64]*Oco, len(snapshot.orderLists))
+	for _, l := range snapshot.orderLists {
+		s.orderLists[l.OrderListId] = l
+	}
+	s.pruneClosed()
+	s.setSynced(true)
+	s.notify(&AccountStateChange{Type: AccountStateChangeTypeSnapshot})
+
+	buffer := s.buffer
+	s.buffer = nil
+	for _, event := range buffer {
+		s.applyEvent(event)
+	}
+	return nil
+}
+
+// reconcile compare the state with a REST snapshot, the entries changed by events received
+// after the snapshot was requested are skipped
+func (s *AccountState) reconcile(ctx context.Context) error {
+	snapshot, err := s.fetchSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.unlock()
+	if !s.IsSynced() {
+		return nil
+	}
+	divergence := &AccountStateDivergence{}
+	updateTime := int64(snapshot.account.UpdateTime)
+	restBalances := make(map[string]bool, len(snapshot.account.Balances))
+	for _, b := range snapshot.account.Balances {
+		restBalances[b.Asset] = true
+		local, ok := s.balances[b.Asset]
+		if ok && local.UpdateTime >= snapshot.requestTime {
+			continue
+		}
+		if ok && decimalEqual(local.Free, b.Free) && decimalEqual(local.Locked, b.Locked) {
+			continue
+		}
+		balance := &AccountBalance{Asset: b.Asset, Free: b.Free, Locked: b.Locked, UpdateTime: updateTime}
+		s.balances[b.Asset] = balance
+		divergence.Balances = append(divergence.Balances, *balance)
+	}
+	for asset, local := range s.balances {
+		if restBalances[asset] || local.UpdateTime >= snapshot.requestTime {
+			continue
+		}
+		// zero balances are omitted by the REST API
+		if decimalEqual(local.Free, "0") && decimalEqual(local.Locked, "0") {
+			continue
+		}
+		delete(s.balances, asset)
+		divergence.Balances = append(divergence.Balances, AccountBalance{Asset: asset, Free: "0", Locked: "0", UpdateTime: updateTime})
+	}
+
+	restOrders := make(map[accountOrderKey]bool, len(snapshot.orders))
+	for _, o := range snapshot.orders {
+		key := accountOrderKey{symbol: o.Symbol, orderID: o.OrderID}
+		restOrders[key] = true
+		if _, ok := s.orders[key]; ok {
+			continue
+		}
+		if closedTime, ok := s.closed[key]; ok && closedTime >= o.UpdateTime {
+			continue
+		}
+		s.orders[key] = o
+		divergence.MissingOrders = append(divergence.MissingOrders, o)
+	}
+	for key, o := range s.orders {
+		if restOrders[key] || o.UpdateTime >= snapshot.requestTime {
+			continue
+		}
+		delete(s.orders, key)
+		divergence.UnknownOrders = append(divergence.UnknownOrders, o)
+	}
+	s.pruneClosed()
+
+	if len(divergence.Balances) == 0 && len(divergence.MissingOrders) == 0 && len(divergence.UnknownOrders) == 0 {
+		return nil
+	}
+	sort.Slice(divergence.Balances, func(i, j int) bool { return divergence.Balances[i].Asset < divergence.Balances[j].Asset })
+	s.notify(&AccountStateChange{Type: AccountStateChangeTypeSnapshot})
+	return divergence
+}
+
+// pruneClosed forget the orders closed for longer than AccountStateClosedOrderRetention, must be called with mu held
+func (s *AccountState) pruneClosed() {
+	limit := currentTimestamp() - AccountStateClosedOrderRetention.Milliseconds()
+	for key, closedTime := range s.closed {
+		if closedTime < limit {
+			delete(s.closed, key)
+		}
+	}
+}
+
+func decimalEqual(a, b string) bool {
+	da, errA := decimal.NewFromString(a)
+	db, errB := decimal.NewFromString(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return da.Equal(db)
+}
@@ -0,0 +1,249 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type accountStateTestSuite struct {
+	baseTestSuite
+	handler WsUserDataHandler
+}
+
+func TestAccountState(t *testing.T) {
+	suite.Run(t, new(accountStateTestSuite))
+}
+
+func (s *accountStateTestSuite) mockSnapshot(account, orders, orderLists string) {
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(account), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(orders), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(orderLists), http.StatusOK), nil).Once()
+}
+
+func (s *accountStateTestSuite) serve(handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	s.handler = handler
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		<-stopC
+		close(doneC)
+	}()
+	return doneC, stopC, nil
+}
+
+func (s *accountStateTestSuite) startState() *AccountState {
+	state := s.client.NewAccountState().Stream(s.serve).ReconcileInterval(0)
+	s.r().NoError(state.Start())
+	return state
+}
+
+func (s *accountStateTestSuite) waitSynced(state *AccountState) {
+	s.r().Eventually(state.IsSynced, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *accountStateTestSuite) TestBootstrap() {
+	s.mockSnapshot(
+		`{"updateTime":1000,"balances":[{"asset":"BTC","free":"1.00000000","locked":"0.50000000"}]}`,
+		`[{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"order1","price":"30000.00","origQty":"0.50000000","executedQty":"0.00000000","status":"NEW","side":"SELL","type":"LIMIT","updateTime":900}]`,
+		`[{"symbol":"BTCUSDT","orderListId":7,"contingencyType":"OCO","listStatusType":"EXEC_STARTED","listOrderStatus":"EXECUTING","listClientOrderId":"list7","transactionTime":900,"orders":[{"symbol":"BTCUSDT","orderId":2,"clientOrderId":"order2"}]}]`,
+	)
+	state := s.startState()
+	defer state.Stop()
+
+	// buffered before the snapshot: the first update is older than the account snapshot
+	s.handler(&WsUserDataEvent{
+		Event: UserDataEventTypeOutboundAccountPosition,
+		AccountUpdate: WsAccountUpdateList{
+			AccountUpdateTime: 999,
+			WsAccountUpdates:  []WsAccountUpdate{{Asset: "BTC", Free: "2.00000000", Locked: "0.00000000"}},
+		},
+	})
+	s.handler(&WsUserDataEvent{
+		Event: UserDataEventTypeOutboundAccountPosition,
+		AccountUpdate: WsAccountUpdateList{
+			AccountUpdateTime: 1001,
+			WsAccountUpdates:  []WsAccountUpdate{{Asset: "USDT", Free: "100.00000000", Locked: "0.00000000"}},
+		},
+	})
+	s.waitSynced(state)
+
+	btc, ok := state.Balance("BTC")
+	s.r().True(ok)
+	s.r().Equal("1.00000000", btc.Free)
+	s.r().Equal("0.50000000", btc.Locked)
+	usdt, ok := state.Balance("USDT")
+	s.r().True(ok)
+	s.r().Equal("100.00000000", usdt.Free)
+	s.r().Len(state.Balances(), 2)
+
+	order, ok := state.OpenOrderByClientOrderID("BTCUSDT", "order1")
+	s.r().True(ok)
+	s.r().Equal(int64(1), order.OrderID)
+	orderList, ok := state.OrderList(7)
+	s.r().True(ok)
+	s.r().Equal("list7", orderList.ListClientOrderID)
+	s.r().Len(orderList.Orders, 1)
+}
+
+func (s *accountStateTestSuite) TestOrderEvents() {
+	s.mockSnapshot(`{"updateTime":1000,"balances":[]}`, `[]`, `[]`)
+	state := s.startState()
+	defer state.Stop()
+	s.waitSynced(state)
+
+	var changes []AccountStateChangeType
+	unsubscribe := state.Subscribe(func(change *AccountStateChange) {
+		// the state can be read from the handler
+		state.OpenOrders("")
+		changes = append(changes, change.Type)
+	})
+
+	orderUpdate := func(executionType, status, latestVolume, filledVolume string, tradeID, transactionTime int64) *WsUserDataEvent {
+		return &WsUserDataEvent{
+			Event: UserDataEventTypeExecutionReport,
+			OrderUpdate: WsOrderUpdate{
+				Symbol:          "BTCUSDT",
+				Id:              3,
+				ClientOrderId:   "order3",
+				Side:            "BUY",
+				Type:            "LIMIT",
+				Volume:          "1.00000000",
+				Price:           "29000.00",
+				ExecutionType:   executionType,
+				Status:          status,
+				LatestVolume:    latestVolume,
+				LatestPrice:     "29000.00",
+				FilledVolume:    filledVolume,
+				TradeId:         tradeID,
+				TransactionTime: transactionTime,
+				IsInOrderBook:   true,
+			},
+		}
+	}
+	s.handler(orderUpdate("NEW", "NEW", "0.00000000", "0.00000000", -1, 2000))
+	s.handler(orderUpdate("TRADE", "PARTIALLY_FILLED", "0.40000000", "0.40000000", 11, 2001))
+	order, ok := state.OpenOrder("BTCUSDT", 3)
+	s.r().True(ok)
+	s.r().Equal(OrderStatusTypePartiallyFilled, order.Status)
+	s.r().Equal("0.40000000", order.ExecutedQuantity)
+
+	// stale event
+	s.handler(orderUpdate("NEW", "NEW", "0.00000000", "0.00000000", -1, 2000))
+	order, _ = state.OpenOrder("BTCUSDT", 3)
+	s.r().Equal(OrderStatusTypePartiallyFilled, order.Status)
+
+	s.handler(orderUpdate("TRADE", "FILLED", "0.60000000", "1.00000000", 12, 2002))
+	_, ok = state.OpenOrder("BTCUSDT", 3)
+	s.r().False(ok)
+	s.r().Empty(state.OpenOrders("BTCUSDT"))
+
+	// late event of a closed order
+	s.handler(orderUpdate("TRADE", "PARTIALLY_FILLED", "0.40000000", "0.40000000", 11, 2001))
+	s.r().Empty(state.OpenOrders(""))
+
+	fills := state.Fills("BTCUSDT")
+	s.r().Len(fills, 2)
+	s.r().Equal(int64(11), fills[0].TradeID)
+	s.r().Equal("0.60000000", fills[1].Quantity)
+
+	unsubscribe()
+	s.handler(orderUpdate("NEW", "NEW", "0.00000000", "0.00000000", -1, 2003))
+	s.r().Equal([]AccountStateChangeType{
+		AccountStateChangeTypeOrder,
+		AccountStateChangeTypeOrder,
+		AccountStateChangeTypeFill,
+		AccountStateChangeTypeOrder,
+		AccountStateChangeTypeFill,
+	}, changes)
+}
+
+func (s *accountStateTestSuite) TestBalanceUpdate() {
+	s.mockSnapshot(`{"updateTime":1000,"balances":[{"asset":"BTC","free":"1.00000000","locked":"0.00000000"}]}`, `[]`, `[]`)
+	state := s.startState()
+	defer state.Stop()
+	s.waitSynced(state)
+
+	s.handler(&WsUserDataEvent{
+		Event:         UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: WsBalanceUpdate{Asset: "BTC", Change: "0.25", TransactionTime: 1000},
+	})
+	s.handler(&WsUserDataEvent{
+		Event:         UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: WsBalanceUpdate{Asset: "BTC", Change: "-0.5", TransactionTime: 2000},
+	})
+	btc, _ := state.Balance("BTC")
+	s.r().Equal("0.5", btc.Free)
+}
+
+func (s *accountStateTestSuite) TestOrderListEvents() {
+	s.mockSnapshot(`{"updateTime":1000,"balances":[]}`, `[]`, `[]`)
+	state := s.startState()
+	defer state.Stop()
+	s.waitSynced(state)
+
+	ocoUpdate := func(listOrderStatus string, transactionTime int64) *WsUserDataEvent {
+		return &WsUserDataEvent{
+			Event: UserDataEventTypeListStatus,
+			OCOUpdate: WsOCOUpdate{
+				Symbol:          "BTCUSDT",
+				OrderListId:     8,
+				ContingencyType: "OCO",
+				ListOrderStatus: listOrderStatus,
+				ClientOrderId:   "list8",
+				TransactionTime: transactionTime,
+				Orders: WsOCOOrderList{WsOCOOrders: []WsOCOOrder{
+					{Symbol: "BTCUSDT", OrderId: 4, ClientOrderId: "order4"},
+					{Symbol: "BTCUSDT", OrderId: 5, ClientOrderId: "order5"},
+				}},
+			},
+		}
+	}
+	s.handler(ocoUpdate("EXECUTING", 2000))
+	s.r().Len(state.OrderLists(), 1)
+	s.handler(ocoUpdate("ALL_DONE", 2001))
+	s.r().Empty(state.OrderLists())
+}
+
+func (s *accountStateTestSuite) TestReconcile() {
+	s.mockSnapshot(
+		`{"updateTime":1000,"balances":[{"asset":"BTC","free":"1.00000000","locked":"0.00000000"}]}`,
+		`[{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"order1","status":"NEW","updateTime":900}]`,
+		`[]`,
+	)
+	state := s.startState()
+	defer state.Stop()
+	s.waitSynced(state)
+
+	s.mockSnapshot(
+		`{"updateTime":3000,"balances":[{"asset":"BTC","free":"1.5","locked":"0"}]}`,
+		`[{"symbol":"BTCUSDT","orderId":2,"clientOrderId":"order2","status":"NEW","updateTime":2500}]`,
+		`[]`,
+	)
+	err := state.reconcile(context.Background())
+	var divergence *AccountStateDivergence
+	s.r().ErrorAs(err, &divergence)
+	s.r().Len(divergence.Balances, 1)
+	s.r().Equal("1.5", divergence.Balances[0].Free)
+	s.r().Len(divergence.MissingOrders, 1)
+	s.r().Equal(int64(2), divergence.MissingOrders[0].OrderID)
+	s.r().Len(divergence.UnknownOrders, 1)
+	s.r().Equal(int64(1), divergence.UnknownOrders[0].OrderID)
+
+	btc, _ := state.Balance("BTC")
+	s.r().Equal("1.5", btc.Free)
+	orders := state.OpenOrders("")
+	s.r().Len(orders, 1)
+	s.r().Equal(int64(2), orders[0].OrderID)
+
+	s.mockSnapshot(
+		`{"updateTime":3000,"balances":[{"asset":"BTC","free":"1.50000000","locked":"0.00000000"}]}`,
+		`[{"symbol":"BTCUSDT","orderId":2,"clientOrderId":"order2","status":"NEW","updateTime":2500}]`,
+		`[]`,
+	)
+	s.r().NoError(state.reconcile(context.Background()))
+}
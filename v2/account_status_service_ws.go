@@ -0,0 +1,159 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountStatusWsApiService queries account information
+type AccountStatusWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewAccountStatusWsApiService init AccountStatusWsApiService
+func (c *Client) NewAccountStatusWsApiService() (*AccountStatusWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountStatusWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// AccountStatusWsRequest parameters for 'account.status' websocket API
+type AccountStatusWsRequest struct {
+	omitZeroBalances *bool
+	recvWindow       *uint16
+}
+
+// NewAccountStatusWsRequest init AccountStatusWsRequest
+func NewAccountStatusWsRequest() *AccountStatusWsRequest {
+	return &AccountStatusWsRequest{}
+}
+
+func (s *AccountStatusWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AccountStatusWsRequest) buildParams() params {
+	m := params{}
+	if s.omitZeroBalances != nil {
+		m["omitZeroBalances"] = *s.omitZeroBalances
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'account.status' request
+func (s *AccountStatusWsApiService) Do(requestID string, request *AccountStatusWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.AccountStatusSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'account.status' request and receives response
+func (s *AccountStatusWsApiService) SyncDo(requestID string, request *AccountStatusWsRequest) (*AccountStatusWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.AccountStatusSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountStatusWsResponse := &AccountStatusWsResponse{}
+	if err := json.Unmarshal(response, accountStatusWsResponse); err != nil {
+		return nil, err
+	}
+
+	return accountStatusWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AccountStatusWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AccountStatusWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AccountStatusWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AccountStatusWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// OmitZeroBalances set omitZeroBalances
+func (s *AccountStatusWsRequest) OmitZeroBalances(omitZeroBalances bool) *AccountStatusWsRequest {
+	s.omitZeroBalances = &omitZeroBalances
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *AccountStatusWsRequest) RecvWindow(recvWindow uint16) *AccountStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AccountStatusWsResponse define 'account.status' websocket API response
+type AccountStatusWsResponse struct {
+	Id     string  `json:"id"`
+	Status int     `json:"status"`
+	Result Account `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
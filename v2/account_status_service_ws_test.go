@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountStatusServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *AccountStatusWsApiService
+	request   *AccountStatusWsRequest
+}
+
+func TestAccountStatusServiceWs(t *testing.T) {
+	suite.Run(t, new(accountStatusServiceWsTestSuite))
+}
+
+func (s *accountStatusServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AccountStatusWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewAccountStatusWsRequest().
+		OmitZeroBalances(true)
+}
+
+func (s *accountStatusServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatus() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"account.status"`)
+		s.Contains(string(data), `"omitZeroBalances":true`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatus_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatus_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *accountStatusServiceWsTestSuite) TestAccountStatusSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {
+			"makerCommission": 15,
+			"takerCommission": 15,
+			"buyerCommission": 0,
+			"sellerCommission": 0,
+			"canTrade": true,
+			"canWithdraw": true,
+			"canDeposit": true,
+			"commissionRates": {
+				"maker": "0.00150000",
+				"taker": "0.00150000",
+				"buyer": "0.00000000",
+				"seller": "0.00000000"
+			},
+			"updateTime": 1660801833000,
+			"accountType": "SPOT",
+			"balances": [
+				{"asset": "BNB", "free": "0.00000000", "locked": "0.00000000"},
+				{"asset": "BTC", "free": "1.31500000", "locked": "0.00847000"}
+			],
+			"permissions": ["SPOT"],
+			"uid": 354937868
+		}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.True(response.Result.CanTrade)
+	s.Equal("0.00150000", response.Result.CommissionRates.Maker)
+	s.Require().Len(response.Result.Balances, 2)
+	s.Equal("BTC", response.Result.Balances[1].Asset)
+}
@@ -16,6 +16,8 @@ type AlgoOrderCancelWsService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewAlgoOrderCancelWsService init AlgoOrderCancelWsService
@@ -31,10 +33,12 @@ func (c *Client) NewAlgoOrderCancelWsApiService() (*AlgoOrderCancelWsService, er
 	}
 
 	return &AlgoOrderCancelWsService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -110,12 +114,13 @@ func (s *AlgoOrderCancelWsService) Do(requestID string, request *AlgoOrderCancel
 	// Use custom method "algoOrder.cancel"
 	method := websocket.WsApiMethodType("algoOrder.cancel")
 
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		method,
@@ -137,22 +142,20 @@ func (s *AlgoOrderCancelWsService) SyncDo(requestID string, request *AlgoOrderCa
 	// Use custom method "algoOrder.cancel"
 	method := websocket.WsApiMethodType("algoOrder.cancel")
 
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		method,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			method,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +16,8 @@ type AlgoOrderPlaceWsService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewAlgoOrderPlaceWsService init AlgoOrderPlaceWsService
@@ -31,10 +33,12 @@ func (c *Client) NewAlgoOrderPlaceWsApiService() (*AlgoOrderPlaceWsService, erro
 	}
 
 	return &AlgoOrderPlaceWsService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -212,22 +216,20 @@ func (s *AlgoOrderPlaceWsService) SyncDo(requestID string, request *AlgoOrderPla
 	// Use custom method "algoOrder.place"
 	method := websocket.WsApiMethodType("algoOrder.place")
 
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		method,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			method,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,198 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AllOrdersWsApiService queries all orders of a symbol; active, canceled, or filled
+type AllOrdersWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewAllOrdersWsApiService init AllOrdersWsApiService
+func (c *Client) NewAllOrdersWsApiService() (*AllOrdersWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllOrdersWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// AllOrdersWsRequest parameters for 'allOrders' websocket API
+type AllOrdersWsRequest struct {
+	symbol     string
+	orderID    *int64
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+	recvWindow *uint16
+}
+
+// NewAllOrdersWsRequest init AllOrdersWsRequest
+func NewAllOrdersWsRequest() *AllOrdersWsRequest {
+	return &AllOrdersWsRequest{}
+}
+
+func (s *AllOrdersWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *AllOrdersWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'allOrders' request
+func (s *AllOrdersWsApiService) Do(requestID string, request *AllOrdersWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.AllOrdersSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'allOrders' request and receives response
+func (s *AllOrdersWsApiService) SyncDo(requestID string, request *AllOrdersWsRequest) (*AllOrdersWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.AllOrdersSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allOrdersWsResponse := &AllOrdersWsResponse{}
+	if err := json.Unmarshal(response, allOrdersWsResponse); err != nil {
+		return nil, err
+	}
+
+	return allOrdersWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *AllOrdersWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *AllOrdersWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *AllOrdersWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *AllOrdersWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *AllOrdersWsRequest) Symbol(symbol string) *AllOrdersWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *AllOrdersWsRequest) OrderID(orderID int64) *AllOrdersWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *AllOrdersWsRequest) StartTime(startTime int64) *AllOrdersWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *AllOrdersWsRequest) EndTime(endTime int64) *AllOrdersWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *AllOrdersWsRequest) Limit(limit int) *AllOrdersWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *AllOrdersWsRequest) RecvWindow(recvWindow uint16) *AllOrdersWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AllOrdersWsResponse define 'allOrders' websocket API response
+type AllOrdersWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Order `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
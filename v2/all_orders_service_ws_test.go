@@ -0,0 +1,106 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type allOrdersServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *AllOrdersWsApiService
+	request   *AllOrdersWsRequest
+}
+
+func TestAllOrdersServiceWs(t *testing.T) {
+	suite.Run(t, new(allOrdersServiceWsTestSuite))
+}
+
+func (s *allOrdersServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &AllOrdersWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewAllOrdersWsRequest().
+		Symbol("BTCUSDT").
+		StartTime(1660780800000).
+		Limit(5)
+}
+
+func (s *allOrdersServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrders() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"allOrders"`)
+		s.Contains(string(data), `"startTime":1660780800000`)
+		s.Contains(string(data), `"limit":5`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrders_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrders_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *allOrdersServiceWsTestSuite) TestAllOrdersSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": [
+			{
+				"symbol": "BTCUSDT",
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"clientOrderId": "4d96324ff9d44481926157",
+				"price": "23416.10000000",
+				"origQty": "0.00847000",
+				"executedQty": "0.00847000",
+				"status": "FILLED",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL",
+				"time": 1660801715639
+			}
+		]
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Require().Len(response.Result, 1)
+	s.Equal(OrderStatusTypeFilled, response.Result[0].Status)
+	s.Equal(int64(1660801715639), response.Result[0].Time)
+}
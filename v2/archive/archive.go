@@ -0,0 +1,243 @@
+// Package archive reads the public bulk data archives of https://data.binance.vision downloaded
+// to a local directory, e.g. spot/monthly/klines/BTCUSDT/1m/BTCUSDT-1m-2024-01.zip, into the
+// types of the clients, so historical and live data share the same structs.
+//
+// The ZIP archives and the CSV files they contain are read with or without their header row,
+// and the microsecond timestamps of the recent spot archives are converted to milliseconds. An
+// archive is verified against its .CHECKSUM file when one is present next to it.
+//
+//	r, err := archive.OpenDir(archive.SpotKlines, "data/spot/monthly/klines/BTCUSDT/1m")
+//	if err != nil {
+//		return err
+//	}
+//	defer r.Close()
+//	for r.Next() {
+//		kline := r.Row()
+//	}
+//	err = r.Err()
+package archive
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Dataset define the CSV format of the archives of a data type, see SpotKlines and the other
+// datasets
+type Dataset[T any] struct {
+	// Name is the name of the data type in the archive paths, e.g. klines or aggTrades
+	Name string
+	// header is the name of the first column in the header row
+	header string
+	// columns is the minimum number of columns of a row
+	columns int
+	// parse convert a row of the archive of symbol
+	parse func(symbol string, record []string) (T, error)
+}
+
+// Reader read the rows of a list of archives in order, it is not safe for concurrent use
+type Reader[T any] struct {
+	dataset Dataset[T]
+	paths   []string
+
+	zip     *zip.ReadCloser
+	entries []*zip.File
+	file    io.Closer
+	csv     *csv.Reader
+	name    string
+	symbol  string
+	line    int
+
+	row T
+	err error
+}
+
+// Open init a Reader on an archive, either a ZIP file or an extracted CSV file
+func Open[T any](dataset Dataset[T], path string) (*Reader[T], error) {
+	return OpenFiles(dataset, []string{path})
+}
+
+// OpenFiles init a Reader on archives read in the given order, the ZIP archives having a
+// .CHECKSUM file are verified first
+func OpenFiles[T any](dataset Dataset[T], paths []string) (*Reader[T], error) {
+	for _, path := range paths {
+		if strings.HasSuffix(path, ".zip") {
+			if err := verifyIfPresent(path); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &Reader[T]{dataset: dataset, paths: paths}, nil
+}
+
+// OpenDir init a Reader on the ZIP and CSV archives found in dir and its subdirectories, read in
+// the order of their file names, which is the chronological order of the archives of a symbol
+func OpenDir[T any](dataset Dataset[T], dir string) (*Reader[T], error) {
+	paths, err := ListDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	return OpenFiles(dataset, paths)
+}
+
+// ListDir return the ZIP and CSV archives found in dir and its subdirectories, sorted by file name
+func ListDir(dir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && (strings.HasSuffix(path, ".zip") || strings.HasSuffix(path, ".csv")) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(paths, func(i, j int) bool {
+		bi, bj := filepath.Base(paths[i]), filepath.Base(paths[j])
+		if bi != bj {
+			return bi < bj
+		}
+		return paths[i] < paths[j]
+	})
+	return paths, nil
+}
+
+// symbolOf return the symbol of an archive from its file name, e.g. BTCUSDT-1m-2024-01.zip
+func symbolOf(path string) string {
+	name := filepath.Base(path)
+	if i := strings.Index(name, "-"); i > 0 {
+		return name[:i]
+	}
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// Next advance to the next row, false at the end of the archives or on error
+func (r *Reader[T]) Next() bool {
+	for r.err == nil {
+		if r.csv == nil {
+			if !r.nextFile() {
+				return false
+			}
+			continue
+		}
+		record, err := r.csv.Read()
+		if err == io.EOF {
+			r.closeFile()
+			continue
+		}
+		r.line++
+		if err != nil {
+			r.err = fmt.Errorf("archive: %s: %w", r.name, err)
+			return false
+		}
+		if r.line == 1 && strings.EqualFold(strings.TrimPrefix(record[0], "\ufeff"), r.dataset.header) {
+			continue
+		}
+		if len(record) < r.dataset.columns {
+			r.err = fmt.Errorf("archive: %s line %d: %d columns, expected %d", r.name, r.line, len(record), r.dataset.columns)
+			return false
+		}
+		row, err := r.dataset.parse(r.symbol, record)
+		if err != nil {
+			r.err = fmt.Errorf("archive: %s line %d: %w", r.name, r.line, err)
+			return false
+		}
+		r.row = row
+		return true
+	}
+	return false
+}
+
+// nextFile open the next CSV file, from the current ZIP archive or the next path
+func (r *Reader[T]) nextFile() bool {
+	for {
+		if len(r.entries) > 0 {
+			entry := r.entries[0]
+			r.entries = r.entries[1:]
+			rc, err := entry.Open()
+			if err != nil {
+				r.err = fmt.Errorf("archive: %s: %w", entry.Name, err)
+				return false
+			}
+			r.startCSV(rc, entry.Name)
+			return true
+		}
+		if r.zip != nil {
+			r.zip.Close()
+			r.zip = nil
+		}
+		if len(r.paths) == 0 {
+			return false
+		}
+		path := r.paths[0]
+		r.paths = r.paths[1:]
+		r.symbol = symbolOf(path)
+		if !strings.HasSuffix(path, ".zip") {
+			f, err := os.Open(path)
+			if err != nil {
+				r.err = err
+				return false
+			}
+			r.startCSV(f, path)
+			return true
+		}
+		zr, err := zip.OpenReader(path)
+		if err != nil {
+			r.err = fmt.Errorf("archive: %s: %w", path, err)
+			return false
+		}
+		r.zip = zr
+		r.entries = r.entries[:0]
+		for _, f := range zr.File {
+			if strings.HasSuffix(f.Name, ".csv") {
+				r.entries = append(r.entries, f)
+			}
+		}
+	}
+}
+
+func (r *Reader[T]) startCSV(rc io.ReadCloser, name string) {
+	r.file, r.name, r.line = rc, name, 0
+	r.csv = csv.NewReader(rc)
+	r.csv.FieldsPerRecord = -1
+	r.csv.ReuseRecord = true
+}
+
+func (r *Reader[T]) closeFile() {
+	if r.file != nil {
+		r.file.Close()
+	}
+	r.file, r.csv = nil, nil
+}
+
+// Row return the current row
+func (r *Reader[T]) Row() T {
+	return r.row
+}
+
+// Err return the error which ended the reading, nil at the end of the archives
+func (r *Reader[T]) Err() error {
+	return r.err
+}
+
+// Close close the archive being read
+func (r *Reader[T]) Close() error {
+	r.closeFile()
+	r.entries, r.paths = nil, nil
+	if r.zip != nil {
+		err := r.zip.Close()
+		r.zip = nil
+		return err
+	}
+	return nil
+}
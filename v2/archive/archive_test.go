@@ -0,0 +1,168 @@
+package archive
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeArchive write a ZIP archive holding the CSV file of the same name, with its checksum file
+func writeArchive(t *testing.T, dir, name, content string, checksum bool) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	w, err := zw.Create(strings.TrimSuffix(filepath.Base(name), ".zip") + ".csv")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+	if checksum {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		sum := sha256.Sum256(data)
+		line := hex.EncodeToString(sum[:]) + "  " + filepath.Base(name) + "\n"
+		require.NoError(t, os.WriteFile(path+".CHECKSUM", []byte(line), 0o644))
+	}
+	return path
+}
+
+func readAll[T any](r *Reader[T], err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var rows []T
+	for r.Next() {
+		rows = append(rows, r.Row())
+	}
+	return rows, r.Err()
+}
+
+func TestSpotKlines(t *testing.T) {
+	dir := t.TempDir()
+	// the archives of 2025 have microsecond timestamps
+	writeArchive(t, dir, "BTCUSDT-1m-2025-01.zip",
+		"1735689600000000,93576.00,93610.93,93537.50,93610.93,8.21827,1735689659999999,768978.35,1495,4.51832,422790.42,0\n", true)
+	writeArchive(t, dir, "BTCUSDT-1m-2024-12.zip",
+		"1735689540000,93500.00,93580.00,93490.00,93576.00,5.1,1735689599999,477000.1,1000,2.5,233000.5,0\n", true)
+
+	klines, err := readAll(OpenDir(SpotKlines, dir))
+	require.NoError(t, err)
+	require.Len(t, klines, 2)
+	assert.Equal(t, int64(1735689540000), klines[0].OpenTime)
+	assert.Equal(t, &binance.Kline{
+		OpenTime:                 1735689600000,
+		Open:                     "93576.00",
+		High:                     "93610.93",
+		Low:                      "93537.50",
+		Close:                    "93610.93",
+		Volume:                   "8.21827",
+		CloseTime:                1735689659999,
+		QuoteAssetVolume:         "768978.35",
+		TradeNum:                 1495,
+		TakerBuyBaseAssetVolume:  "4.51832",
+		TakerBuyQuoteAssetVolume: "422790.42",
+	}, klines[1])
+}
+
+func TestFuturesHeaderVariants(t *testing.T) {
+	dir := t.TempDir()
+	path := writeArchive(t, dir, "um/BTCUSDT-aggTrades-2024-01-01.zip",
+		"agg_trade_id,price,quantity,first_trade_id,last_trade_id,transact_time,is_buyer_maker\n"+
+			"1,42000.1,0.5,10,12,1704067200000,true\n"+
+			"2,42000.2,0.1,13,13,1704067200001,false\n", false)
+	trades, err := readAll(Open(FuturesAggTrades, path))
+	require.NoError(t, err)
+	assert.Equal(t, []*futures.AggTrade{
+		{AggTradeID: 1, Price: "42000.1", Quantity: "0.5", FirstTradeID: 10, LastTradeID: 12, Timestamp: 1704067200000, IsBuyerMaker: true},
+		{AggTradeID: 2, Price: "42000.2", Quantity: "0.1", FirstTradeID: 13, LastTradeID: 13, Timestamp: 1704067200001},
+	}, trades)
+
+	// the older archives have no header
+	csvPath := filepath.Join(dir, "BTCUSDT-trades-2020-01-01.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("5,7200.5,0.2,1440.1,1577836800000,True\n"), 0o644))
+	futuresTrades, err := readAll(Open(FuturesTrades, csvPath))
+	require.NoError(t, err)
+	assert.Equal(t, []*futures.Trade{
+		{ID: 5, Price: "7200.5", Quantity: "0.2", QuoteQuantity: "1440.1", Time: 1577836800000, IsBuyerMaker: true},
+	}, futuresTrades)
+}
+
+func TestFuturesBookTickerAndMetrics(t *testing.T) {
+	dir := t.TempDir()
+	bookTicker := writeArchive(t, dir, "ETHUSDT-bookTicker-2024-01-01.zip",
+		"update_id,best_bid_price,best_bid_qty,best_ask_price,best_ask_qty,transaction_time,event_time\n"+
+			"3747186394040,2281.37,26.785,2281.38,44.003,1704067200004,1704067200010\n", true)
+	tickers, err := readAll(Open(FuturesBookTicker, bookTicker))
+	require.NoError(t, err)
+	assert.Equal(t, []*futures.BookTicker{{
+		Symbol:       "ETHUSDT",
+		BidPrice:     "2281.37",
+		BidQuantity:  "26.785",
+		AskPrice:     "2281.38",
+		AskQuantity:  "44.003",
+		Time:         1704067200004,
+		LastUpdateId: 3747186394040,
+	}}, tickers)
+
+	metrics := writeArchive(t, dir, "BTCUSDT-metrics-2024-01-01.zip",
+		"create_time,symbol,sum_open_interest,sum_open_interest_value,count_toptrader_long_short_ratio,sum_toptrader_long_short_ratio,count_long_short_ratio,sum_taker_long_short_vol_ratio\n"+
+			"2024-01-01 00:05:00,BTCUSDT,73011.264,3096341003.5,1.5,1.2,1.7,0.9\n", false)
+	rows, err := readAll(Open(FuturesMetrics, metrics))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, int64(1704067500000), rows[0].Time)
+	assert.Equal(t, "73011.264", rows[0].SumOpenInterest)
+	assert.Equal(t, "0.9", rows[0].SumTakerLongShortVolRatio)
+}
+
+func TestChecksum(t *testing.T) {
+	dir := t.TempDir()
+	path := writeArchive(t, dir, "BTCUSDT-aggTrades-2024-01.zip",
+		"1,42000.1,0.5,10,12,1704067200000,True,True\n", true)
+	require.NoError(t, VerifyChecksum(path))
+
+	require.NoError(t, os.WriteFile(path+".CHECKSUM", []byte(strings.Repeat("0", 64)+"  BTCUSDT-aggTrades-2024-01.zip\n"), 0o644))
+	err := VerifyChecksum(path)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	_, err = Open(SpotAggTrades, path)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+
+	require.NoError(t, os.Remove(path+".CHECKSUM"))
+	assert.Error(t, VerifyChecksum(path))
+	trades, err := readAll(Open(SpotAggTrades, path))
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	assert.True(t, trades[0].IsBestPriceMatch)
+}
+
+func TestParseError(t *testing.T) {
+	dir := t.TempDir()
+	path := writeArchive(t, dir, "BTCUSDT-trades-2024-01.zip",
+		"1,42000.1,0.5,21000.05,1704067200000,true,true\n2,42000.1,0.5,21000.05,x,true,true\n", false)
+	r, err := Open(SpotTrades, path)
+	require.NoError(t, err)
+	defer r.Close()
+	assert.True(t, r.Next())
+	assert.False(t, r.Next())
+	assert.EqualError(t, r.Err(), `archive: BTCUSDT-trades-2024-01.csv line 2: column 5: strconv.ParseInt: parsing "x": invalid syntax`)
+
+	r, err = Open(SpotTrades, writeArchive(t, dir, "BTCUSDT-trades-2024-02.zip", "1,42000.1\n", false))
+	require.NoError(t, err)
+	defer r.Close()
+	assert.False(t, r.Next())
+	assert.Error(t, r.Err())
+}
@@ -0,0 +1,52 @@
+package archive
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when an archive does not match its .CHECKSUM file
+var ErrChecksumMismatch = errors.New("archive: checksum mismatch")
+
+// VerifyChecksum check the SHA256 of the archive at path against the path.CHECKSUM file, which
+// holds the hex digest followed by the file name like the output of sha256sum
+func VerifyChecksum(path string) error {
+	data, err := os.ReadFile(path + ".CHECKSUM")
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return fmt.Errorf("archive: empty checksum file %s.CHECKSUM", path)
+	}
+	expected, err := hex.DecodeString(fields[0])
+	if err != nil || len(expected) != sha256.Size {
+		return fmt.Errorf("archive: invalid checksum in %s.CHECKSUM", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if !strings.EqualFold(hex.EncodeToString(h.Sum(nil)), fields[0]) {
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, path)
+	}
+	return nil
+}
+
+// verifyIfPresent verify the archive at path if it has a .CHECKSUM file
+func verifyIfPresent(path string) error {
+	if _, err := os.Stat(path + ".CHECKSUM"); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return VerifyChecksum(path)
+}
@@ -0,0 +1,299 @@
+package archive
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Datasets of the spot archives
+var (
+	// SpotKlines read spot/*/klines, with microsecond timestamps since 2025
+	SpotKlines = Dataset[*binance.Kline]{Name: "klines", header: "open_time", columns: 11, parse: parseSpotKline}
+	// SpotAggTrades read spot/*/aggTrades
+	SpotAggTrades = Dataset[*binance.AggTrade]{Name: "aggTrades", header: "agg_trade_id", columns: 8, parse: parseSpotAggTrade}
+	// SpotTrades read spot/*/trades
+	SpotTrades = Dataset[*binance.Trade]{Name: "trades", header: "id", columns: 7, parse: parseSpotTrade}
+)
+
+// Datasets of the USDⓈ-M futures archives, futures/um
+var (
+	// FuturesKlines read futures/um/*/klines, and the markPriceKlines, indexPriceKlines and
+	// premiumIndexKlines archives which have the same columns
+	FuturesKlines = Dataset[*futures.Kline]{Name: "klines", header: "open_time", columns: 11, parse: parseFuturesKline}
+	// FuturesAggTrades read futures/um/*/aggTrades
+	FuturesAggTrades = Dataset[*futures.AggTrade]{Name: "aggTrades", header: "agg_trade_id", columns: 7, parse: parseFuturesAggTrade}
+	// FuturesTrades read futures/um/*/trades
+	FuturesTrades = Dataset[*futures.Trade]{Name: "trades", header: "id", columns: 6, parse: parseFuturesTrade}
+	// FuturesBookTicker read futures/um/*/bookTicker, the symbol is taken from the file name
+	FuturesBookTicker = Dataset[*futures.BookTicker]{Name: "bookTicker", header: "update_id", columns: 6, parse: parseFuturesBookTicker}
+	// FuturesMetrics read futures/um/daily/metrics
+	FuturesMetrics = Dataset[*Metric]{Name: "metrics", header: "create_time", columns: 8, parse: parseMetric}
+)
+
+// Datasets of the COIN-M futures archives, futures/cm
+var (
+	// DeliveryKlines read futures/cm/*/klines, and the markPriceKlines, indexPriceKlines and
+	// premiumIndexKlines archives which have the same columns
+	DeliveryKlines = Dataset[*delivery.Kline]{Name: "klines", header: "open_time", columns: 11, parse: parseDeliveryKline}
+	// DeliveryAggTrades read futures/cm/*/aggTrades
+	DeliveryAggTrades = Dataset[*delivery.AggTrade]{Name: "aggTrades", header: "agg_trade_id", columns: 7, parse: parseDeliveryAggTrade}
+	// DeliveryTrades read futures/cm/*/trades
+	DeliveryTrades = Dataset[*delivery.Trade]{Name: "trades", header: "id", columns: 6, parse: parseDeliveryTrade}
+)
+
+// Metric define a row of the futures metrics archives, the open interest and long/short ratios
+// of a symbol every 5 minutes
+type Metric struct {
+	Time                         int64
+	Symbol                       string
+	SumOpenInterest              string
+	SumOpenInterestValue         string
+	CountTopTraderLongShortRatio string
+	SumTopTraderLongShortRatio   string
+	CountLongShortRatio          string
+	SumTakerLongShortVolRatio    string
+}
+
+// microsecondThreshold separates the microsecond timestamps from the millisecond ones, it is
+// year 5138 in milliseconds and 1973 in microseconds
+const microsecondThreshold = 1e14
+
+// parseTime parse a timestamp in milliseconds or microseconds into milliseconds
+func parseTime(s string) (int64, error) {
+	t, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if t >= microsecondThreshold {
+		t /= 1000
+	}
+	return t, nil
+}
+
+// fields parse the integer, time and boolean columns of a row, the first error is kept
+type fields struct {
+	record []string
+	err    error
+}
+
+func (f *fields) int(i int) int64 {
+	v, err := strconv.ParseInt(f.record[i], 10, 64)
+	if err != nil && f.err == nil {
+		f.err = fmt.Errorf("column %d: %w", i+1, err)
+	}
+	return v
+}
+
+func (f *fields) time(i int) int64 {
+	v, err := parseTime(f.record[i])
+	if err != nil && f.err == nil {
+		f.err = fmt.Errorf("column %d: %w", i+1, err)
+	}
+	return v
+}
+
+func (f *fields) bool(i int) bool {
+	v, err := strconv.ParseBool(f.record[i])
+	if err != nil && f.err == nil {
+		f.err = fmt.Errorf("column %d: %w", i+1, err)
+	}
+	return v
+}
+
+// klineColumns hold the columns shared by the klines of all the markets
+type klineColumns struct {
+	openTime, closeTime, tradeNum                                      int64
+	open, high, low, close, volume, quoteVolume, takerBase, takerQuote string
+}
+
+func parseKlineColumns(record []string) (k klineColumns, err error) {
+	f := fields{record: record}
+	k.openTime = f.time(0)
+	k.closeTime = f.time(6)
+	k.tradeNum = f.int(8)
+	k.open, k.high, k.low, k.close, k.volume = record[1], record[2], record[3], record[4], record[5]
+	k.quoteVolume, k.takerBase, k.takerQuote = record[7], record[9], record[10]
+	return k, f.err
+}
+
+func parseSpotKline(symbol string, record []string) (*binance.Kline, error) {
+	k, err := parseKlineColumns(record)
+	if err != nil {
+		return nil, err
+	}
+	return &binance.Kline{
+		OpenTime:                 k.openTime,
+		Open:                     k.open,
+		High:                     k.high,
+		Low:                      k.low,
+		Close:                    k.close,
+		Volume:                   k.volume,
+		CloseTime:                k.closeTime,
+		QuoteAssetVolume:         k.quoteVolume,
+		TradeNum:                 k.tradeNum,
+		TakerBuyBaseAssetVolume:  k.takerBase,
+		TakerBuyQuoteAssetVolume: k.takerQuote,
+	}, nil
+}
+
+func parseFuturesKline(symbol string, record []string) (*futures.Kline, error) {
+	k, err := parseKlineColumns(record)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.Kline{
+		OpenTime:                 k.openTime,
+		Open:                     k.open,
+		High:                     k.high,
+		Low:                      k.low,
+		Close:                    k.close,
+		Volume:                   k.volume,
+		CloseTime:                k.closeTime,
+		QuoteAssetVolume:         k.quoteVolume,
+		TradeNum:                 k.tradeNum,
+		TakerBuyBaseAssetVolume:  k.takerBase,
+		TakerBuyQuoteAssetVolume: k.takerQuote,
+	}, nil
+}
+
+func parseDeliveryKline(symbol string, record []string) (*delivery.Kline, error) {
+	k, err := parseKlineColumns(record)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery.Kline{
+		OpenTime:                 k.openTime,
+		Open:                     k.open,
+		High:                     k.high,
+		Low:                      k.low,
+		Close:                    k.close,
+		Volume:                   k.volume,
+		CloseTime:                k.closeTime,
+		QuoteAssetVolume:         k.quoteVolume,
+		TradeNum:                 k.tradeNum,
+		TakerBuyBaseAssetVolume:  k.takerBase,
+		TakerBuyQuoteAssetVolume: k.takerQuote,
+	}, nil
+}
+
+func parseSpotAggTrade(symbol string, record []string) (*binance.AggTrade, error) {
+	f := fields{record: record}
+	t := &binance.AggTrade{
+		AggTradeID:       f.int(0),
+		Price:            record[1],
+		Quantity:         record[2],
+		FirstTradeID:     f.int(3),
+		LastTradeID:      f.int(4),
+		Timestamp:        f.time(5),
+		IsBuyerMaker:     f.bool(6),
+		IsBestPriceMatch: f.bool(7),
+	}
+	return t, f.err
+}
+
+func parseFuturesAggTrade(symbol string, record []string) (*futures.AggTrade, error) {
+	f := fields{record: record}
+	t := &futures.AggTrade{
+		AggTradeID:   f.int(0),
+		Price:        record[1],
+		Quantity:     record[2],
+		FirstTradeID: f.int(3),
+		LastTradeID:  f.int(4),
+		Timestamp:    f.time(5),
+		IsBuyerMaker: f.bool(6),
+	}
+	return t, f.err
+}
+
+func parseDeliveryAggTrade(symbol string, record []string) (*delivery.AggTrade, error) {
+	f := fields{record: record}
+	t := &delivery.AggTrade{
+		AggTradeID:   f.int(0),
+		Price:        record[1],
+		Quantity:     record[2],
+		FirstTradeID: f.int(3),
+		LastTradeID:  f.int(4),
+		Timestamp:    f.time(5),
+		IsBuyerMaker: f.bool(6),
+	}
+	return t, f.err
+}
+
+func parseSpotTrade(symbol string, record []string) (*binance.Trade, error) {
+	f := fields{record: record}
+	t := &binance.Trade{
+		ID:            f.int(0),
+		Price:         record[1],
+		Quantity:      record[2],
+		QuoteQuantity: record[3],
+		Time:          f.time(4),
+		IsBuyerMaker:  f.bool(5),
+		IsBestMatch:   f.bool(6),
+	}
+	return t, f.err
+}
+
+func parseFuturesTrade(symbol string, record []string) (*futures.Trade, error) {
+	f := fields{record: record}
+	t := &futures.Trade{
+		ID:            f.int(0),
+		Price:         record[1],
+		Quantity:      record[2],
+		QuoteQuantity: record[3],
+		Time:          f.time(4),
+		IsBuyerMaker:  f.bool(5),
+	}
+	return t, f.err
+}
+
+func parseDeliveryTrade(symbol string, record []string) (*delivery.Trade, error) {
+	f := fields{record: record}
+	t := &delivery.Trade{
+		ID:           f.int(0),
+		Price:        record[1],
+		Quantity:     record[2],
+		BaseQuantity: record[3],
+		Time:         f.time(4),
+		IsBuyerMaker: f.bool(5),
+	}
+	return t, f.err
+}
+
+func parseFuturesBookTicker(symbol string, record []string) (*futures.BookTicker, error) {
+	f := fields{record: record}
+	t := &futures.BookTicker{
+		Symbol:       symbol,
+		LastUpdateId: f.int(0),
+		BidPrice:     record[1],
+		BidQuantity:  record[2],
+		AskPrice:     record[3],
+		AskQuantity:  record[4],
+		Time:         f.time(5),
+	}
+	return t, f.err
+}
+
+// metricTimeLayout is the layout of the create_time column of the metrics archives, in UTC
+const metricTimeLayout = "2006-01-02 15:04:05"
+
+func parseMetric(symbol string, record []string) (*Metric, error) {
+	t, err := time.Parse(metricTimeLayout, record[0])
+	if err != nil {
+		return nil, fmt.Errorf("column 1: %w", err)
+	}
+	return &Metric{
+		Time:                         t.UnixMilli(),
+		Symbol:                       record[1],
+		SumOpenInterest:              record[2],
+		SumOpenInterestValue:         record[3],
+		CountTopTraderLongShortRatio: record[4],
+		SumTopTraderLongShortRatio:   record[5],
+		CountLongShortRatio:          record[6],
+		SumTakerLongShortVolRatio:    record[7],
+	}, nil
+}
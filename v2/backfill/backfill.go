@@ -0,0 +1,369 @@
+// Package backfill downloads the history of the paginated market and account data endpoints, e.g.
+// klines, trades, funding rates and income, over a time range.
+//
+// The range is split into windows downloaded concurrently within a request weight budget. Each
+// window is paged by time or by id, the rows repeated at the page boundaries are dropped, and the
+// rows are delivered in order through an Iterator or a channel. A Checkpoint keeps the time up to
+// which the rows were delivered, so an interrupted download resumes where it stopped.
+//
+//	it := backfill.Download(ctx, backfill.SpotKlines(client, "BTCUSDT", "1m"), start, end, backfill.Config{
+//		Limiter: backfill.NewWeightBudget(1200),
+//	})
+//	defer it.Close()
+//	for it.Next() {
+//		kline := it.Row()
+//	}
+//	err := it.Err()
+package backfill
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultWorkers is the number of windows downloaded concurrently when Config.Workers is not set
+var DefaultWorkers = 4
+
+// Page define the request of a page
+type Page struct {
+	// StartTime and EndTime bound the rows of the page in milliseconds, both inclusive
+	StartTime int64
+	EndTime   int64
+	// FromID is the id of the first row of the page when a Source pages by id, 0 for the first
+	// page of a window
+	FromID int64
+	// Limit is the maximum number of rows of the page
+	Limit int
+}
+
+// Source define how to download the rows of a dataset, see the constructors like SpotKlines
+type Source[T any] struct {
+	// Name identifies the dataset in a Checkpoint, e.g. spot/klines/BTCUSDT/1m
+	Name string
+	// Limit is the number of rows requested per page, a shorter page ends a window
+	Limit int
+	// Weight is the request weight of a page
+	Weight int64
+	// Window is the time range of the windows downloaded concurrently, 0 downloads the whole
+	// range as a single window
+	Window time.Duration
+	// ByID makes the pages after the first one of a window start at the id following the last
+	// row, instead of the time of the last row
+	ByID bool
+	// Fetch return the rows of a page in ascending order
+	Fetch func(ctx context.Context, page Page) ([]T, error)
+	// Time return the time of a row in milliseconds
+	Time func(row T) int64
+	// ID return the id of a row, it may be nil if the time of a row is unique and ByID is false
+	ID func(row T) int64
+	// Interval is the time range covered by a row like a kline, when set and the download ends
+	// now the checkpoint stays before the row still open so that it is downloaded again
+	Interval time.Duration
+}
+
+// Config define the options of a download
+type Config struct {
+	// Workers is the number of windows downloaded concurrently, DefaultWorkers if not set
+	Workers int
+	// Limiter is waited with the weight of each page if set, see NewWeightBudget. Sharing the
+	// limiter of the client instead makes the pages count twice.
+	Limiter *common.RateLimiter
+	// Checkpoint saves the progress of the download if set
+	Checkpoint Checkpoint
+}
+
+// NewWeightBudget init a rate limiter allowing weight request weight per minute, which can be
+// shared by several downloads
+func NewWeightBudget(weight int64) *common.RateLimiter {
+	return common.NewRateLimiter([]common.RateLimitRule{{
+		RateLimitType: common.RateLimitTypeRequestWeight,
+		Interval:      "MINUTE",
+		IntervalNum:   1,
+		Limit:         weight,
+	}})
+}
+
+// window define a time range downloaded by a worker, in milliseconds, both inclusive
+type window struct {
+	start, end int64
+}
+
+type windowResult[T any] struct {
+	rows []T
+	err  error
+	done chan struct{}
+}
+
+// Iterator deliver the rows of a download in order, it is not safe for concurrent use
+type Iterator[T any] struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	source  Source[T]
+	config  Config
+	windows []window
+	results []*windowResult[T]
+	slots   chan struct{}
+	// saveMax is the latest time saved to the checkpoint
+	saveMax int64
+
+	index   int
+	rows    []T
+	pos     int
+	row     T
+	pending bool
+	err     error
+	once    sync.Once
+}
+
+// Download start downloading the rows of source between start and end, a zero end is now. The
+// rows before the time saved in config.Checkpoint are skipped.
+func Download[T any](ctx context.Context, source Source[T], start, end time.Time, config Config) *Iterator[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	if config.Workers <= 0 {
+		config.Workers = DefaultWorkers
+	}
+	it := &Iterator[T]{
+		ctx:     ctx,
+		cancel:  cancel,
+		source:  source,
+		config:  config,
+		slots:   make(chan struct{}, config.Workers),
+		saveMax: math.MaxInt64,
+	}
+	if end.IsZero() {
+		end = time.Now()
+		if source.Interval > 0 {
+			it.saveMax = end.Add(-source.Interval).UnixMilli()
+		}
+	}
+	from, to := start.UnixMilli(), end.UnixMilli()
+	if config.Checkpoint != nil {
+		saved, ok, err := config.Checkpoint.Load(source.Name)
+		if err != nil {
+			it.err = err
+			return it
+		}
+		if ok && saved >= from {
+			from = saved + 1
+		}
+	}
+	it.windows = splitWindows(from, to, source.Window)
+	it.results = make([]*windowResult[T], len(it.windows))
+	for i := range it.results {
+		it.results[i] = &windowResult[T]{done: make(chan struct{})}
+	}
+	go it.dispatch()
+	return it
+}
+
+// splitWindows split [from, to] into windows of size, a single window if size <= 0
+func splitWindows(from, to int64, size time.Duration) []window {
+	if from > to {
+		return nil
+	}
+	step := size.Milliseconds()
+	if step <= 0 {
+		return []window{{from, to}}
+	}
+	var windows []window
+	for start := from; start <= to; start += step {
+		end := start + step - 1
+		if end > to {
+			end = to
+		}
+		windows = append(windows, window{start, end})
+	}
+	return windows
+}
+
+// dispatch start the download of the windows in order, no more than Workers windows ahead of
+// the one being delivered
+func (it *Iterator[T]) dispatch() {
+	for i := range it.windows {
+		select {
+		case it.slots <- struct{}{}:
+		case <-it.ctx.Done():
+			return
+		}
+		go func(w window, res *windowResult[T]) {
+			res.rows, res.err = it.fetchWindow(w)
+			close(res.done)
+		}(it.windows[i], it.results[i])
+	}
+}
+
+// fetchWindow download the rows of a window page by page
+func (it *Iterator[T]) fetchWindow(w window) ([]T, error) {
+	s := it.source
+	page := Page{StartTime: w.start, EndTime: w.end, Limit: s.Limit}
+	var (
+		rows     []T
+		last     int64
+		lastID   int64
+		started  bool
+		seen     = make(map[int64]bool)
+		finished bool
+	)
+	for !finished {
+		if it.config.Limiter != nil {
+			if err := it.config.Limiter.Wait(it.ctx, s.Weight, 0); err != nil {
+				return nil, err
+			}
+		}
+		got, err := s.Fetch(it.ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		fresh := 0
+		for _, row := range got {
+			t := s.Time(row)
+			if t > w.end {
+				finished = true
+				break
+			}
+			if t < w.start {
+				continue
+			}
+			var id int64
+			if s.ID != nil {
+				id = s.ID(row)
+			}
+			if started {
+				switch {
+				case s.ByID:
+					if id <= lastID {
+						continue
+					}
+				case s.ID == nil:
+					if t <= last {
+						continue
+					}
+				case t < last || (t == last && seen[id]):
+					continue
+				}
+			}
+			if t != last {
+				seen = make(map[int64]bool)
+			}
+			seen[id] = true
+			last, lastID, started = t, id, true
+			rows = append(rows, row)
+			fresh++
+		}
+		if len(got) < s.Limit || s.Limit <= 0 {
+			break
+		}
+		tail := got[len(got)-1]
+		switch {
+		case s.ByID:
+			page.FromID = s.ID(tail) + 1
+		case s.ID == nil || fresh == 0:
+			// a page full of rows at the same time cannot be paged by time, the next rows at
+			// that time are skipped
+			page.StartTime = s.Time(tail) + 1
+		default:
+			page.StartTime = s.Time(tail)
+		}
+		if page.StartTime > w.end {
+			break
+		}
+	}
+	return rows, nil
+}
+
+// Next advance to the next row, false at the end of the download or on error
+func (it *Iterator[T]) Next() bool {
+	for {
+		if it.err != nil {
+			return false
+		}
+		if it.pos < len(it.rows) {
+			it.row = it.rows[it.pos]
+			it.pos++
+			return true
+		}
+		if it.pending {
+			// the rows of the previous window were all handled
+			it.pending = false
+			if it.config.Checkpoint != nil {
+				saved := it.windows[it.index-1].end
+				if saved > it.saveMax {
+					saved = it.saveMax
+				}
+				if err := it.config.Checkpoint.Save(it.source.Name, saved); err != nil {
+					it.fail(err)
+					return false
+				}
+			}
+		}
+		if it.index == len(it.windows) {
+			it.Close()
+			return false
+		}
+		res := it.results[it.index]
+		select {
+		case <-res.done:
+		case <-it.ctx.Done():
+			it.fail(it.ctx.Err())
+			return false
+		}
+		if res.err != nil {
+			it.fail(res.err)
+			return false
+		}
+		it.rows, it.pos, it.pending = res.rows, 0, true
+		res.rows = nil
+		it.index++
+		<-it.slots
+	}
+}
+
+// Row return the current row
+func (it *Iterator[T]) Row() T {
+	return it.row
+}
+
+// Err return the error which ended the download, nil at the end of the range
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close stop the download, the pending requests are canceled
+func (it *Iterator[T]) Close() {
+	it.once.Do(it.cancel)
+}
+
+func (it *Iterator[T]) fail(err error) {
+	it.err = err
+	it.Close()
+}
+
+// Stream download the rows of source between start and end like Download, the rows are sent on
+// rowC which is closed at the end. errC receives the error which ended the download if any, and
+// is closed after rowC. The checkpoint of a window is saved once its last row is received.
+func Stream[T any](ctx context.Context, source Source[T], start, end time.Time, config Config) (rowC <-chan T, errC <-chan error) {
+	rows := make(chan T)
+	errs := make(chan error, 1)
+	it := Download(ctx, source, start, end, config)
+	go func() {
+		defer close(errs)
+		defer close(rows)
+		defer it.Close()
+		for it.Next() {
+			select {
+			case rows <- it.Row():
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			errs <- err
+		}
+	}()
+	return rows, errs
+}
@@ -0,0 +1,248 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRow struct {
+	id   int64
+	time int64
+}
+
+// testSource page rows sorted by time like the REST endpoints, by time or by id
+func testSource(rows []testRow, limit int, window time.Duration, byID bool) (Source[testRow], *int32) {
+	var calls int32
+	return Source[testRow]{
+		Name:   "test",
+		Limit:  limit,
+		Weight: 1,
+		Window: window,
+		ByID:   byID,
+		Fetch: func(ctx context.Context, page Page) ([]testRow, error) {
+			atomic.AddInt32(&calls, 1)
+			var res []testRow
+			for _, r := range rows {
+				if len(res) == page.Limit {
+					break
+				}
+				if page.FromID != 0 {
+					if r.id >= page.FromID {
+						res = append(res, r)
+					}
+					continue
+				}
+				if r.time >= page.StartTime && r.time <= page.EndTime {
+					res = append(res, r)
+				}
+			}
+			return res, nil
+		},
+		Time: func(r testRow) int64 { return r.time },
+		ID:   func(r testRow) int64 { return r.id },
+	}, &calls
+}
+
+func collect(t *testing.T, it *Iterator[testRow]) []int64 {
+	defer it.Close()
+	var ids []int64
+	for it.Next() {
+		ids = append(ids, it.Row().id)
+	}
+	require.NoError(t, it.Err())
+	return ids
+}
+
+func testRows(n int, step int64) []testRow {
+	rows := make([]testRow, n)
+	for i := range rows {
+		rows[i] = testRow{id: int64(i + 1), time: int64(i) * step}
+	}
+	return rows
+}
+
+func sequence(from, to int64) []int64 {
+	var ids []int64
+	for id := from; id <= to; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestDownloadByTime(t *testing.T) {
+	// several rows share a time, the pages overlap at the last time
+	var rows []testRow
+	for i := 0; i < 95; i++ {
+		rows = append(rows, testRow{id: int64(i + 1), time: int64(i / 3)})
+	}
+	source, _ := testSource(rows, 10, 0, false)
+	ids := collect(t, Download(context.Background(), source, time.UnixMilli(0), time.UnixMilli(100), Config{}))
+	assert.Equal(t, sequence(1, 95), ids)
+
+	// unique times are paged from the time following the last row
+	source, calls := testSource(testRows(100, 1), 10, 0, false)
+	source.ID = nil
+	ids = collect(t, Download(context.Background(), source, time.UnixMilli(0), time.UnixMilli(99), Config{}))
+	assert.Equal(t, sequence(1, 100), ids)
+	assert.Equal(t, int32(10), atomic.LoadInt32(calls))
+}
+
+func TestDownloadByIDWindows(t *testing.T) {
+	source, _ := testSource(testRows(1000, 7), 25, 100*time.Millisecond, true)
+	ids := collect(t, Download(context.Background(), source, time.UnixMilli(70), time.UnixMilli(6993), Config{Workers: 8}))
+	assert.Equal(t, sequence(11, 1000), ids)
+}
+
+func TestDownloadOrderAndWorkers(t *testing.T) {
+	var running, maxRunning int32
+	source, _ := testSource(testRows(100, 10), 1000, 50*time.Millisecond, false)
+	fetch := source.Fetch
+	source.Fetch = func(ctx context.Context, page Page) ([]testRow, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		// the later windows finish first
+		time.Sleep(time.Duration(1000-page.StartTime) * time.Microsecond * 10)
+		return fetch(ctx, page)
+	}
+	ids := collect(t, Download(context.Background(), source, time.UnixMilli(0), time.UnixMilli(999), Config{Workers: 3}))
+	assert.Equal(t, sequence(1, 100), ids)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(3))
+	assert.Greater(t, atomic.LoadInt32(&maxRunning), int32(1))
+}
+
+func TestDownloadCheckpoint(t *testing.T) {
+	checkpoint := NewFileCheckpoint(filepath.Join(t.TempDir(), "checkpoint.json"))
+	source, _ := testSource(testRows(100, 10), 1000, 100*time.Millisecond, false)
+	config := Config{Checkpoint: checkpoint}
+
+	it := Download(context.Background(), source, time.UnixMilli(0), time.UnixMilli(999), config)
+	var ids []int64
+	for it.Next() && len(ids) < 25 {
+		ids = append(ids, it.Row().id)
+	}
+	it.Close()
+	// the windows of 10 rows were handled up to the 20th row
+	saved, ok, err := checkpoint.Load("test")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(199), saved)
+
+	ids = collect(t, Download(context.Background(), source, time.UnixMilli(0), time.UnixMilli(999), config))
+	assert.Equal(t, sequence(21, 100), ids)
+	saved, _, _ = checkpoint.Load("test")
+	assert.Equal(t, int64(999), saved)
+
+	other, ok, err := NewFileCheckpoint(checkpoint.path).Load("other")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Zero(t, other)
+}
+
+func TestDownloadCheckpointOpenRow(t *testing.T) {
+	// klines opened every minute up to the one still open since 30s
+	now := time.Now().UnixMilli()
+	var rows []testRow
+	for i := 0; i < 5; i++ {
+		rows = append(rows, testRow{id: int64(i + 1), time: now - 30000 - int64(4-i)*60000})
+	}
+	checkpoint := NewFileCheckpoint(filepath.Join(t.TempDir(), "checkpoint.json"))
+	source, _ := testSource(rows, 1000, 0, false)
+	source.Interval = time.Minute
+	config := Config{Checkpoint: checkpoint}
+	start := time.UnixMilli(rows[0].time)
+
+	ids := collect(t, Download(context.Background(), source, start, time.Time{}, config))
+	assert.Equal(t, sequence(1, 5), ids)
+	saved, _, _ := checkpoint.Load("test")
+	assert.GreaterOrEqual(t, saved, rows[3].time)
+	assert.Less(t, saved, rows[4].time)
+
+	// the open kline is downloaded again on resume
+	ids = collect(t, Download(context.Background(), source, start, time.Time{}, config))
+	assert.Equal(t, []int64{5}, ids)
+}
+
+func TestDownloadError(t *testing.T) {
+	source, _ := testSource(testRows(100, 10), 1000, 100*time.Millisecond, false)
+	fetch := source.Fetch
+	errFetch := errors.New("fetch failed")
+	source.Fetch = func(ctx context.Context, page Page) ([]testRow, error) {
+		if page.StartTime == 300 {
+			return nil, errFetch
+		}
+		return fetch(ctx, page)
+	}
+	it := Download(context.Background(), source, time.UnixMilli(0), time.UnixMilli(999), Config{})
+	n := 0
+	for it.Next() {
+		n++
+	}
+	assert.Equal(t, 30, n)
+	assert.Equal(t, errFetch, it.Err())
+}
+
+func TestDownloadWeightBudget(t *testing.T) {
+	source, calls := testSource(testRows(100, 10), 1000, 100*time.Millisecond, false)
+	source.Weight = 10
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	// a daily budget does not refill during the test like a budget per minute could
+	limiter := common.NewRateLimiter([]common.RateLimitRule{{
+		RateLimitType: common.RateLimitTypeRequestWeight,
+		Interval:      "DAY",
+		IntervalNum:   1,
+		Limit:         50,
+	}})
+	it := Download(ctx, source, time.UnixMilli(0), time.UnixMilli(999), Config{Workers: 1, Limiter: limiter})
+	defer it.Close()
+	n := 0
+	for it.Next() {
+		n++
+	}
+	assert.Error(t, it.Err())
+	assert.Equal(t, 50, n)
+	assert.Equal(t, int32(5), atomic.LoadInt32(calls))
+}
+
+func TestStream(t *testing.T) {
+	source, _ := testSource(testRows(100, 10), 1000, 100*time.Millisecond, false)
+	rowC, errC := Stream(context.Background(), source, time.UnixMilli(0), time.UnixMilli(999), Config{})
+	var mu sync.Mutex
+	var ids []int64
+	for row := range rowC {
+		mu.Lock()
+		ids = append(ids, row.id)
+		mu.Unlock()
+	}
+	assert.NoError(t, <-errC)
+	assert.True(t, sort.SliceIsSorted(ids, func(i, j int) bool { return ids[i] < ids[j] }))
+	assert.Len(t, ids, 100)
+}
+
+func TestIntervalDuration(t *testing.T) {
+	d, ok := intervalDuration("15m")
+	assert.True(t, ok)
+	assert.Equal(t, 15*time.Minute, d)
+	d, ok = intervalDuration("1M")
+	assert.True(t, ok)
+	assert.Equal(t, 31*24*time.Hour, d)
+	_, ok = intervalDuration("x")
+	assert.False(t, ok)
+	assert.Equal(t, 1000*time.Hour, klinesWindow("1h", 1000))
+}
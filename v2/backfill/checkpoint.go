@@ -0,0 +1,85 @@
+package backfill
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint save the time in milliseconds up to which the rows of a dataset were delivered
+type Checkpoint interface {
+	// Load return the saved time of the dataset name, false if none
+	Load(name string) (time int64, ok bool, err error)
+	// Save save the time of the dataset name
+	Save(name string, time int64) error
+}
+
+// FileCheckpoint is a Checkpoint saving the times of the datasets to a JSON file, it can be
+// shared by several downloads
+type FileCheckpoint struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileCheckpoint init a FileCheckpoint on the file at path, created on the first save
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{path: path}
+}
+
+// Load return the saved time of the dataset name, false if none
+func (c *FileCheckpoint) Load(name string) (int64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	times, err := c.read()
+	if err != nil {
+		return 0, false, err
+	}
+	t, ok := times[name]
+	return t, ok, nil
+}
+
+// Save save the time of the dataset name, the file is replaced atomically
+func (c *FileCheckpoint) Save(name string, time int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	times, err := c.read()
+	if err != nil {
+		return err
+	}
+	times[name] = time
+	data, err := json.MarshalIndent(times, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path)
+}
+
+func (c *FileCheckpoint) read() (map[string]int64, error) {
+	times := make(map[string]int64)
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return times, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &times); err != nil {
+		return nil, err
+	}
+	return times, nil
+}
@@ -0,0 +1,112 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2/delivery"
+)
+
+// DeliveryKlines return the source of the klines of the COIN-M futures symbol at interval, paged
+// by open time
+func DeliveryKlines(client *delivery.Client, symbol, interval string) Source[*delivery.Kline] {
+	return Source[*delivery.Kline]{
+		Name:     fmt.Sprintf("delivery/klines/%s/%s", symbol, interval),
+		Limit:    1000,
+		Weight:   5,
+		Window:   klinesWindow(interval, 1000),
+		Interval: klinesInterval(interval),
+		Fetch: func(ctx context.Context, page Page) ([]*delivery.Kline, error) {
+			return client.NewKlinesService().Symbol(symbol).Interval(interval).Limit(page.Limit).
+				StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(k *delivery.Kline) int64 { return k.OpenTime },
+	}
+}
+
+// DeliveryAggTrades return the source of the aggregate trades of the COIN-M futures symbol, paged
+// by id within windows of one hour
+func DeliveryAggTrades(client *delivery.Client, symbol string) Source[*delivery.AggTrade] {
+	return Source[*delivery.AggTrade]{
+		Name:   fmt.Sprintf("delivery/aggTrades/%s", symbol),
+		Limit:  1000,
+		Weight: 20,
+		Window: time.Hour,
+		ByID:   true,
+		Fetch: func(ctx context.Context, page Page) ([]*delivery.AggTrade, error) {
+			s := client.NewAggTradesService().Symbol(symbol).Limit(page.Limit)
+			if page.FromID != 0 {
+				return s.FromID(page.FromID).Do(ctx)
+			}
+			return s.StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(t *delivery.AggTrade) int64 { return t.Timestamp },
+		ID:   func(t *delivery.AggTrade) int64 { return t.AggTradeID },
+	}
+}
+
+// DeliveryTrades return the source of the trades of the COIN-M futures symbol, paged by id within
+// windows of one hour. The first trade of a window is located with the aggregate trades.
+func DeliveryTrades(client *delivery.Client, symbol string) Source[*delivery.Trade] {
+	return Source[*delivery.Trade]{
+		Name:   fmt.Sprintf("delivery/trades/%s", symbol),
+		Limit:  500,
+		Weight: 20 + 20,
+		Window: time.Hour,
+		ByID:   true,
+		Fetch: func(ctx context.Context, page Page) ([]*delivery.Trade, error) {
+			fromID := page.FromID
+			if fromID == 0 {
+				first, err := client.NewAggTradesService().Symbol(symbol).StartTime(page.StartTime).
+					EndTime(page.EndTime).Limit(1).Do(ctx)
+				if err != nil || len(first) == 0 {
+					return nil, err
+				}
+				fromID = first[0].FirstTradeID
+			}
+			return client.NewHistoricalTradesService().Symbol(symbol).FromID(fromID).Limit(page.Limit).Do(ctx)
+		},
+		Time: func(t *delivery.Trade) int64 { return t.Time },
+		ID:   func(t *delivery.Trade) int64 { return t.ID },
+	}
+}
+
+// DeliveryFundingRates return the source of the funding rate history of the COIN-M futures symbol
+func DeliveryFundingRates(client *delivery.Client, symbol string) Source[*delivery.FundingRate] {
+	return Source[*delivery.FundingRate]{
+		Name:   fmt.Sprintf("delivery/fundingRates/%s", symbol),
+		Limit:  1000,
+		Weight: 1,
+		Window: 30 * 24 * time.Hour,
+		Fetch: func(ctx context.Context, page Page) ([]*delivery.FundingRate, error) {
+			return client.NewFundingRateService().Symbol(symbol).Limit(page.Limit).
+				StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(r *delivery.FundingRate) int64 { return r.FundingTime },
+	}
+}
+
+// DeliveryIncome return the source of the income history of the COIN-M futures account, symbol and
+// incomeType may be empty for all of them
+func DeliveryIncome(client *delivery.Client, symbol, incomeType string) Source[*delivery.IncomeHistory] {
+	return Source[*delivery.IncomeHistory]{
+		Name:   fmt.Sprintf("delivery/income/%s/%s", symbol, incomeType),
+		Limit:  1000,
+		Weight: 20,
+		Window: 7 * 24 * time.Hour,
+		Fetch: func(ctx context.Context, page Page) ([]*delivery.IncomeHistory, error) {
+			s := client.NewGetIncomeHistoryService().Limit(page.Limit).
+				StartTime(page.StartTime).EndTime(page.EndTime)
+			if symbol != "" {
+				s.Symbol(symbol)
+			}
+			if incomeType != "" {
+				s.IncomeType(incomeType)
+			}
+			return s.Do(ctx)
+		},
+		Time: func(h *delivery.IncomeHistory) int64 { return h.Time },
+		ID:   func(h *delivery.IncomeHistory) int64 { return h.TranID },
+	}
+}
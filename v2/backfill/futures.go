@@ -0,0 +1,112 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// FuturesKlines return the source of the klines of the USDⓈ-M futures symbol at interval, paged
+// by open time
+func FuturesKlines(client *futures.Client, symbol, interval string) Source[*futures.Kline] {
+	return Source[*futures.Kline]{
+		Name:     fmt.Sprintf("futures/klines/%s/%s", symbol, interval),
+		Limit:    1000,
+		Weight:   5,
+		Window:   klinesWindow(interval, 1000),
+		Interval: klinesInterval(interval),
+		Fetch: func(ctx context.Context, page Page) ([]*futures.Kline, error) {
+			return client.NewKlinesService().Symbol(symbol).Interval(interval).Limit(page.Limit).
+				StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(k *futures.Kline) int64 { return k.OpenTime },
+	}
+}
+
+// FuturesAggTrades return the source of the aggregate trades of the USDⓈ-M futures symbol, paged
+// by id within windows of one hour
+func FuturesAggTrades(client *futures.Client, symbol string) Source[*futures.AggTrade] {
+	return Source[*futures.AggTrade]{
+		Name:   fmt.Sprintf("futures/aggTrades/%s", symbol),
+		Limit:  1000,
+		Weight: 20,
+		Window: time.Hour,
+		ByID:   true,
+		Fetch: func(ctx context.Context, page Page) ([]*futures.AggTrade, error) {
+			s := client.NewAggTradesService().Symbol(symbol).Limit(page.Limit)
+			if page.FromID != 0 {
+				return s.FromID(page.FromID).Do(ctx)
+			}
+			return s.StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(t *futures.AggTrade) int64 { return t.Timestamp },
+		ID:   func(t *futures.AggTrade) int64 { return t.AggTradeID },
+	}
+}
+
+// FuturesTrades return the source of the trades of the USDⓈ-M futures symbol, paged by id within
+// windows of one hour. The first trade of a window is located with the aggregate trades.
+func FuturesTrades(client *futures.Client, symbol string) Source[*futures.Trade] {
+	return Source[*futures.Trade]{
+		Name:   fmt.Sprintf("futures/trades/%s", symbol),
+		Limit:  500,
+		Weight: 20 + 20,
+		Window: time.Hour,
+		ByID:   true,
+		Fetch: func(ctx context.Context, page Page) ([]*futures.Trade, error) {
+			fromID := page.FromID
+			if fromID == 0 {
+				first, err := client.NewAggTradesService().Symbol(symbol).StartTime(page.StartTime).
+					EndTime(page.EndTime).Limit(1).Do(ctx)
+				if err != nil || len(first) == 0 {
+					return nil, err
+				}
+				fromID = first[0].FirstTradeID
+			}
+			return client.NewHistoricalTradesService().Symbol(symbol).FromID(fromID).Limit(page.Limit).Do(ctx)
+		},
+		Time: func(t *futures.Trade) int64 { return t.Time },
+		ID:   func(t *futures.Trade) int64 { return t.ID },
+	}
+}
+
+// FuturesFundingRates return the source of the funding rate history of the USDⓈ-M futures symbol
+func FuturesFundingRates(client *futures.Client, symbol string) Source[*futures.FundingRate] {
+	return Source[*futures.FundingRate]{
+		Name:   fmt.Sprintf("futures/fundingRates/%s", symbol),
+		Limit:  1000,
+		Weight: 1,
+		Window: 30 * 24 * time.Hour,
+		Fetch: func(ctx context.Context, page Page) ([]*futures.FundingRate, error) {
+			return client.NewFundingRateService().Symbol(symbol).Limit(page.Limit).
+				StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(r *futures.FundingRate) int64 { return r.FundingTime },
+	}
+}
+
+// FuturesIncome return the source of the income history of the USDⓈ-M futures account, symbol and
+// incomeType may be empty for all of them
+func FuturesIncome(client *futures.Client, symbol, incomeType string) Source[*futures.IncomeHistory] {
+	return Source[*futures.IncomeHistory]{
+		Name:   fmt.Sprintf("futures/income/%s/%s", symbol, incomeType),
+		Limit:  1000,
+		Weight: 30,
+		Window: 7 * 24 * time.Hour,
+		Fetch: func(ctx context.Context, page Page) ([]*futures.IncomeHistory, error) {
+			s := client.NewGetIncomeHistoryService().Limit(int64(page.Limit)).
+				StartTime(page.StartTime).EndTime(page.EndTime)
+			if symbol != "" {
+				s.Symbol(symbol)
+			}
+			if incomeType != "" {
+				s.IncomeType(incomeType)
+			}
+			return s.Do(ctx)
+		},
+		Time: func(h *futures.IncomeHistory) int64 { return h.Time },
+		ID:   func(h *futures.IncomeHistory) int64 { return h.TranID },
+	}
+}
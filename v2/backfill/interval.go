@@ -0,0 +1,51 @@
+package backfill
+
+import (
+	"strconv"
+	"time"
+)
+
+// intervalDuration return the duration of a kline interval like 1m, 4h, 1d, 1w or 1M, a month
+// counting 31 days
+func intervalDuration(interval string) (time.Duration, bool) {
+	if len(interval) < 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	var unit time.Duration
+	switch interval[len(interval)-1] {
+	case 's':
+		unit = time.Second
+	case 'm':
+		unit = time.Minute
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	case 'M':
+		unit = 31 * 24 * time.Hour
+	default:
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// klinesWindow return the window holding limit klines of interval, 0 for an unknown interval
+func klinesWindow(interval string, limit int) time.Duration {
+	d, ok := intervalDuration(interval)
+	if !ok {
+		return 0
+	}
+	return d * time.Duration(limit)
+}
+
+// klinesInterval return the duration of a kline interval, 0 for an unknown interval
+func klinesInterval(interval string) time.Duration {
+	d, _ := intervalDuration(interval)
+	return d
+}
@@ -0,0 +1,46 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// OptionsKlines return the source of the klines of the option symbol at interval, paged by open
+// time
+func OptionsKlines(client *options.Client, symbol, interval string) Source[*options.Kline] {
+	return Source[*options.Kline]{
+		Name:     fmt.Sprintf("options/klines/%s/%s", symbol, interval),
+		Limit:    1000,
+		Weight:   1,
+		Window:   klinesWindow(interval, 1000),
+		Interval: klinesInterval(interval),
+		Fetch: func(ctx context.Context, page Page) ([]*options.Kline, error) {
+			return client.NewKlinesService().Symbol(symbol).Interval(interval).Limit(page.Limit).
+				StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(k *options.Kline) int64 { return k.OpenTime },
+	}
+}
+
+// OptionsTrades return the source of the trades of the option symbol from the trade fromID,
+// paged by id. The historical trades cannot be searched by time, the trades before the start of
+// the range are downloaded and skipped, so fromID should be close to it.
+func OptionsTrades(client *options.Client, symbol string, fromID int64) Source[*options.HistoricalTrade] {
+	return Source[*options.HistoricalTrade]{
+		Name:   fmt.Sprintf("options/trades/%s", symbol),
+		Limit:  500,
+		Weight: 20,
+		ByID:   true,
+		Fetch: func(ctx context.Context, page Page) ([]*options.HistoricalTrade, error) {
+			id := page.FromID
+			if id == 0 {
+				id = fromID
+			}
+			return client.NewHistoricalTradesService().Symbol(symbol).FromID(id).Limit(page.Limit).Do(ctx)
+		},
+		Time: func(t *options.HistoricalTrade) int64 { return int64(t.Time) },
+		ID:   func(t *options.HistoricalTrade) int64 { return int64(t.Id) },
+	}
+}
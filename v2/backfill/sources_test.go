@@ -0,0 +1,89 @@
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer serve minute klines from time 0 and aggregate trades with id i at time 100*i
+func newTestServer(t *testing.T, klines, trades int64) *httptest.Server {
+	param := func(r *http.Request, key string) (int64, bool) {
+		v, err := strconv.ParseInt(r.URL.Query().Get(key), 10, 64)
+		return v, err == nil
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := param(r, "limit")
+		var res []any
+		switch r.URL.Path {
+		case "/api/v3/klines":
+			start, _ := param(r, "startTime")
+			end, _ := param(r, "endTime")
+			for i := int64(0); i < klines && int64(len(res)) < limit; i++ {
+				openTime := i * int64(time.Minute/time.Millisecond)
+				if openTime >= start && openTime <= end {
+					res = append(res, []any{openTime, "1", "2", "0.5", "1.5", "10", openTime + 59999, "15", 3, "5", "7.5", "0"})
+				}
+			}
+		case "/fapi/v1/aggTrades":
+			fromID, byID := param(r, "fromId")
+			start, _ := param(r, "startTime")
+			end, _ := param(r, "endTime")
+			for id := int64(1); id <= trades && int64(len(res)) < limit; id++ {
+				ts := 100 * id
+				if (byID && id >= fromID) || (!byID && ts >= start && ts <= end) {
+					res = append(res, map[string]any{"a": id, "p": "1", "q": "1", "f": id, "l": id, "T": ts, "m": true})
+				}
+			}
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSpotKlines(t *testing.T) {
+	server := newTestServer(t, 2500, 0)
+	client := binance.NewClient("", "")
+	client.SetEndpoints(binance.NewLocalEndpoints(server.URL))
+
+	start, end := time.UnixMilli(0), time.UnixMilli(0).Add(3000*time.Minute)
+	it := Download(context.Background(), SpotKlines(client, "BTCUSDT", "1m"), start, end, Config{})
+	defer it.Close()
+	var n int64
+	for it.Next() {
+		k := it.Row()
+		require.Equal(t, n*60000, k.OpenTime)
+		assert.Equal(t, "1.5", k.Close)
+		n++
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, int64(2500), n)
+}
+
+func TestFuturesAggTrades(t *testing.T) {
+	server := newTestServer(t, 0, 12000)
+	client := futures.NewClient("", "")
+	client.SetEndpoints(futures.NewLocalEndpoints(server.URL))
+
+	source := FuturesAggTrades(client, "BTCUSDT")
+	rowC, errC := Stream(context.Background(), source, time.UnixMilli(250), time.UnixMilli(1_100_000), Config{Workers: 2})
+	next := int64(3)
+	for trade := range rowC {
+		require.Equal(t, next, trade.AggTradeID)
+		next++
+	}
+	require.NoError(t, <-errC)
+	assert.Equal(t, int64(11001), next)
+}
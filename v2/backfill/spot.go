@@ -0,0 +1,73 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+// SpotKlines return the source of the klines of symbol at interval, paged by open time
+func SpotKlines(client *binance.Client, symbol, interval string) Source[*binance.Kline] {
+	return Source[*binance.Kline]{
+		Name:     fmt.Sprintf("spot/klines/%s/%s", symbol, interval),
+		Limit:    1000,
+		Weight:   2,
+		Window:   klinesWindow(interval, 1000),
+		Interval: klinesInterval(interval),
+		Fetch: func(ctx context.Context, page Page) ([]*binance.Kline, error) {
+			return client.NewKlinesService().Symbol(symbol).Interval(interval).Limit(page.Limit).
+				StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(k *binance.Kline) int64 { return k.OpenTime },
+	}
+}
+
+// SpotAggTrades return the source of the aggregate trades of symbol, paged by id within windows
+// of one hour
+func SpotAggTrades(client *binance.Client, symbol string) Source[*binance.AggTrade] {
+	return Source[*binance.AggTrade]{
+		Name:   fmt.Sprintf("spot/aggTrades/%s", symbol),
+		Limit:  1000,
+		Weight: 4,
+		Window: time.Hour,
+		ByID:   true,
+		Fetch: func(ctx context.Context, page Page) ([]*binance.AggTrade, error) {
+			s := client.NewAggTradesService().Symbol(symbol).Limit(page.Limit)
+			if page.FromID != 0 {
+				return s.FromID(page.FromID).Do(ctx)
+			}
+			return s.StartTime(page.StartTime).EndTime(page.EndTime).Do(ctx)
+		},
+		Time: func(t *binance.AggTrade) int64 { return t.Timestamp },
+		ID:   func(t *binance.AggTrade) int64 { return t.AggTradeID },
+	}
+}
+
+// SpotTrades return the source of the trades of symbol, paged by id within windows of one hour.
+// The first trade of a window is located with the aggregate trades, and the historical trades
+// need an API key.
+func SpotTrades(client *binance.Client, symbol string) Source[*binance.Trade] {
+	return Source[*binance.Trade]{
+		Name:   fmt.Sprintf("spot/trades/%s", symbol),
+		Limit:  1000,
+		Weight: 25 + 4,
+		Window: time.Hour,
+		ByID:   true,
+		Fetch: func(ctx context.Context, page Page) ([]*binance.Trade, error) {
+			fromID := page.FromID
+			if fromID == 0 {
+				first, err := client.NewAggTradesService().Symbol(symbol).StartTime(page.StartTime).
+					EndTime(page.EndTime).Limit(1).Do(ctx)
+				if err != nil || len(first) == 0 {
+					return nil, err
+				}
+				fromID = first[0].FirstTradeID
+			}
+			return client.NewHistoricalTradesService().Symbol(symbol).FromID(fromID).Limit(page.Limit).Do(ctx)
+		},
+		Time: func(t *binance.Trade) int64 { return t.Time },
+		ID:   func(t *binance.Trade) int64 { return t.ID },
+	}
+}
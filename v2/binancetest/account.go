@@ -0,0 +1,191 @@
+package binancetest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// defaultLeverage is the leverage of the futures symbols until it is changed
+const defaultLeverage = 20
+
+// Account define an account of the simulator. Its spot balances, futures wallet and positions are
+// updated by the trades of its orders, they can be set and read by the tests.
+type Account struct {
+	APIKey string
+
+	server    *Server
+	house     bool
+	secret    []byte
+	publicKey crypto.PublicKey
+
+	balances  map[string]*balance
+	wallet    map[string]decimal.Decimal
+	positions map[string]*position
+	leverage  map[string]int
+}
+
+type balance struct {
+	free   decimal.Decimal
+	locked decimal.Decimal
+}
+
+type position struct {
+	amount     decimal.Decimal
+	entryPrice decimal.Decimal
+	realized   decimal.Decimal
+}
+
+func newAccount(s *Server, apiKey string) *Account {
+	return &Account{
+		APIKey:    apiKey,
+		server:    s,
+		balances:  make(map[string]*balance),
+		wallet:    make(map[string]decimal.Decimal),
+		positions: make(map[string]*position),
+		leverage:  make(map[string]int),
+	}
+}
+
+// AddAccount add an account authenticated by apiKey. key is the secret of a HMAC key, or the PEM
+// encoded public (PKIX) or private (PKCS8) key of a RSA or Ed25519 key, keyType is one of the
+// common.KeyType constants.
+func (s *Server) AddAccount(apiKey, keyType, key string) (*Account, error) {
+	if apiKey == "" {
+		return nil, errors.New("empty API key")
+	}
+	secret, publicKey, err := parseKey(keyType, key)
+	if err != nil {
+		return nil, err
+	}
+	a := newAccount(s, apiKey)
+	a.secret, a.publicKey = secret, publicKey
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[apiKey] = a
+	return a, nil
+}
+
+// SetBalance set the free spot balance of asset
+func (a *Account) SetBalance(asset, free string) {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+	a.balance(asset).free = mustDecimal(free)
+}
+
+// Balance return the free and locked spot balances of asset
+func (a *Account) Balance(asset string) (free, locked string) {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+	b := a.balance(asset)
+	return b.free.String(), b.locked.String()
+}
+
+// SetFuturesBalance set the futures wallet balance of asset
+func (a *Account) SetFuturesBalance(asset, amount string) {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+	a.wallet[asset] = mustDecimal(amount)
+}
+
+// FuturesBalance return the futures wallet balance of asset, the realized profits included
+func (a *Account) FuturesBalance(asset string) string {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+	return a.wallet[asset].String()
+}
+
+// Position return the futures position of symbol, the amount is negative for a short position
+func (a *Account) Position(symbol string) (amount, entryPrice string) {
+	a.server.mu.Lock()
+	defer a.server.mu.Unlock()
+	p := a.position(symbol)
+	return p.amount.String(), p.entryPrice.String()
+}
+
+func (a *Account) balance(asset string) *balance {
+	b, ok := a.balances[asset]
+	if !ok {
+		b = &balance{}
+		a.balances[asset] = b
+	}
+	return b
+}
+
+func (a *Account) position(symbol string) *position {
+	p, ok := a.positions[symbol]
+	if !ok {
+		p = &position{}
+		a.positions[symbol] = p
+	}
+	return p
+}
+
+func (a *Account) symbolLeverage(symbol string) int {
+	if l, ok := a.leverage[symbol]; ok {
+		return l
+	}
+	return defaultLeverage
+}
+
+func (a *Account) sortedAssets() []string {
+	assets := make([]string, 0, len(a.balances))
+	for asset := range a.balances {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+func (a *Account) sortedWalletAssets() []string {
+	assets := make([]string, 0, len(a.wallet))
+	for asset := range a.wallet {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+	return assets
+}
+
+// trade update the position with a trade of qty, negative for a sell, at price and return the
+// realized profit
+func (p *position) trade(qty, price decimal.Decimal) decimal.Decimal {
+	realized := decimal.Zero
+	if p.amount.IsZero() || p.amount.Sign() == qty.Sign() {
+		total := p.amount.Add(qty)
+		p.entryPrice = p.entryPrice.Mul(p.amount.Abs()).Add(price.Mul(qty.Abs())).Div(total.Abs())
+		p.amount = total
+		return realized
+	}
+	closed := decimal.Min(p.amount.Abs(), qty.Abs())
+	realized = price.Sub(p.entryPrice).Mul(closed)
+	if p.amount.IsNegative() {
+		realized = realized.Neg()
+	}
+	p.realized = p.realized.Add(realized)
+	p.amount = p.amount.Add(qty)
+	switch {
+	case p.amount.IsZero():
+		p.entryPrice = decimal.Zero
+	case p.amount.Sign() == qty.Sign():
+		// the position is reversed, the rest is opened at price
+		p.entryPrice = price
+	}
+	return realized
+}
+
+func (p *position) unrealized(mark decimal.Decimal) decimal.Decimal {
+	if p.amount.IsZero() || !mark.IsPositive() {
+		return decimal.Zero
+	}
+	return mark.Sub(p.entryPrice).Mul(p.amount)
+}
+
+func newID() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,210 @@
+package binancetest
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type handlerFunc func(r *request) (any, error)
+
+// request define the parameters of a REST or WebSocket API request
+type request struct {
+	params    url.Values
+	apiKey    string
+	signature string
+	// payload is the signed part of the request
+	payload string
+}
+
+func newRequest(r *http.Request) (*request, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	req := &request{apiKey: r.Header.Get("X-MBX-APIKEY")}
+	query := r.URL.RawQuery
+	// the signature is the last parameter of the query string, it signs the query string and the body
+	if i := strings.Index(query, "signature="); i == 0 || (i > 0 && query[i-1] == '&') {
+		req.signature, _ = url.QueryUnescape(query[i+len("signature="):])
+		query = strings.TrimSuffix(query[:i], "&")
+	}
+	req.payload = query + string(body)
+	if req.params, err = url.ParseQuery(query); err != nil {
+		return nil, err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range form {
+		req.params[k] = append(req.params[k], v...)
+	}
+	return req, nil
+}
+
+// newWsRequest init a request from the params of a WebSocket API request, decoded with UseNumber
+func newWsRequest(params map[string]any) *request {
+	req := &request{params: url.Values{}}
+	signed := url.Values{}
+	for k, v := range params {
+		value := fmt.Sprintf("%v", v)
+		switch k {
+		case "signature":
+			req.signature = value
+			continue
+		case "apiKey":
+			req.apiKey = value
+		}
+		req.params.Set(k, value)
+		signed.Add(k, value)
+	}
+	req.payload = signed.Encode()
+	return req
+}
+
+func (r *request) get(name string) string {
+	return r.params.Get(name)
+}
+
+func (r *request) required(name string) (string, error) {
+	v := r.params.Get(name)
+	if v == "" {
+		return "", errMandatoryParam(name)
+	}
+	return v, nil
+}
+
+func (r *request) int64(name string) (int64, bool, error) {
+	v := r.params.Get(name)
+	if v == "" {
+		return 0, false, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false, errMandatoryParam(name)
+	}
+	return i, true, nil
+}
+
+// authenticate return the account of the API key of r
+func (s *Server) authenticate(r *request) (*Account, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account, ok := s.accounts[r.apiKey]
+	if !ok || r.apiKey == "" {
+		return nil, errInvalidAPIKey
+	}
+	return account, nil
+}
+
+// verify authenticate r and check its timestamp and its signature
+func (s *Server) verify(r *request) (*Account, error) {
+	account, err := s.authenticate(r)
+	if err != nil {
+		return nil, err
+	}
+	timestamp, ok, err := r.int64("timestamp")
+	if err != nil || !ok {
+		return nil, errMandatoryParam("timestamp")
+	}
+	recvWindow, ok, err := r.int64("recvWindow")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		recvWindow = 5000
+	}
+	if t := now(); timestamp > t+1000 || t-timestamp > recvWindow {
+		return nil, errInvalidTimestamp
+	}
+	if r.signature == "" {
+		return nil, errMandatoryParam("signature")
+	}
+	if !account.verifySignature(r.payload, r.signature) {
+		return nil, errInvalidSignature
+	}
+	return account, nil
+}
+
+// parseKey parse the key of an account: the secret of a HMAC key, or the PEM encoded public or
+// private key of a RSA or Ed25519 key
+func parseKey(keyType, key string) (secret []byte, publicKey crypto.PublicKey, err error) {
+	switch keyType {
+	case common.KeyTypeHmac:
+		return []byte(key), nil, nil
+	case common.KeyTypeRsa, common.KeyTypeEd25519:
+	default:
+		return nil, nil, fmt.Errorf("unsupported keyType=%s", keyType)
+	}
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, nil, errors.New("invalid pem format key")
+	}
+	if k, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		publicKey = k
+	} else if k, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := k.(crypto.Signer)
+		if !ok {
+			return nil, nil, errors.New("unsupported private key")
+		}
+		publicKey = signer.Public()
+	} else {
+		return nil, nil, fmt.Errorf("invalid %s key: %w", keyType, err)
+	}
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		if keyType == common.KeyTypeRsa {
+			return nil, publicKey, nil
+		}
+	case ed25519.PublicKey:
+		if keyType == common.KeyTypeEd25519 {
+			return nil, publicKey, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("key is not a %s key", keyType)
+}
+
+func (a *Account) verifySignature(payload, signature string) bool {
+	switch k := a.publicKey.(type) {
+	case *rsa.PublicKey:
+		sig, err := base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			return false
+		}
+		hashed := sha256.Sum256([]byte(payload))
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hashed[:], sig) == nil
+	case ed25519.PublicKey:
+		sig, err := base64.StdEncoding.DecodeString(signature)
+		if err != nil {
+			return false
+		}
+		return ed25519.Verify(k, []byte(payload), sig)
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(payload))
+	return hmac.Equal(mac.Sum(nil), sig)
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
@@ -0,0 +1,415 @@
+package binancetest
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Order status, sides, types and time in force of the engine
+const (
+	statusNew             = "NEW"
+	statusPartiallyFilled = "PARTIALLY_FILLED"
+	statusFilled          = "FILLED"
+	statusCanceled        = "CANCELED"
+	statusExpired         = "EXPIRED"
+
+	sideBuy  = "BUY"
+	sideSell = "SELL"
+
+	typeLimit      = "LIMIT"
+	typeLimitMaker = "LIMIT_MAKER"
+	typeMarket     = "MARKET"
+
+	tifGTC = "GTC"
+	tifIOC = "IOC"
+	tifFOK = "FOK"
+	tifGTX = "GTX"
+)
+
+// SymbolConfig define a symbol of the simulator, the empty values have defaults
+type SymbolConfig struct {
+	Symbol     string
+	BaseAsset  string
+	QuoteAsset string
+	// TickSize is the PRICE_FILTER tick size, 0.01 by default
+	TickSize string
+	// StepSize is the LOT_SIZE step size, 0.00001 by default
+	StepSize string
+	// MinQuantity is the LOT_SIZE minimum quantity, the step size by default
+	MinQuantity string
+	// MinNotional is the minimum notional of the NOTIONAL (spot) or MIN_NOTIONAL (futures) filter,
+	// not checked by default
+	MinNotional string
+	// MarkPrice is the initial mark price of a futures symbol, the price of the last trade is used
+	// until it is set
+	MarkPrice string
+}
+
+// symbol define a symbol of an exchange and its order book
+type symbol struct {
+	SymbolConfig
+	tick        decimal.Decimal
+	step        decimal.Decimal
+	minQty      decimal.Decimal
+	minNotional decimal.Decimal
+	markPrice   decimal.Decimal
+	lastPrice   decimal.Decimal
+
+	bids         []*order
+	asks         []*order
+	lastUpdateID int64
+
+	// published is the book of the last diff depth event, by side and price
+	published   map[string]map[string]string
+	publishedID int64
+}
+
+func newSymbol(cfg SymbolConfig) *symbol {
+	if cfg.TickSize == "" {
+		cfg.TickSize = "0.01"
+	}
+	if cfg.StepSize == "" {
+		cfg.StepSize = "0.00001"
+	}
+	if cfg.MinQuantity == "" {
+		cfg.MinQuantity = cfg.StepSize
+	}
+	return &symbol{
+		SymbolConfig: cfg,
+		tick:         mustDecimal(cfg.TickSize),
+		step:         mustDecimal(cfg.StepSize),
+		minQty:       mustDecimal(cfg.MinQuantity),
+		minNotional:  mustDecimal(cfg.MinNotional),
+		markPrice:    mustDecimal(cfg.MarkPrice),
+		published:    map[string]map[string]string{sideBuy: {}, sideSell: {}},
+	}
+}
+
+// mark return the mark price of the symbol, or the last price if it is not set
+func (s *symbol) mark() decimal.Decimal {
+	if s.markPrice.IsPositive() {
+		return s.markPrice
+	}
+	return s.lastPrice
+}
+
+// order define an order of the engine
+type order struct {
+	id            int64
+	clientOrderID string
+	account       *Account
+	symbol        string
+	side          string
+	orderType     string
+	timeInForce   string
+	price         decimal.Decimal
+	origQty       decimal.Decimal
+	quoteQty      decimal.Decimal
+	executedQty   decimal.Decimal
+	cumQuote      decimal.Decimal
+	status        string
+	reduceOnly    bool
+	// locked is the balance locked by a spot order, in the quote asset for a buy and the base asset for a sell
+	locked     decimal.Decimal
+	time       int64
+	updateTime int64
+}
+
+func (o *order) remaining() decimal.Decimal {
+	return o.origQty.Sub(o.executedQty)
+}
+
+func (o *order) isOpen() bool {
+	return o.status == statusNew || o.status == statusPartiallyFilled
+}
+
+func (o *order) avgPrice() decimal.Decimal {
+	if o.executedQty.IsZero() {
+		return decimal.Zero
+	}
+	return o.cumQuote.Div(o.executedQty)
+}
+
+// crosses return true if the taker order can trade with a resting order at price
+func (o *order) crosses(price decimal.Decimal) bool {
+	if o.orderType == typeMarket {
+		return true
+	}
+	if o.side == sideBuy {
+		return !o.price.LessThan(price)
+	}
+	return !o.price.GreaterThan(price)
+}
+
+// fill define a trade between a taker and a maker order
+type fill struct {
+	tradeID int64
+	taker   *order
+	maker   *order
+	price   decimal.Decimal
+	qty     decimal.Decimal
+	time    int64
+	// takerPnl and makerPnl are the futures profits realized by the trade
+	takerPnl decimal.Decimal
+	makerPnl decimal.Decimal
+}
+
+// exchange define the symbols and the orders of a market
+type exchange struct {
+	market      Market
+	symbols     map[string]*symbol
+	orders      map[int64]*order
+	fills       []*fill
+	nextOrderID int64
+	nextTradeID int64
+}
+
+func newExchange(market Market) *exchange {
+	return &exchange{
+		market:  market,
+		symbols: make(map[string]*symbol),
+		orders:  make(map[int64]*order),
+	}
+}
+
+func (e *exchange) symbol(name string) (*symbol, error) {
+	s, ok := e.symbols[name]
+	if !ok {
+		return nil, errInvalidSymbol
+	}
+	return s, nil
+}
+
+func (e *exchange) sortedSymbols() []*symbol {
+	res := make([]*symbol, 0, len(e.symbols))
+	for _, s := range e.symbols {
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Symbol < res[j].Symbol })
+	return res
+}
+
+// findOrder return the order of account by id or client order id
+func (e *exchange) findOrder(account *Account, symbol string, id int64, clientOrderID string) *order {
+	if id != 0 {
+		if o, ok := e.orders[id]; ok && o.account == account && o.symbol == symbol {
+			return o
+		}
+		return nil
+	}
+	// a client order id can be reused once the order is closed, the open or the last order is returned
+	var res *order
+	for _, o := range e.orders {
+		if o.account != account || o.symbol != symbol || o.clientOrderID != clientOrderID {
+			continue
+		}
+		if o.isOpen() {
+			return o
+		}
+		if res == nil || o.id > res.id {
+			res = o
+		}
+	}
+	return res
+}
+
+// accountOrders return the orders of account sorted by id, symbol filters them if not empty
+func (e *exchange) accountOrders(account *Account, symbol string, openOnly bool) []*order {
+	var res []*order
+	for _, o := range e.orders {
+		if o.account != account || (symbol != "" && o.symbol != symbol) || (openOnly && !o.isOpen()) {
+			continue
+		}
+		res = append(res, o)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].id < res[j].id })
+	return res
+}
+
+// accountFills return the fills of account on symbol sorted by trade id
+func (e *exchange) accountFills(account *Account, symbol string) []*fill {
+	var res []*fill
+	for _, f := range e.fills {
+		if f.taker.symbol == symbol && (f.taker.account == account || f.maker.account == account) {
+			res = append(res, f)
+		}
+	}
+	return res
+}
+
+// available return the quantity and the quote quantity a taker order could trade against the book
+func (s *symbol) available(o *order) (qty, quote decimal.Decimal) {
+	for _, maker := range s.opposite(o.side) {
+		if !o.crosses(maker.price) {
+			break
+		}
+		q := maker.remaining()
+		if left := o.remaining().Sub(qty); o.quoteQty.IsZero() && q.GreaterThan(left) {
+			q = left
+		}
+		if !o.quoteQty.IsZero() {
+			q = decimal.Min(q, o.quoteQty.Sub(quote).Div(maker.price))
+			q = roundDown(q, s.step)
+		}
+		if !q.IsPositive() {
+			break
+		}
+		qty = qty.Add(q)
+		quote = quote.Add(q.Mul(maker.price))
+	}
+	return qty, quote
+}
+
+func (s *symbol) opposite(side string) []*order {
+	if side == sideBuy {
+		return s.asks
+	}
+	return s.bids
+}
+
+// match trade the taker order against the resting orders of the book, by price then time priority.
+// The remaining quantity of a GTC order rests in the book, other orders expire.
+func (e *exchange) match(s *symbol, o *order, t int64) []*fill {
+	var fills []*fill
+	book := &s.asks
+	if o.side == sideSell {
+		book = &s.bids
+	}
+	for len(*book) > 0 && o.isOpen() {
+		maker := (*book)[0]
+		if !o.crosses(maker.price) {
+			break
+		}
+		qty := decimal.Min(maker.remaining(), o.remaining())
+		if !o.quoteQty.IsZero() {
+			qty = decimal.Min(maker.remaining(), roundDown(o.quoteQty.Sub(o.cumQuote).Div(maker.price), s.step))
+		}
+		if !qty.IsPositive() {
+			break
+		}
+		e.nextTradeID++
+		f := &fill{tradeID: e.nextTradeID, taker: o, maker: maker, price: maker.price, qty: qty, time: t}
+		fills = append(fills, f)
+		e.fills = append(e.fills, f)
+		for _, x := range []*order{o, maker} {
+			x.executedQty = x.executedQty.Add(qty)
+			x.cumQuote = x.cumQuote.Add(qty.Mul(maker.price))
+			x.updateTime = t
+			x.status = statusPartiallyFilled
+			if x.quoteQty.IsZero() && !x.remaining().IsPositive() {
+				x.status = statusFilled
+			}
+		}
+		if maker.status == statusFilled {
+			*book = (*book)[1:]
+		}
+		s.lastPrice = maker.price
+	}
+	if !o.quoteQty.IsZero() && o.status == statusPartiallyFilled &&
+		!roundDown(o.quoteQty.Sub(o.cumQuote).Div(s.lastPrice), s.step).IsPositive() {
+		// the quote quantity is spent, otherwise the book ran out and the order expires
+		o.origQty = o.executedQty
+		o.status = statusFilled
+	}
+	if o.isOpen() {
+		if o.orderType == typeMarket || o.timeInForce != tifGTC {
+			o.status = statusExpired
+		} else {
+			s.insert(o)
+		}
+	}
+	s.lastUpdateID++
+	return fills
+}
+
+// insert add a resting order to the book after the orders of the same price
+func (s *symbol) insert(o *order) {
+	book := &s.bids
+	better := func(a, b decimal.Decimal) bool { return a.GreaterThan(b) }
+	if o.side == sideSell {
+		book = &s.asks
+		better = func(a, b decimal.Decimal) bool { return a.LessThan(b) }
+	}
+	i := sort.Search(len(*book), func(i int) bool { return better(o.price, (*book)[i].price) })
+	*book = append(*book, nil)
+	copy((*book)[i+1:], (*book)[i:])
+	(*book)[i] = o
+}
+
+// remove remove a resting order from the book
+func (s *symbol) remove(o *order) {
+	for _, book := range []*[]*order{&s.bids, &s.asks} {
+		for i, x := range *book {
+			if x == o {
+				*book = append((*book)[:i], (*book)[i+1:]...)
+				s.lastUpdateID++
+				return
+			}
+		}
+	}
+}
+
+// level define a price level of the book
+type level struct {
+	price decimal.Decimal
+	qty   decimal.Decimal
+}
+
+// depth return the limit best price levels of each side of the book, all of them if limit is 0
+func (s *symbol) depth(limit int) (bids, asks []level) {
+	aggregate := func(book []*order) []level {
+		var levels []level
+		for _, o := range book {
+			if n := len(levels); n > 0 && levels[n-1].price.Equal(o.price) {
+				levels[n-1].qty = levels[n-1].qty.Add(o.remaining())
+				continue
+			}
+			if limit > 0 && len(levels) == limit {
+				break
+			}
+			levels = append(levels, level{price: o.price, qty: o.remaining()})
+		}
+		return levels
+	}
+	return aggregate(s.bids), aggregate(s.asks)
+}
+
+func levelsJSON(levels []level) [][]string {
+	res := make([][]string, len(levels))
+	for i, l := range levels {
+		res[i] = []string{l.price.String(), l.qty.String()}
+	}
+	return res
+}
+
+// checkFilters check the price and the quantity of o against the filters of the symbol
+func (s *symbol) checkFilters(o *order, notionalPrice decimal.Decimal, minNotionalFilter string) error {
+	if o.orderType != typeMarket {
+		if !o.price.IsPositive() || (s.tick.IsPositive() && !o.price.Mod(s.tick).IsZero()) {
+			return errFilterFailure("PRICE_FILTER")
+		}
+	}
+	if o.quoteQty.IsZero() {
+		if o.origQty.LessThan(s.minQty) || (s.step.IsPositive() && !o.origQty.Sub(s.minQty).Mod(s.step).IsZero()) {
+			return errFilterFailure("LOT_SIZE")
+		}
+	}
+	if s.minNotional.IsPositive() && !o.reduceOnly {
+		notional := o.quoteQty
+		if notional.IsZero() {
+			notional = o.origQty.Mul(notionalPrice)
+		}
+		if notional.LessThan(s.minNotional) {
+			return errFilterFailure(minNotionalFilter)
+		}
+	}
+	return nil
+}
+
+func roundDown(v, step decimal.Decimal) decimal.Decimal {
+	if !step.IsPositive() {
+		return v
+	}
+	return v.Div(step).Floor().Mul(step)
+}
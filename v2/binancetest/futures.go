@@ -0,0 +1,709 @@
+package binancetest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+var (
+	errMarginInsufficient = newAPIError(http.StatusBadRequest, -2019, "Margin is insufficient.")
+	errReduceOnlyRejected = newAPIError(http.StatusBadRequest, -2022, "ReduceOnly Order is rejected.")
+)
+
+// AddFuturesSymbol add a USD-M perpetual futures symbol with an empty order book, the margin asset
+// is the quote asset
+func (s *Server) AddFuturesSymbol(cfg SymbolConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.futures.symbols[cfg.Symbol] = newSymbol(cfg)
+}
+
+// SetMarkPrice set the mark price of a futures symbol and push it to the mark price streams
+func (s *Server) SetMarkPrice(symbol, price string) error {
+	p, err := decimal.NewFromString(price)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sym, err := s.futures.symbol(symbol)
+	if err != nil {
+		return err
+	}
+	sym.markPrice = p
+	s.publishMarkPrice(sym, now())
+	return nil
+}
+
+func (s *Server) futuresHandler(method, path string) handlerFunc {
+	switch method + " " + path {
+	case "GET /fapi/v1/ping":
+		return ping
+	case "GET /fapi/v1/time":
+		return serverTime
+	case "GET /fapi/v1/exchangeInfo":
+		return s.public(s.futuresExchangeInfo)
+	case "GET /fapi/v1/depth":
+		return s.public(s.futuresDepth)
+	case "GET /fapi/v1/premiumIndex":
+		return s.public(s.futuresPremiumIndex)
+	case "GET /fapi/v1/ticker/price", "GET /fapi/v2/ticker/price":
+		return s.public(s.futuresTickerPrice)
+	case "GET /fapi/v1/ticker/bookTicker":
+		return s.public(s.futuresBookTicker)
+	case "POST /fapi/v1/order":
+		return s.signed(s.futuresCreateOrder)
+	case "GET /fapi/v1/order":
+		return s.signed(s.futuresGetOrder)
+	case "DELETE /fapi/v1/order":
+		return s.signed(s.futuresCancelOrder)
+	case "GET /fapi/v1/openOrders":
+		return s.signed(s.futuresOpenOrders)
+	case "DELETE /fapi/v1/allOpenOrders":
+		return s.signed(s.futuresCancelAllOpenOrders)
+	case "GET /fapi/v1/allOrders":
+		return s.signed(s.futuresAllOrders)
+	case "GET /fapi/v1/userTrades":
+		return s.signed(s.futuresUserTrades)
+	case "POST /fapi/v1/leverage":
+		return s.signed(s.futuresChangeLeverage)
+	case "GET /fapi/v2/account", "GET /fapi/v3/account":
+		return s.signed(s.futuresAccount)
+	case "GET /fapi/v2/balance", "GET /fapi/v3/balance":
+		return s.signed(s.futuresBalance)
+	case "GET /fapi/v2/positionRisk":
+		return s.signed(s.futuresPositionRisk)
+	case "GET /fapi/v3/positionRisk":
+		return s.signed(s.futuresPositionRiskV3)
+	case "POST /fapi/v1/listenKey":
+		return s.withAPIKey(s.startUserStream(MarketFutures))
+	case "PUT /fapi/v1/listenKey":
+		return s.withAPIKey(s.keepaliveUserStream)
+	case "DELETE /fapi/v1/listenKey":
+		return s.withAPIKey(s.closeUserStream(MarketFutures))
+	}
+	return nil
+}
+
+func (s *Server) futuresExchangeInfo(r *request) (any, error) {
+	info := &futures.ExchangeInfo{
+		Timezone:   "UTC",
+		ServerTime: now(),
+		RateLimits: []futures.RateLimit{{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: int64(s.limits.limit)}},
+		Symbols:    []futures.Symbol{},
+	}
+	for _, sym := range s.futures.sortedSymbols() {
+		filters := []map[string]any{
+			{"filterType": "PRICE_FILTER", "minPrice": sym.TickSize, "maxPrice": "1000000", "tickSize": sym.TickSize},
+			{"filterType": "LOT_SIZE", "minQty": sym.MinQuantity, "maxQty": "9000000", "stepSize": sym.StepSize},
+			{"filterType": "MARKET_LOT_SIZE", "minQty": sym.MinQuantity, "maxQty": "9000000", "stepSize": sym.StepSize},
+		}
+		if sym.minNotional.IsPositive() {
+			filters = append(filters, map[string]any{"filterType": "MIN_NOTIONAL", "notional": sym.MinNotional})
+		}
+		info.Symbols = append(info.Symbols, futures.Symbol{
+			Symbol:             sym.Symbol,
+			Pair:               sym.Symbol,
+			ContractType:       futures.ContractTypePerpetual,
+			Status:             "TRADING",
+			PricePrecision:     int(-sym.tick.Exponent()),
+			QuantityPrecision:  int(-sym.step.Exponent()),
+			BaseAssetPrecision: 8,
+			QuotePrecision:     8,
+			OrderType:          []futures.OrderType{futures.OrderTypeLimit, futures.OrderTypeMarket},
+			TimeInForce: []futures.TimeInForceType{futures.TimeInForceTypeGTC, futures.TimeInForceTypeIOC,
+				futures.TimeInForceTypeFOK, futures.TimeInForceTypeGTX},
+			Filters:     filters,
+			QuoteAsset:  sym.QuoteAsset,
+			MarginAsset: sym.QuoteAsset,
+			BaseAsset:   sym.BaseAsset,
+		})
+	}
+	return info, nil
+}
+
+func (s *Server) futuresDepth(r *request) (any, error) {
+	sym, err := s.futures.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	bids, asks := sym.depth(depthLimit(r))
+	t := now()
+	return map[string]any{
+		"lastUpdateId": sym.lastUpdateID,
+		"E":            t,
+		"T":            t,
+		"bids":         levelsJSON(bids),
+		"asks":         levelsJSON(asks),
+	}, nil
+}
+
+func (s *Server) futuresPremiumIndex(r *request) (any, error) {
+	return tickers(s.futures, r, func(sym *symbol) map[string]any {
+		return map[string]any{
+			"symbol":               sym.Symbol,
+			"markPrice":            sym.mark().String(),
+			"indexPrice":           sym.mark().String(),
+			"estimatedSettlePrice": sym.mark().String(),
+			"lastFundingRate":      "0",
+			"nextFundingTime":      0,
+			"interestRate":         "0",
+			"time":                 now(),
+		}
+	})
+}
+
+func (s *Server) futuresTickerPrice(r *request) (any, error) {
+	return tickers(s.futures, r, func(sym *symbol) map[string]any {
+		return map[string]any{"symbol": sym.Symbol, "price": sym.lastPrice.String(), "time": now()}
+	})
+}
+
+func (s *Server) futuresBookTicker(r *request) (any, error) {
+	return tickers(s.futures, r, func(sym *symbol) map[string]any {
+		res := bookTicker(sym)
+		res["time"] = now()
+		return res
+	})
+}
+
+// futuresMargin return the wallet balance, the unrealized profit and the initial margins of the
+// positions and of the open orders in the margin asset
+func (s *Server) futuresMargin(a *Account, asset string) (wallet, unrealized, positionMargin, orderMargin decimal.Decimal) {
+	wallet = a.wallet[asset]
+	for name, p := range a.positions {
+		sym, ok := s.futures.symbols[name]
+		if !ok || sym.QuoteAsset != asset {
+			continue
+		}
+		mark := sym.mark()
+		unrealized = unrealized.Add(p.unrealized(mark))
+		positionMargin = positionMargin.Add(p.amount.Abs().Mul(mark).Div(decimal.NewFromInt(int64(a.symbolLeverage(name)))))
+	}
+	for _, o := range s.futures.accountOrders(a, "", true) {
+		sym := s.futures.symbols[o.symbol]
+		if o.reduceOnly || sym.QuoteAsset != asset {
+			continue
+		}
+		orderMargin = orderMargin.Add(o.remaining().Mul(o.price).Div(decimal.NewFromInt(int64(a.symbolLeverage(o.symbol)))))
+	}
+	return wallet, unrealized, positionMargin, orderMargin
+}
+
+func (s *Server) futuresAvailable(a *Account, asset string) decimal.Decimal {
+	wallet, unrealized, positionMargin, orderMargin := s.futuresMargin(a, asset)
+	return wallet.Add(unrealized).Sub(positionMargin).Sub(orderMargin)
+}
+
+func signedQty(side string, qty decimal.Decimal) decimal.Decimal {
+	if side == sideSell {
+		return qty.Neg()
+	}
+	return qty
+}
+
+// futuresValidateOrder parse the order of r and check it against the filters, the position and
+// the available margin
+func (s *Server) futuresValidateOrder(a *Account, r *request) (*symbol, *order, error) {
+	sym, o, err := s.parseOrder(s.futures, a, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := sym.checkFilters(o, sym.notionalPrice(o), "MIN_NOTIONAL"); err != nil {
+		return nil, nil, err
+	}
+	if a.house {
+		return sym, o, nil
+	}
+	amount := a.position(sym.Symbol).amount
+	if o.reduceOnly {
+		if amount.IsZero() || amount.Sign() == signedQty(o.side, o.origQty).Sign() {
+			return nil, nil, errReduceOnlyRejected
+		}
+		o.origQty = decimal.Min(o.origQty, amount.Abs())
+		return sym, o, nil
+	}
+	// only the quantity increasing the position requires margin
+	increase := amount.Add(signedQty(o.side, o.origQty)).Abs().Sub(amount.Abs())
+	if !increase.IsPositive() {
+		return sym, o, nil
+	}
+	price := o.price
+	if o.orderType == typeMarket {
+		if qty, quote := sym.available(o); qty.IsPositive() {
+			price = quote.Div(qty)
+		} else {
+			price = sym.mark()
+		}
+	}
+	required := increase.Mul(price).Div(decimal.NewFromInt(int64(a.symbolLeverage(sym.Symbol))))
+	if s.futuresAvailable(a, sym.QuoteAsset).LessThan(required) {
+		return nil, nil, errMarginInsufficient
+	}
+	return sym, o, nil
+}
+
+func (s *Server) futuresCreateOrder(a *Account, r *request) (any, error) {
+	sym, o, err := s.futuresValidateOrder(a, r)
+	if err != nil {
+		return nil, err
+	}
+	t := now()
+	s.futures.addOrder(o, t)
+	s.futuresPlace(sym, o, t)
+	return futuresCreateOrderResponse(o), nil
+}
+
+// futuresPlace match the order, the positions of the accounts of the fills are updated and the
+// events are pushed to the streams
+func (s *Server) futuresPlace(sym *symbol, o *order, t int64) []*fill {
+	s.pushFuturesOrder(o, "NEW", nil, t)
+	if o.timeInForce == tifGTX && sym.wouldTake(o) {
+		o.status = statusExpired
+		s.pushFuturesOrder(o, "EXPIRED", nil, t)
+		return nil
+	}
+	if sym.wouldKill(o) {
+		o.status = statusExpired
+		s.pushFuturesOrder(o, "EXPIRED", nil, t)
+		return nil
+	}
+
+	fills := s.futures.match(sym, o, t)
+	changed := map[*Account]bool{}
+	for _, f := range fills {
+		f.takerPnl = s.futuresApplyFill(sym, f.taker, f)
+		f.makerPnl = s.futuresApplyFill(sym, f.maker, f)
+		for _, x := range []*order{f.taker, f.maker} {
+			changed[x.account] = true
+			s.pushFuturesOrder(x, "TRADE", f, t)
+		}
+	}
+	if o.status == statusExpired {
+		s.pushFuturesOrder(o, "EXPIRED", nil, t)
+	}
+	for account := range changed {
+		s.pushFuturesAccount(account, sym, t)
+	}
+	s.publishTrades(MarketFutures, sym, fills)
+	s.publishBook(MarketFutures, sym, t)
+	return fills
+}
+
+// futuresApplyFill update the position and the wallet of the account of x with the fill and
+// return the realized profit
+func (s *Server) futuresApplyFill(sym *symbol, x *order, f *fill) decimal.Decimal {
+	a := x.account
+	if a.house {
+		return decimal.Zero
+	}
+	realized := a.position(sym.Symbol).trade(signedQty(x.side, f.qty), f.price)
+	a.wallet[sym.QuoteAsset] = a.wallet[sym.QuoteAsset].Add(realized)
+	return realized
+}
+
+func (s *Server) futuresCancel(sym *symbol, o *order, t int64) {
+	sym.remove(o)
+	o.status = statusCanceled
+	o.updateTime = t
+	s.pushFuturesOrder(o, "CANCELED", nil, t)
+	s.publishBook(MarketFutures, sym, t)
+}
+
+func (s *Server) futuresGetOrder(a *Account, r *request) (any, error) {
+	o, err := s.futures.orderRef(a, r)
+	if err != nil {
+		return nil, err
+	}
+	return futuresOrder(o), nil
+}
+
+func (s *Server) futuresCancelOrder(a *Account, r *request) (any, error) {
+	o, err := s.futures.orderRef(a, r)
+	if err == errOrderNotFound || (err == nil && !o.isOpen()) {
+		return nil, errUnknownOrder
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.futuresCancel(s.futures.symbols[o.symbol], o, now())
+	return futuresOrder(o), nil
+}
+
+func (s *Server) futuresOpenOrders(a *Account, r *request) (any, error) {
+	res := []*futures.Order{}
+	for _, o := range s.futures.accountOrders(a, r.get("symbol"), true) {
+		res = append(res, futuresOrder(o))
+	}
+	return res, nil
+}
+
+func (s *Server) futuresCancelAllOpenOrders(a *Account, r *request) (any, error) {
+	sym, err := s.futures.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	t := now()
+	for _, o := range s.futures.accountOrders(a, sym.Symbol, true) {
+		s.futuresCancel(sym, o, t)
+	}
+	return map[string]any{"code": 200, "msg": "The operation of cancel all open order is done."}, nil
+}
+
+func (s *Server) futuresAllOrders(a *Account, r *request) (any, error) {
+	sym, err := s.futures.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	res := []*futures.Order{}
+	for _, o := range s.futures.accountOrders(a, sym.Symbol, false) {
+		res = append(res, futuresOrder(o))
+	}
+	return res, nil
+}
+
+func (s *Server) futuresUserTrades(a *Account, r *request) (any, error) {
+	sym, err := s.futures.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	res := []*futures.AccountTrade{}
+	for _, f := range s.futures.accountFills(a, sym.Symbol) {
+		for _, x := range []*order{f.maker, f.taker} {
+			if x.account != a {
+				continue
+			}
+			pnl := f.takerPnl
+			if x == f.maker {
+				pnl = f.makerPnl
+			}
+			res = append(res, &futures.AccountTrade{
+				Buyer:           x.side == sideBuy,
+				Commission:      "0",
+				CommissionAsset: sym.QuoteAsset,
+				ID:              f.tradeID,
+				Maker:           x == f.maker,
+				OrderID:         x.id,
+				Price:           f.price.String(),
+				Quantity:        f.qty.String(),
+				QuoteQuantity:   f.price.Mul(f.qty).String(),
+				RealizedPnl:     pnl.String(),
+				Side:            futures.SideType(x.side),
+				PositionSide:    futures.PositionSideTypeBoth,
+				Symbol:          sym.Symbol,
+				Time:            f.time,
+			})
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) futuresChangeLeverage(a *Account, r *request) (any, error) {
+	sym, err := s.futures.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	leverage, err := strconv.Atoi(r.get("leverage"))
+	if err != nil || leverage < 1 || leverage > 125 {
+		return nil, newAPIError(http.StatusBadRequest, -4028, "Leverage is not valid")
+	}
+	a.leverage[sym.Symbol] = leverage
+	return &futures.SymbolLeverage{Leverage: leverage, MaxNotionalValue: "1000000", Symbol: sym.Symbol}, nil
+}
+
+func (s *Server) futuresAccount(a *Account, r *request) (any, error) {
+	t := now()
+	res := &futures.Account{
+		Assets:     []*futures.AccountAsset{},
+		CanTrade:   true,
+		UpdateTime: t,
+		Positions:  []*futures.AccountPosition{},
+	}
+	var totalWallet, totalUnrealized, totalPosition, totalOrder, totalAvailable decimal.Decimal
+	for _, asset := range a.sortedWalletAssets() {
+		wallet, unrealized, positionMargin, orderMargin := s.futuresMargin(a, asset)
+		available := wallet.Add(unrealized).Sub(positionMargin).Sub(orderMargin)
+		res.Assets = append(res.Assets, &futures.AccountAsset{
+			Asset:                  asset,
+			InitialMargin:          positionMargin.Add(orderMargin).String(),
+			MaintMargin:            "0",
+			MarginBalance:          wallet.Add(unrealized).String(),
+			MaxWithdrawAmount:      available.String(),
+			OpenOrderInitialMargin: orderMargin.String(),
+			PositionInitialMargin:  positionMargin.String(),
+			UnrealizedProfit:       unrealized.String(),
+			WalletBalance:          wallet.String(),
+			CrossWalletBalance:     wallet.String(),
+			CrossUnPnl:             unrealized.String(),
+			AvailableBalance:       available.String(),
+			MarginAvailable:        true,
+			UpdateTime:             t,
+		})
+		totalWallet = totalWallet.Add(wallet)
+		totalUnrealized = totalUnrealized.Add(unrealized)
+		totalPosition = totalPosition.Add(positionMargin)
+		totalOrder = totalOrder.Add(orderMargin)
+		totalAvailable = totalAvailable.Add(available)
+	}
+	res.TotalInitialMargin = totalPosition.Add(totalOrder).String()
+	res.TotalMaintMargin = "0"
+	res.TotalWalletBalance = totalWallet.String()
+	res.TotalUnrealizedProfit = totalUnrealized.String()
+	res.TotalMarginBalance = totalWallet.Add(totalUnrealized).String()
+	res.TotalPositionInitialMargin = totalPosition.String()
+	res.TotalOpenOrderInitialMargin = totalOrder.String()
+	res.TotalCrossWalletBalance = totalWallet.String()
+	res.TotalCrossUnPnl = totalUnrealized.String()
+	res.AvailableBalance = totalAvailable.String()
+	res.MaxWithdrawAmount = totalAvailable.String()
+	for _, sym := range s.futures.sortedSymbols() {
+		p := a.position(sym.Symbol)
+		leverage := decimal.NewFromInt(int64(a.symbolLeverage(sym.Symbol)))
+		notional := p.amount.Mul(sym.mark())
+		res.Positions = append(res.Positions, &futures.AccountPosition{
+			Leverage:               leverage.String(),
+			InitialMargin:          notional.Abs().Div(leverage).String(),
+			MaintMargin:            "0",
+			OpenOrderInitialMargin: "0",
+			PositionInitialMargin:  notional.Abs().Div(leverage).String(),
+			Symbol:                 sym.Symbol,
+			UnrealizedProfit:       p.unrealized(sym.mark()).String(),
+			EntryPrice:             p.entryPrice.String(),
+			MaxNotional:            "1000000",
+			PositionSide:           futures.PositionSideTypeBoth,
+			PositionAmt:            p.amount.String(),
+			Notional:               notional.String(),
+			BidNotional:            "0",
+			AskNotional:            "0",
+			IsolatedWallet:         "0",
+			UpdateTime:             t,
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) futuresBalance(a *Account, r *request) (any, error) {
+	res := []*futures.Balance{}
+	for _, asset := range a.sortedWalletAssets() {
+		wallet, unrealized, _, _ := s.futuresMargin(a, asset)
+		available := s.futuresAvailable(a, asset)
+		res = append(res, &futures.Balance{
+			AccountAlias:       "sim",
+			Asset:              asset,
+			Balance:            wallet.String(),
+			CrossWalletBalance: wallet.String(),
+			CrossUnPnl:         unrealized.String(),
+			AvailableBalance:   available.String(),
+			MaxWithdrawAmount:  available.String(),
+			MarginAvailable:    true,
+			UpdateTime:         now(),
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) futuresPositionRisk(a *Account, r *request) (any, error) {
+	res := []*futures.PositionRisk{}
+	for _, sym := range s.futures.sortedSymbols() {
+		if name := r.get("symbol"); name != "" && name != sym.Symbol {
+			continue
+		}
+		p := a.position(sym.Symbol)
+		res = append(res, &futures.PositionRisk{
+			EntryPrice:       p.entryPrice.String(),
+			BreakEvenPrice:   p.entryPrice.String(),
+			MarginType:       "cross",
+			IsAutoAddMargin:  "false",
+			IsolatedMargin:   "0",
+			Leverage:         strconv.Itoa(a.symbolLeverage(sym.Symbol)),
+			LiquidationPrice: "0",
+			MarkPrice:        sym.mark().String(),
+			MaxNotionalValue: "1000000",
+			PositionAmt:      p.amount.String(),
+			Symbol:           sym.Symbol,
+			UnRealizedProfit: p.unrealized(sym.mark()).String(),
+			PositionSide:     string(futures.PositionSideTypeBoth),
+			Notional:         p.amount.Mul(sym.mark()).String(),
+			IsolatedWallet:   "0",
+		})
+	}
+	return res, nil
+}
+
+// futuresPositionRiskV3 return the open positions only, like the v3 endpoint
+func (s *Server) futuresPositionRiskV3(a *Account, r *request) (any, error) {
+	res := []*futures.PositionRiskV3{}
+	for _, sym := range s.futures.sortedSymbols() {
+		p := a.position(sym.Symbol)
+		if name := r.get("symbol"); (name != "" && name != sym.Symbol) || p.amount.IsZero() {
+			continue
+		}
+		notional := p.amount.Mul(sym.mark())
+		margin := notional.Abs().Div(decimal.NewFromInt(int64(a.symbolLeverage(sym.Symbol))))
+		res = append(res, &futures.PositionRiskV3{
+			Symbol:                 sym.Symbol,
+			PositionSide:           string(futures.PositionSideTypeBoth),
+			PositionAmt:            p.amount.String(),
+			EntryPrice:             p.entryPrice.String(),
+			BreakEvenPrice:         p.entryPrice.String(),
+			MarkPrice:              sym.mark().String(),
+			UnRealizedProfit:       p.unrealized(sym.mark()).String(),
+			LiquidationPrice:       "0",
+			IsolatedMargin:         "0",
+			Notional:               notional.String(),
+			MarginAsset:            sym.QuoteAsset,
+			IsolatedWallet:         "0",
+			InitialMargin:          margin.String(),
+			MaintMargin:            "0",
+			PositionInitialMargin:  margin.String(),
+			OpenOrderInitialMargin: "0",
+			BidNotional:            "0",
+			AskNotional:            "0",
+			UpdateTime:             now(),
+		})
+	}
+	return res, nil
+}
+
+func futuresOrder(o *order) *futures.Order {
+	return &futures.Order{
+		Symbol:                  o.symbol,
+		OrderID:                 o.id,
+		ClientOrderID:           o.clientOrderID,
+		Price:                   o.price.String(),
+		ReduceOnly:              o.reduceOnly,
+		OrigQuantity:            o.origQty.String(),
+		ExecutedQuantity:        o.executedQty.String(),
+		CumQuantity:             o.executedQty.String(),
+		CumQuote:                o.cumQuote.String(),
+		Status:                  futures.OrderStatusType(o.status),
+		TimeInForce:             futures.TimeInForceType(o.timeInForce),
+		Type:                    futures.OrderType(o.orderType),
+		Side:                    futures.SideType(o.side),
+		StopPrice:               "0",
+		Time:                    o.time,
+		UpdateTime:              o.updateTime,
+		WorkingType:             futures.WorkingTypeContractPrice,
+		AvgPrice:                o.avgPrice().String(),
+		OrigType:                futures.OrderType(o.orderType),
+		PositionSide:            futures.PositionSideTypeBoth,
+		PriceMatch:              "NONE",
+		SelfTradePreventionMode: "NONE",
+	}
+}
+
+func futuresCreateOrderResponse(o *order) *futures.CreateOrderResponse {
+	return &futures.CreateOrderResponse{
+		Symbol:                  o.symbol,
+		OrderID:                 o.id,
+		ClientOrderID:           o.clientOrderID,
+		Price:                   o.price.String(),
+		OrigQuantity:            o.origQty.String(),
+		ExecutedQuantity:        o.executedQty.String(),
+		CumQuote:                o.cumQuote.String(),
+		ReduceOnly:              o.reduceOnly,
+		Status:                  futures.OrderStatusType(o.status),
+		StopPrice:               "0",
+		TimeInForce:             futures.TimeInForceType(o.timeInForce),
+		Type:                    futures.OrderType(o.orderType),
+		Side:                    futures.SideType(o.side),
+		UpdateTime:              o.updateTime,
+		WorkingType:             futures.WorkingTypeContractPrice,
+		AvgPrice:                o.avgPrice().String(),
+		PositionSide:            futures.PositionSideTypeBoth,
+		PriceMatch:              "NONE",
+		SelfTradePreventionMode: "NONE",
+		CumQty:                  o.executedQty.String(),
+		OrigType:                futures.OrderType(o.orderType),
+	}
+}
+
+// pushFuturesOrder push an ORDER_TRADE_UPDATE of o to the user data streams of its account
+func (s *Server) pushFuturesOrder(o *order, executionType string, f *fill, t int64) {
+	if o.account.house {
+		return
+	}
+	update := map[string]any{
+		"s":   o.symbol,
+		"c":   o.clientOrderID,
+		"S":   o.side,
+		"o":   o.orderType,
+		"f":   o.timeInForce,
+		"q":   o.origQty.String(),
+		"p":   o.price.String(),
+		"ap":  o.avgPrice().String(),
+		"sp":  "0",
+		"x":   executionType,
+		"X":   o.status,
+		"i":   o.id,
+		"l":   "0",
+		"z":   o.executedQty.String(),
+		"L":   "0",
+		"N":   s.futures.symbols[o.symbol].QuoteAsset,
+		"n":   "0",
+		"T":   t,
+		"t":   0,
+		"b":   "0",
+		"a":   "0",
+		"m":   false,
+		"R":   o.reduceOnly,
+		"wt":  string(futures.WorkingTypeContractPrice),
+		"ot":  o.orderType,
+		"ps":  string(futures.PositionSideTypeBoth),
+		"cp":  false,
+		"rp":  "0",
+		"pP":  false,
+		"V":   "NONE",
+		"pm":  "NONE",
+		"gtd": 0,
+	}
+	if f != nil {
+		update["l"] = f.qty.String()
+		update["L"] = f.price.String()
+		update["t"] = f.tradeID
+		update["m"] = o == f.maker
+		if o == f.maker {
+			update["rp"] = f.makerPnl.String()
+		} else {
+			update["rp"] = f.takerPnl.String()
+		}
+	}
+	s.hub.publishUser(MarketFutures, o.account, map[string]any{
+		"e": "ORDER_TRADE_UPDATE",
+		"E": t,
+		"T": t,
+		"o": update,
+	})
+}
+
+// pushFuturesAccount push an ACCOUNT_UPDATE with the wallet balance and the position of sym
+func (s *Server) pushFuturesAccount(a *Account, sym *symbol, t int64) {
+	if a.house {
+		return
+	}
+	p := a.position(sym.Symbol)
+	wallet := a.wallet[sym.QuoteAsset].String()
+	s.hub.publishUser(MarketFutures, a, map[string]any{
+		"e": "ACCOUNT_UPDATE",
+		"E": t,
+		"T": t,
+		"a": map[string]any{
+			"m": string(futures.UserDataEventReasonTypeOrder),
+			"B": []map[string]string{{"a": sym.QuoteAsset, "wb": wallet, "cw": wallet, "bc": "0"}},
+			"P": []map[string]string{{
+				"s":  sym.Symbol,
+				"pa": p.amount.String(),
+				"ep": p.entryPrice.String(),
+				"cr": p.realized.String(),
+				"up": p.unrealized(sym.mark()).String(),
+				"mt": "cross",
+				"iw": "0",
+				"ps": string(futures.PositionSideTypeBoth),
+			}},
+		},
+	})
+}
@@ -0,0 +1,243 @@
+package binancetest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+type accountHandlerFunc func(a *Account, r *request) (any, error)
+
+// public lock the server for a handler without authentication
+func (s *Server) public(h handlerFunc) handlerFunc {
+	return func(r *request) (any, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return h(r)
+	}
+}
+
+// signed verify the signature of the request and lock the server for the handler
+func (s *Server) signed(h accountHandlerFunc) handlerFunc {
+	return func(r *request) (any, error) {
+		a, err := s.verify(r)
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return h(a, r)
+	}
+}
+
+// withAPIKey authenticate the request by its API key and lock the server for the handler
+func (s *Server) withAPIKey(h accountHandlerFunc) handlerFunc {
+	return func(r *request) (any, error) {
+		a, err := s.authenticate(r)
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return h(a, r)
+	}
+}
+
+func ping(r *request) (any, error) {
+	return struct{}{}, nil
+}
+
+func serverTime(r *request) (any, error) {
+	return map[string]int64{"serverTime": now()}, nil
+}
+
+// listenKey define a listen key of a user data stream
+type listenKey struct {
+	account *Account
+	market  Market
+}
+
+func (s *Server) startUserStream(market Market) accountHandlerFunc {
+	return func(a *Account, r *request) (any, error) {
+		key := newID()
+		s.listenKeys[key] = &listenKey{account: a, market: market}
+		return map[string]string{"listenKey": key}, nil
+	}
+}
+
+// keepaliveUserStream check the listen key of the request, the futures requests have no listen key
+func (s *Server) keepaliveUserStream(a *Account, r *request) (any, error) {
+	if key := r.get("listenKey"); key != "" {
+		if lk, ok := s.listenKeys[key]; !ok || lk.account != a {
+			return nil, errUnknownListenKey
+		}
+	}
+	return struct{}{}, nil
+}
+
+// closeUserStream close the listen key of the request, or all the listen keys of the account in
+// market if there is none
+func (s *Server) closeUserStream(market Market) accountHandlerFunc {
+	return func(a *Account, r *request) (any, error) {
+		key := r.get("listenKey")
+		for k, lk := range s.listenKeys {
+			if lk.account == a && lk.market == market && (key == "" || k == key) {
+				delete(s.listenKeys, k)
+				s.hub.closeListenKey(k)
+			}
+		}
+		return struct{}{}, nil
+	}
+}
+
+// parseOrder parse the order parameters of r, the order isn't added to the exchange
+func (s *Server) parseOrder(e *exchange, a *Account, r *request) (*symbol, *order, error) {
+	name, err := r.required("symbol")
+	if err != nil {
+		return nil, nil, err
+	}
+	sym, err := e.symbol(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	o := &order{
+		account:       a,
+		symbol:        name,
+		side:          r.get("side"),
+		orderType:     r.get("type"),
+		timeInForce:   r.get("timeInForce"),
+		clientOrderID: r.get("newClientOrderId"),
+		reduceOnly:    r.get("reduceOnly") == "true",
+		status:        statusNew,
+	}
+	if o.side != sideBuy && o.side != sideSell {
+		return nil, nil, errMandatoryParam("side")
+	}
+	switch o.orderType {
+	case typeLimit:
+		if o.timeInForce == "" {
+			return nil, nil, errMandatoryParam("timeInForce")
+		}
+		valid := o.timeInForce == tifGTC || o.timeInForce == tifIOC || o.timeInForce == tifFOK ||
+			(e.market == MarketFutures && o.timeInForce == tifGTX)
+		if !valid {
+			return nil, nil, newAPIError(http.StatusBadRequest, -1115, "Invalid timeInForce.")
+		}
+	case typeLimitMaker:
+		if e.market == MarketFutures {
+			return nil, nil, errInvalidOrderType
+		}
+		o.timeInForce = tifGTC
+	case typeMarket:
+		o.timeInForce = ""
+	default:
+		return nil, nil, errInvalidOrderType
+	}
+	if o.orderType != typeMarket {
+		price, err := r.required("price")
+		if err != nil {
+			return nil, nil, err
+		}
+		if o.price, err = decimal.NewFromString(price); err != nil {
+			return nil, nil, errMandatoryParam("price")
+		}
+	}
+	if quoteQty := r.get("quoteOrderQty"); quoteQty != "" && e.market == MarketSpot && o.orderType == typeMarket {
+		if o.quoteQty, err = decimal.NewFromString(quoteQty); err != nil || !o.quoteQty.IsPositive() {
+			return nil, nil, errMandatoryParam("quoteOrderQty")
+		}
+	} else {
+		qty, err := r.required("quantity")
+		if err != nil {
+			return nil, nil, err
+		}
+		if o.origQty, err = decimal.NewFromString(qty); err != nil {
+			return nil, nil, errMandatoryParam("quantity")
+		}
+	}
+	if ps := r.get("positionSide"); ps != "" && ps != "BOTH" {
+		return nil, nil, newAPIError(http.StatusBadRequest, -4061, "Order's position side does not match user's setting.")
+	}
+	return sym, o, nil
+}
+
+var (
+	errInvalidOrderType = newAPIError(http.StatusBadRequest, -1116, "Invalid orderType.")
+	errWouldTake        = newAPIError(http.StatusBadRequest, -2010, "Order would immediately match and take.")
+)
+
+// addOrder assign an id to the order and add it to the exchange
+func (e *exchange) addOrder(o *order, t int64) {
+	e.nextOrderID++
+	o.id = e.nextOrderID
+	if o.clientOrderID == "" {
+		o.clientOrderID = "sim" + itoa(o.id)
+	}
+	o.time, o.updateTime = t, t
+	e.orders[o.id] = o
+}
+
+// orderRef return the order of r identified by orderId or origClientOrderId
+func (e *exchange) orderRef(a *Account, r *request) (*order, error) {
+	name, err := r.required("symbol")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := e.symbol(name); err != nil {
+		return nil, err
+	}
+	id, _, err := r.int64("orderId")
+	if err != nil {
+		return nil, err
+	}
+	clientOrderID := r.get("origClientOrderId")
+	if id == 0 && clientOrderID == "" {
+		return nil, errMandatoryParam("orderId")
+	}
+	if o := e.findOrder(a, name, id, clientOrderID); o != nil {
+		return o, nil
+	}
+	return nil, errOrderNotFound
+}
+
+// notionalPrice return the price used to check the notional of o
+func (sym *symbol) notionalPrice(o *order) decimal.Decimal {
+	if o.orderType != typeMarket {
+		return o.price
+	}
+	if book := sym.opposite(o.side); len(book) > 0 {
+		return book[0].price
+	}
+	return sym.mark()
+}
+
+// wouldTake return true if a post only order would trade immediately
+func (sym *symbol) wouldTake(o *order) bool {
+	book := sym.opposite(o.side)
+	return len(book) > 0 && o.crosses(book[0].price)
+}
+
+// wouldKill return true if a fill or kill order cannot be filled entirely
+func (sym *symbol) wouldKill(o *order) bool {
+	if o.timeInForce != tifFOK {
+		return false
+	}
+	qty, _ := sym.available(o)
+	return qty.LessThan(o.origQty)
+}
+
+func symbolsParam(r *request) []string {
+	if name := r.get("symbol"); name != "" {
+		return []string{name}
+	}
+	v := strings.Trim(r.get("symbols"), "[]")
+	if v == "" {
+		return nil
+	}
+	var res []string
+	for _, name := range strings.Split(v, ",") {
+		res = append(res, strings.Trim(name, `"`))
+	}
+	return res
+}
@@ -0,0 +1,423 @@
+// Package binancetest provides an in-process Binance exchange simulator to run the clients of
+// go-binance against in tests, without network access.
+//
+// Server speaks the REST API, the WebSocket API and the market and user data streams of the spot
+// and the USD-M futures markets. The orders are matched by price-time priority against the resting
+// orders of the symbol, and the balances and positions of the accounts are updated by the trades:
+//
+//	s := binancetest.NewServer()
+//	defer s.Close()
+//
+//	s.AddSpotSymbol(binancetest.SymbolConfig{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"})
+//	account, _ := s.AddAccount("key", common.KeyTypeHmac, "secret")
+//	account.SetBalance("USDT", "10000")
+//	_ = s.AddLiquidity(binancetest.MarketSpot, "BTCUSDT", "SELL", "30000", "1")
+//
+//	client := binance.NewClient("key", "secret")
+//	client.SetEndpoints(s.SpotEndpoints())
+//	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+//		Type(binance.OrderTypeMarket).Quantity("0.1").Do(ctx)
+//
+// The simulator supports LIMIT (GTC, IOC, FOK and, for futures, GTX), LIMIT_MAKER and MARKET orders,
+// the futures positions are in one-way mode and no commission is charged.
+package binancetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Market define a market of the simulator
+type Market int
+
+const (
+	MarketSpot Market = iota
+	MarketFutures
+)
+
+// Server is an in-process Binance exchange simulator, create it with NewServer
+type Server struct {
+	srv      *httptest.Server
+	upgrader websocket.Upgrader
+
+	mu         sync.Mutex
+	accounts   map[string]*Account
+	house      *Account
+	spot       *exchange
+	futures    *exchange
+	listenKeys map[string]*listenKey
+
+	hub    *hub
+	faults faults
+	limits rateLimits
+}
+
+// NewServer start a simulator without symbols nor accounts, it must be closed with Close
+func NewServer() *Server {
+	s := &Server{
+		accounts:   make(map[string]*Account),
+		spot:       newExchange(MarketSpot),
+		futures:    newExchange(MarketFutures),
+		listenKeys: make(map[string]*listenKey),
+		hub:        newHub(),
+		limits:     newRateLimits(),
+	}
+	s.house = newAccount(s, "")
+	s.house.house = true
+	s.upgrader.CheckOrigin = func(r *http.Request) bool { return true }
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close close the stream connections and shut down the server
+func (s *Server) Close() {
+	s.hub.closeAll()
+	s.srv.Close()
+}
+
+// URL return the base URL of the REST API, e.g. http://127.0.0.1:12345
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// WsURL return the base URL of the websockets, e.g. ws://127.0.0.1:12345
+func (s *Server) WsURL() string {
+	return "ws" + strings.TrimPrefix(s.srv.URL, "http")
+}
+
+// SpotEndpoints return the endpoints of the spot market of the server, set them on a client with
+// binance.Client.SetEndpoints
+func (s *Server) SpotEndpoints() binance.Endpoints {
+	return binance.NewLocalEndpoints(s.URL())
+}
+
+// FuturesEndpoints return the endpoints of the futures market of the server, set them on a client
+// with futures.Client.SetEndpoints
+func (s *Server) FuturesEndpoints() futures.Endpoints {
+	return futures.NewLocalEndpoints(s.URL())
+}
+
+// Install point the production endpoints of the binance and futures packages to the server and
+// return a function restoring them, for the clients without endpoints. The endpoints are package
+// variables: the tests using Install must not run in parallel with tests using other endpoints,
+// prefer setting SpotEndpoints or FuturesEndpoints on the clients.
+func (s *Server) Install() (restore func()) {
+	spot, fut := s.SpotEndpoints(), s.FuturesEndpoints()
+	vars := []struct {
+		p     *string
+		value string
+	}{
+		{&binance.BaseAPIMainURL, spot.API},
+		{&binance.BaseWsMainURL, spot.Stream},
+		{&binance.BaseCombinedMainURL, spot.CombinedStream},
+		{&binance.BaseWsApiMainURL, spot.WsAPI},
+		{&futures.BaseApiMainUrl, fut.API},
+		{&futures.BaseWsPublicURL, fut.PublicStream},
+		{&futures.BaseWsMarketURL, fut.MarketStream},
+		{&futures.BaseWsPrivateURL, fut.PrivateStream},
+		{&futures.BaseCombinedPublicURL, fut.CombinedPublicStream},
+		{&futures.BaseCombinedMarketURL, fut.CombinedMarketStream},
+		{&futures.BaseCombinedPrivateURL, fut.CombinedPrivateStream},
+		{&futures.BaseWsApiMainURL, fut.WsAPI},
+	}
+	saved := make([]string, len(vars))
+	for i, v := range vars {
+		saved[i] = *v.p
+		*v.p = v.value
+	}
+	return func() {
+		for i, v := range vars {
+			*v.p = saved[i]
+		}
+	}
+}
+
+// SetLatency delay every REST and WebSocket API response by d
+func (s *Server) SetLatency(d time.Duration) {
+	s.faults.setLatency(d)
+}
+
+// FailRequests make the next count REST requests whose path starts with path, or WebSocket API
+// requests whose method starts with path, fail with the HTTP status, e.g. 503
+func (s *Server) FailRequests(path string, status, count int) {
+	s.faults.add(path, status, count)
+}
+
+// DisconnectStreams close all the market stream, user data stream and WebSocket API connections
+func (s *Server) DisconnectStreams() {
+	s.hub.closeAll()
+}
+
+// SetRateLimit set the request weight allowed per minute, the requests over the limit fail with
+// the status 429. The used weight is returned in the X-MBX-USED-WEIGHT-1M header.
+func (s *Server) SetRateLimit(weightPerMinute int) {
+	s.limits.setLimit(weightPerMinute)
+}
+
+// SetRequestWeight set the weight of the REST requests whose path starts with path, or of the
+// WebSocket API requests whose method starts with path. The default weight is 1.
+func (s *Server) SetRequestWeight(path string, weight int) {
+	s.limits.setWeight(path, weight)
+}
+
+// apiError define the error body of the API
+type apiError struct {
+	status int
+	Code   int64  `json:"code"`
+	Msg    string `json:"msg"`
+}
+
+func (e *apiError) Error() string {
+	return e.Msg
+}
+
+func newAPIError(status int, code int64, msg string) *apiError {
+	return &apiError{status: status, Code: code, Msg: msg}
+}
+
+var (
+	errInvalidSymbol       = newAPIError(http.StatusBadRequest, -1121, "Invalid symbol.")
+	errInvalidAPIKey       = newAPIError(http.StatusUnauthorized, -2015, "Invalid API-key, IP, or permissions for action.")
+	errInvalidSignature    = newAPIError(http.StatusBadRequest, -1022, "Signature for this request is not valid.")
+	errInvalidTimestamp    = newAPIError(http.StatusBadRequest, -1021, "Timestamp for this request is outside of the recvWindow.")
+	errInsufficientBalance = newAPIError(http.StatusBadRequest, -2010, "Account has insufficient balance for requested action.")
+	errOrderNotFound       = newAPIError(http.StatusBadRequest, -2013, "Order does not exist.")
+	errUnknownOrder        = newAPIError(http.StatusBadRequest, -2011, "Unknown order sent.")
+	errTooManyRequests     = newAPIError(http.StatusTooManyRequests, -1003, "Too many requests; current limit is exceeded.")
+	errUnknownListenKey    = newAPIError(http.StatusBadRequest, -1125, "This listenKey does not exist.")
+	errUnknown             = newAPIError(http.StatusInternalServerError, -1000, "An unknown error occurred while processing the request.")
+)
+
+func errMandatoryParam(name string) *apiError {
+	return newAPIError(http.StatusBadRequest, -1102, "Mandatory parameter '"+name+"' was not sent, was empty/null, or malformed.")
+}
+
+func errFilterFailure(filter string) *apiError {
+	return newAPIError(http.StatusBadRequest, -1013, "Filter failure: "+filter)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	switch {
+	case path == "/ws-api/v3":
+		s.serveWsAPI(w, r, MarketSpot)
+		return
+	case path == "/ws-fapi/v1":
+		s.serveWsAPI(w, r, MarketFutures)
+		return
+	case path == "/stream" || path == "/ws" || strings.HasPrefix(path, "/ws/"):
+		s.serveStream(w, r, MarketSpot)
+		return
+	case strings.HasPrefix(path, "/fstream/"):
+		s.serveStream(w, r, MarketFutures)
+		return
+	}
+
+	s.faults.delay()
+	header := w.Header()
+	used, allowed := s.limits.use(path)
+	s.limits.setHeaders(header, used)
+	if !allowed {
+		header.Set("Retry-After", "60")
+		writeError(w, errTooManyRequests)
+		return
+	}
+	if status, ok := s.faults.fail(path); ok {
+		writeError(w, newAPIError(status, errUnknown.Code, errUnknown.Msg))
+		return
+	}
+	req, err := newRequest(r)
+	if err != nil {
+		writeError(w, newAPIError(http.StatusBadRequest, -1100, err.Error()))
+		return
+	}
+	var handler handlerFunc
+	if strings.HasPrefix(path, "/fapi/") {
+		handler = s.futuresHandler(r.Method, path)
+	} else {
+		handler = s.spotHandler(r.Method, path)
+	}
+	if handler == nil {
+		writeError(w, newAPIError(http.StatusNotFound, -1000, "Unknown endpoint: "+r.Method+" "+path))
+		return
+	}
+	res, err := handler(req)
+	if err != nil {
+		if e, ok := err.(*apiError); ok {
+			writeError(w, e)
+		} else {
+			writeError(w, newAPIError(http.StatusBadRequest, -1100, err.Error()))
+		}
+		return
+	}
+	if isOrderRequest(r.Method, path) {
+		s.limits.setOrderHeaders(header)
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func isOrderRequest(method, path string) bool {
+	return method == http.MethodPost && (path == "/api/v3/order" || path == "/fapi/v1/order")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, e *apiError) {
+	writeJSON(w, e.status, e)
+}
+
+// faults define the faults injected in the responses
+type faults struct {
+	mu       sync.Mutex
+	latency  time.Duration
+	failures []failure
+}
+
+type failure struct {
+	prefix string
+	status int
+	count  int
+}
+
+func (f *faults) setLatency(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latency = d
+}
+
+func (f *faults) add(prefix string, status, count int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, failure{prefix: prefix, status: status, count: count})
+}
+
+func (f *faults) delay() {
+	f.mu.Lock()
+	d := f.latency
+	f.mu.Unlock()
+	if d > 0 {
+		time.Sleep(d)
+	}
+}
+
+// fail return the status of the failure of the request of path, if any
+func (f *faults) fail(path string) (int, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, failure := range f.failures {
+		if !strings.HasPrefix(path, failure.prefix) {
+			continue
+		}
+		f.failures[i].count--
+		if f.failures[i].count <= 0 {
+			f.failures = append(f.failures[:i], f.failures[i+1:]...)
+		}
+		return failure.status, true
+	}
+	return 0, false
+}
+
+// rateLimits count the request weight and the orders of the current windows
+type rateLimits struct {
+	mu          sync.Mutex
+	limit       int
+	weights     map[string]int
+	window      int64
+	used        int
+	orderWindow int64
+	orders      int
+}
+
+func newRateLimits() rateLimits {
+	return rateLimits{weights: make(map[string]int)}
+}
+
+func (l *rateLimits) setLimit(limit int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limit = limit
+}
+
+func (l *rateLimits) setWeight(prefix string, weight int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.weights[prefix] = weight
+}
+
+// use add the weight of the request of path to the current minute, the request is not allowed
+// if it exceeds the limit
+func (l *rateLimits) use(path string) (used int, allowed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if window := time.Now().Unix() / 60; window != l.window {
+		l.window, l.used = window, 0
+	}
+	weight, matched := 1, 0
+	for prefix, w := range l.weights {
+		if strings.HasPrefix(path, prefix) && len(prefix) > matched {
+			weight, matched = w, len(prefix)
+		}
+	}
+	if l.limit > 0 && l.used+weight > l.limit {
+		return l.used, false
+	}
+	l.used += weight
+	return l.used, true
+}
+
+// countOrder add an order to the current 10 seconds
+func (l *rateLimits) countOrder() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if window := time.Now().Unix() / 10; window != l.orderWindow {
+		l.orderWindow, l.orders = window, 0
+	}
+	l.orders++
+	return l.orders
+}
+
+func (l *rateLimits) setHeaders(header http.Header, used int) {
+	header.Set("X-MBX-USED-WEIGHT-1M", itoa(int64(used)))
+}
+
+func (l *rateLimits) setOrderHeaders(header http.Header) {
+	header.Set("X-MBX-ORDER-COUNT-10S", itoa(int64(l.countOrder())))
+}
+
+func (l *rateLimits) wsRateLimits(used int) []map[string]any {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return []map[string]any{{
+		"rateLimitType": "REQUEST_WEIGHT",
+		"interval":      "MINUTE",
+		"intervalNum":   1,
+		"limit":         l.limit,
+		"count":         used,
+	}}
+}
+
+func now() int64 {
+	return time.Now().UnixMilli()
+}
+
+func mustDecimal(v string) decimal.Decimal {
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
@@ -0,0 +1,475 @@
+package binancetest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+
+	gorilla "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type serverTestSuite struct {
+	suite.Suite
+	server  *Server
+	account *Account
+	ctx     context.Context
+}
+
+func TestServer(t *testing.T) {
+	suite.Run(t, new(serverTestSuite))
+}
+
+func (s *serverTestSuite) SetupTest() {
+	s.server = NewServer()
+	s.ctx = context.Background()
+	s.server.AddSpotSymbol(SymbolConfig{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT"})
+	s.server.AddFuturesSymbol(SymbolConfig{Symbol: "BTCUSDT", BaseAsset: "BTC", QuoteAsset: "USDT", StepSize: "0.001"})
+	var err error
+	s.account, err = s.server.AddAccount("key", common.KeyTypeHmac, "secret")
+	s.Require().NoError(err)
+}
+
+func (s *serverTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *serverTestSuite) spotClient(apiKey, secretKey string) *binance.Client {
+	client := binance.NewClient(apiKey, secretKey)
+	client.SetEndpoints(s.server.SpotEndpoints())
+	return client
+}
+
+func (s *serverTestSuite) futuresClient(apiKey, secretKey string) *futures.Client {
+	client := futures.NewClient(apiKey, secretKey)
+	client.SetEndpoints(s.server.FuturesEndpoints())
+	return client
+}
+
+func (s *serverTestSuite) requireAPIError(err error, code int64) {
+	r := s.Require()
+	r.Error(err)
+	r.True(common.IsAPIError(err), err.Error())
+	r.Equal(code, err.(*common.APIError).Code)
+}
+
+// waitSubscribed wait until n connections are subscribed to the user data stream of the account
+func (s *serverTestSuite) waitSubscribed(n int) {
+	s.Require().Eventually(func() bool {
+		h := s.server.hub
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		count := 0
+		for sub := range h.subs {
+			if sub.account == s.account {
+				count++
+			}
+		}
+		return count == n
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *serverTestSuite) TestSpotMarketOrder() {
+	r := s.Require()
+	s.account.SetBalance("USDT", "100000")
+	r.NoError(s.server.AddLiquidity(MarketSpot, "BTCUSDT", "SELL", "30000", "1"))
+	r.NoError(s.server.AddLiquidity(MarketSpot, "BTCUSDT", "SELL", "30010", "1"))
+
+	client := s.spotClient("key", "secret")
+	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).Quantity("1.5").NewOrderRespType(binance.NewOrderRespTypeFULL).Do(s.ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, order.Status)
+	r.Equal("1.5", order.ExecutedQuantity)
+	r.Equal("45005", order.CummulativeQuoteQuantity)
+	r.Len(order.Fills, 2)
+	r.Equal("30000", order.Fills[0].Price)
+	r.Equal("30010", order.Fills[1].Price)
+
+	free, locked := s.account.Balance("BTC")
+	r.Equal("1.5", free)
+	r.Equal("0", locked)
+	free, _ = s.account.Balance("USDT")
+	r.Equal("54995", free)
+
+	depth, err := client.NewDepthService().Symbol("BTCUSDT").Do(s.ctx)
+	r.NoError(err)
+	r.Len(depth.Asks, 1)
+	r.Equal("30010", depth.Asks[0].Price)
+	r.Equal("0.5", depth.Asks[0].Quantity)
+}
+
+func (s *serverTestSuite) TestSpotLimitOrder() {
+	r := s.Require()
+	s.account.SetBalance("USDT", "1000")
+	client := s.spotClient("key", "secret")
+
+	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).Price("100").Quantity("2").Do(s.ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeNew, order.Status)
+	free, locked := s.account.Balance("USDT")
+	r.Equal("800", free)
+	r.Equal("200", locked)
+
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).Price("100").Quantity("9").Do(s.ctx)
+	s.requireAPIError(err, -2010)
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).Price("100.001").Quantity("1").Do(s.ctx)
+	s.requireAPIError(err, -1013)
+
+	open, err := client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(s.ctx)
+	r.NoError(err)
+	r.Len(open, 1)
+
+	_, err = client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(s.ctx)
+	r.NoError(err)
+	got, err := client.NewGetOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(s.ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeCanceled, got.Status)
+	free, locked = s.account.Balance("USDT")
+	r.Equal("1000", free)
+	r.Equal("0", locked)
+
+	_, err = client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(order.OrderID).Do(s.ctx)
+	s.requireAPIError(err, -2011)
+}
+
+func (s *serverTestSuite) TestSpotFillOrKill() {
+	r := s.Require()
+	s.account.SetBalance("USDT", "100000")
+	r.NoError(s.server.AddLiquidity(MarketSpot, "BTCUSDT", "SELL", "30000", "1"))
+	r.NoError(s.server.AddLiquidity(MarketSpot, "BTCUSDT", "SELL", "30010", "1"))
+
+	client := s.spotClient("key", "secret")
+	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeFOK).Price("30000").Quantity("1.5").Do(s.ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeExpired, order.Status)
+	r.Equal("0", order.ExecutedQuantity)
+	free, locked := s.account.Balance("USDT")
+	r.Equal("100000", free)
+	r.Equal("0", locked)
+
+	order, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeFOK).Price("30010").Quantity("1.5").Do(s.ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, order.Status)
+	r.Equal("1.5", order.ExecutedQuantity)
+}
+
+func (s *serverTestSuite) TestSpotQuoteOrderQty() {
+	r := s.Require()
+	s.account.SetBalance("USDT", "100000")
+	r.NoError(s.server.AddLiquidity(MarketSpot, "BTCUSDT", "SELL", "30000", "1"))
+
+	client := s.spotClient("key", "secret")
+	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).QuoteOrderQty("15000").Do(s.ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeFilled, order.Status)
+	r.Equal("0.5", order.OrigQuantity)
+	r.Equal("0.5", order.ExecutedQuantity)
+
+	// the book runs out before the quote quantity is spent
+	order, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeMarket).QuoteOrderQty("30000").Do(s.ctx)
+	r.NoError(err)
+	r.Equal(binance.OrderStatusTypeExpired, order.Status)
+	r.Equal("0", order.OrigQuantity)
+	r.Equal("0.5", order.ExecutedQuantity)
+	r.Equal("15000", order.CummulativeQuoteQuantity)
+	free, _ := s.account.Balance("USDT")
+	r.Equal("70000", free)
+}
+
+func (s *serverTestSuite) TestSignatures() {
+	r := s.Require()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	r.NoError(err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	r.NoError(err)
+
+	for keyType, key := range map[string]crypto.Signer{common.KeyTypeRsa: rsaKey, common.KeyTypeEd25519: edKey} {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		r.NoError(err)
+		private := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		der, err = x509.MarshalPKIXPublicKey(key.Public())
+		r.NoError(err)
+		public := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+		_, err = s.server.AddAccount(keyType, keyType, public)
+		r.NoError(err)
+		client := s.spotClient(keyType, private)
+		client.KeyType = keyType
+		_, err = client.NewGetAccountService().Do(s.ctx)
+		r.NoError(err, keyType)
+	}
+
+	_, err = s.spotClient("key", "wrong").NewGetAccountService().Do(s.ctx)
+	s.requireAPIError(err, -1022)
+	_, err = s.spotClient("unknown", "secret").NewGetAccountService().Do(s.ctx)
+	s.requireAPIError(err, -2015)
+}
+
+func (s *serverTestSuite) TestSpotUserDataStream() {
+	r := s.Require()
+	s.account.SetBalance("USDT", "1000")
+	client := s.spotClient("key", "secret")
+	listenKey, err := client.NewStartUserStreamService().Do(s.ctx)
+	r.NoError(err)
+
+	events := make(chan *binance.WsUserDataEvent, 16)
+	handler := func(event *binance.WsUserDataEvent) { events <- event }
+	errHandler := func(err error) {}
+	_, stopC, err := client.WsUserDataServe(listenKey, handler, errHandler)
+	r.NoError(err)
+	defer close(stopC)
+	_, stopSignatureC, err := client.WsUserDataServeSignature(handler, errHandler)
+	r.NoError(err)
+	defer close(stopSignatureC)
+	s.waitSubscribed(2)
+
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeBuy).
+		Type(binance.OrderTypeLimit).TimeInForce(binance.TimeInForceTypeGTC).Price("100").Quantity("1").Do(s.ctx)
+	r.NoError(err)
+
+	var orders, balances int
+	for orders+balances < 4 {
+		select {
+		case event := <-events:
+			switch event.Event {
+			case binance.UserDataEventTypeExecutionReport:
+				r.Equal("NEW", event.OrderUpdate.Status)
+				r.Equal("100", event.OrderUpdate.Price)
+				orders++
+			case binance.UserDataEventTypeOutboundAccountPosition:
+				balances++
+			}
+		case <-time.After(time.Second):
+			r.Fail("missing user data events")
+		}
+	}
+	r.Equal(2, orders)
+	r.Equal(2, balances)
+}
+
+func (s *serverTestSuite) TestMarketStreams() {
+	r := s.Require()
+	s.account.SetBalance("BTC", "1")
+	client := s.spotClient("key", "secret")
+	depths := make(chan *binance.WsDepthEvent, 16)
+	_, stopC, err := client.WsDepthServe("BTCUSDT", func(event *binance.WsDepthEvent) { depths <- event }, func(err error) {})
+	r.NoError(err)
+	defer close(stopC)
+	trades := make(chan *binance.WsCombinedTradeEvent, 16)
+	_, stopTradeC, err := client.WsCombinedTradeServe([]string{"BTCUSDT"}, func(event *binance.WsCombinedTradeEvent) { trades <- event }, func(err error) {})
+	r.NoError(err)
+	defer close(stopTradeC)
+
+	r.NoError(s.server.AddLiquidity(MarketSpot, "BTCUSDT", "BUY", "99", "2"))
+	select {
+	case event := <-depths:
+		r.Equal([]binance.Bid{{Price: "99", Quantity: "2"}}, event.Bids)
+		r.Equal(event.FirstUpdateID, event.LastUpdateID)
+	case <-time.After(time.Second):
+		r.Fail("missing depth event")
+	}
+
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(binance.SideTypeSell).
+		Type(binance.OrderTypeMarket).Quantity("0.5").Do(s.ctx)
+	r.NoError(err)
+	select {
+	case event := <-trades:
+		r.Equal("btcusdt@trade", event.Stream)
+		r.Equal("99", event.Data.Price)
+		r.Equal("0.5", event.Data.Quantity)
+		r.True(event.Data.IsBuyerMaker)
+	case <-time.After(time.Second):
+		r.Fail("missing trade event")
+	}
+	select {
+	case event := <-depths:
+		r.Equal([]binance.Bid{{Price: "99", Quantity: "1.5"}}, event.Bids)
+	case <-time.After(time.Second):
+		r.Fail("missing depth event")
+	}
+}
+
+func (s *serverTestSuite) TestFuturesPosition() {
+	r := s.Require()
+	s.account.SetFuturesBalance("USDT", "10000")
+	client := s.futuresClient("key", "secret")
+	r.NoError(s.server.AddLiquidity(MarketFutures, "BTCUSDT", "SELL", "30000", "1"))
+
+	_, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("10").Do(s.ctx)
+	s.requireAPIError(err, -2019)
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeMarket).Quantity("0.1").ReduceOnly(true).Do(s.ctx)
+	s.requireAPIError(err, -2022)
+
+	order, err := client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("0.1").Do(s.ctx)
+	r.NoError(err)
+	r.Equal(futures.OrderStatusTypeFilled, order.Status)
+	amount, entry := s.account.Position("BTCUSDT")
+	r.Equal("0.1", amount)
+	r.Equal("30000", entry)
+
+	r.NoError(s.server.AddLiquidity(MarketFutures, "BTCUSDT", "BUY", "31000", "1"))
+	r.NoError(s.server.SetMarkPrice("BTCUSDT", "31000"))
+	positions, err := client.NewGetPositionRiskV3Service().Symbol("BTCUSDT").Do(s.ctx)
+	r.NoError(err)
+	r.Len(positions, 1)
+	r.Equal("100", positions[0].UnRealizedProfit)
+
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeMarket).Quantity("0.1").ReduceOnly(true).Do(s.ctx)
+	r.NoError(err)
+	amount, _ = s.account.Position("BTCUSDT")
+	r.Equal("0", amount)
+	r.Equal("10100", s.account.FuturesBalance("USDT"))
+
+	trades, err := client.NewListAccountTradeService().Symbol("BTCUSDT").Do(s.ctx)
+	r.NoError(err)
+	r.Len(trades, 2)
+	r.Equal("100", trades[1].RealizedPnl)
+}
+
+func (s *serverTestSuite) TestFuturesUserDataStream() {
+	r := s.Require()
+	s.account.SetFuturesBalance("USDT", "10000")
+	client := s.futuresClient("key", "secret")
+	listenKey, err := client.NewStartUserStreamService().Do(s.ctx)
+	r.NoError(err)
+	events := make(chan *futures.WsUserDataEvent, 16)
+	_, stopC, err := client.WsUserDataServe(listenKey, func(event *futures.WsUserDataEvent) { events <- event }, func(err error) {})
+	r.NoError(err)
+	defer close(stopC)
+	s.waitSubscribed(1)
+
+	r.NoError(s.server.AddLiquidity(MarketFutures, "BTCUSDT", "BUY", "30000", "1"))
+	_, err = client.NewCreateOrderService().Symbol("BTCUSDT").Side(futures.SideTypeSell).
+		Type(futures.OrderTypeMarket).Quantity("0.2").Do(s.ctx)
+	r.NoError(err)
+
+	var statuses []futures.OrderStatusType
+	for len(statuses) < 2 {
+		select {
+		case event := <-events:
+			if event.Event == futures.UserDataEventTypeOrderTradeUpdate {
+				statuses = append(statuses, event.OrderTradeUpdate.Status)
+			}
+		case <-time.After(time.Second):
+			r.Fail("missing order events")
+		}
+	}
+	r.Equal([]futures.OrderStatusType{futures.OrderStatusTypeNew, futures.OrderStatusTypeFilled}, statuses)
+	select {
+	case event := <-events:
+		r.Equal(futures.UserDataEventTypeAccountUpdate, event.Event)
+		r.Equal("-0.2", event.AccountUpdate.Positions[0].Amount)
+	case <-time.After(time.Second):
+		r.Fail("missing account event")
+	}
+}
+
+func (s *serverTestSuite) TestFuturesWsAPI() {
+	r := s.Require()
+	s.account.SetFuturesBalance("USDT", "10000")
+	conn, err := s.futuresClient("key", "secret").WsApiInitReadWriteConn()
+	r.NoError(err)
+	defer conn.Close()
+
+	placeOrder := func(id string, request *futures.OrderPlaceWsRequest) *futures.CreateOrderWsResponse {
+		data, err := websocket.CreateRequest(websocket.NewRequestData(id, "key", "secret", 0, common.KeyTypeHmac),
+			websocket.OrderPlaceFuturesWsApiMethod, request.GetParams())
+		r.NoError(err)
+		r.NoError(conn.WriteMessage(gorilla.TextMessage, data))
+		_, message, err := conn.ReadMessage()
+		r.NoError(err)
+		res := &futures.CreateOrderWsResponse{}
+		r.NoError(json.Unmarshal(message, res))
+		r.Equal(id, res.Id)
+		return res
+	}
+
+	res := placeOrder("1", futures.NewOrderPlaceWsRequest().Symbol("BTCUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeLimit).TimeInForce(futures.TimeInForceTypeGTC).Price("29000").Quantity("0.01"))
+	r.Nil(res.Error)
+	r.Equal(http.StatusOK, res.Status)
+	r.Equal(futures.OrderStatusTypeNew, res.Result.Status)
+	r.Equal("29000", res.Result.Price)
+
+	res = placeOrder("2", futures.NewOrderPlaceWsRequest().Symbol("ETHUSDT").Side(futures.SideTypeBuy).
+		Type(futures.OrderTypeMarket).Quantity("1"))
+	r.NotNil(res.Error)
+	r.Equal(int64(-1121), res.Error.Code)
+}
+
+func (s *serverTestSuite) TestRateLimit() {
+	r := s.Require()
+	s.server.SetRateLimit(3)
+	s.server.SetRequestWeight("/api/v3/depth", 2)
+	client := s.spotClient("key", "secret")
+	r.NoError(client.NewPingService().Do(s.ctx))
+
+	res, err := http.Get(s.server.URL() + "/api/v3/depth?symbol=BTCUSDT")
+	r.NoError(err)
+	res.Body.Close()
+	r.Equal(http.StatusOK, res.StatusCode)
+	r.Equal("3", res.Header.Get("X-MBX-USED-WEIGHT-1M"))
+
+	err = client.NewPingService().Do(s.ctx)
+	s.requireAPIError(err, -1003)
+	r.True(common.IsRateLimited(err))
+}
+
+func (s *serverTestSuite) TestFailRequests() {
+	r := s.Require()
+	s.server.FailRequests("/api/v3/ping", http.StatusServiceUnavailable, 1)
+	client := s.spotClient("key", "secret")
+	err := client.NewPingService().Do(s.ctx)
+	r.Error(err)
+	r.Equal(http.StatusServiceUnavailable, err.(*common.APIError).StatusCode)
+	r.NoError(client.NewPingService().Do(s.ctx))
+}
+
+func (s *serverTestSuite) TestDisconnectStreams() {
+	r := s.Require()
+	client := s.spotClient("key", "secret")
+	doneC, stopC, err := client.WsBookTickerServe("BTCUSDT", func(event *binance.WsBookTickerEvent) {}, func(err error) {})
+	r.NoError(err)
+	defer close(stopC)
+	s.server.DisconnectStreams()
+	select {
+	case <-doneC:
+	case <-time.After(time.Second):
+		r.Fail("stream not disconnected")
+	}
+}
+
+func (s *serverTestSuite) TestInstall() {
+	r := s.Require()
+	restore := s.server.Install()
+	r.NoError(binance.NewClient("key", "secret").NewPingService().Do(s.ctx))
+	_, err := futures.NewClient("key", "secret").NewGetBalanceService().Do(s.ctx)
+	r.NoError(err)
+	restore()
+	r.Equal(binance.MainnetEndpoints.API, binance.BaseAPIMainURL)
+}
@@ -0,0 +1,618 @@
+package binancetest
+
+import (
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2"
+)
+
+// AddSpotSymbol add a spot symbol with an empty order book
+func (s *Server) AddSpotSymbol(cfg SymbolConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.spot.symbols[cfg.Symbol] = newSymbol(cfg)
+}
+
+// AddLiquidity place a GTC limit order of an account with unlimited balances on symbol, it trades
+// with the crossing orders of the book first
+func (s *Server) AddLiquidity(market Market, symbol, side, price, quantity string) error {
+	r := &request{params: map[string][]string{
+		"symbol":      {symbol},
+		"side":        {side},
+		"type":        {typeLimit},
+		"timeInForce": {tifGTC},
+		"price":       {price},
+		"quantity":    {quantity},
+	}}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var err error
+	if market == MarketFutures {
+		_, err = s.futuresCreateOrder(s.house, r)
+	} else {
+		_, err = s.spotCreateOrder(s.house, r)
+	}
+	return err
+}
+
+func (s *Server) spotHandler(method, path string) handlerFunc {
+	switch method + " " + path {
+	case "GET /api/v3/ping":
+		return ping
+	case "GET /api/v3/time":
+		return serverTime
+	case "GET /api/v3/exchangeInfo":
+		return s.public(s.spotExchangeInfo)
+	case "GET /api/v3/depth":
+		return s.public(s.spotDepth)
+	case "GET /api/v3/ticker/price":
+		return s.public(s.spotTickerPrice)
+	case "GET /api/v3/ticker/bookTicker":
+		return s.public(s.spotBookTicker)
+	case "POST /api/v3/order":
+		return s.signed(s.spotCreateOrder)
+	case "POST /api/v3/order/test":
+		return s.signed(s.spotTestOrder)
+	case "GET /api/v3/order":
+		return s.signed(s.spotGetOrder)
+	case "DELETE /api/v3/order":
+		return s.signed(s.spotCancelOrder)
+	case "GET /api/v3/openOrders":
+		return s.signed(s.spotOpenOrders)
+	case "DELETE /api/v3/openOrders":
+		return s.signed(s.spotCancelOpenOrders)
+	case "GET /api/v3/allOrders":
+		return s.signed(s.spotAllOrders)
+	case "GET /api/v3/account":
+		return s.signed(s.spotAccount)
+	case "GET /api/v3/myTrades":
+		return s.signed(s.spotMyTrades)
+	case "POST /api/v3/userDataStream":
+		return s.withAPIKey(s.startUserStream(MarketSpot))
+	case "PUT /api/v3/userDataStream":
+		return s.withAPIKey(s.keepaliveUserStream)
+	case "DELETE /api/v3/userDataStream":
+		return s.withAPIKey(s.closeUserStream(MarketSpot))
+	}
+	return nil
+}
+
+func (s *Server) spotExchangeInfo(r *request) (any, error) {
+	info := &binance.ExchangeInfo{
+		Timezone:   "UTC",
+		ServerTime: now(),
+		RateLimits: []binance.RateLimit{{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: int64(s.limits.limit)}},
+		Symbols:    []binance.Symbol{},
+	}
+	for _, sym := range s.spot.sortedSymbols() {
+		filters := []map[string]any{
+			{"filterType": "PRICE_FILTER", "minPrice": sym.TickSize, "maxPrice": "1000000", "tickSize": sym.TickSize},
+			{"filterType": "LOT_SIZE", "minQty": sym.MinQuantity, "maxQty": "9000000", "stepSize": sym.StepSize},
+		}
+		if sym.minNotional.IsPositive() {
+			filters = append(filters, map[string]any{
+				"filterType": "NOTIONAL", "minNotional": sym.MinNotional, "applyMinToMarket": true,
+				"maxNotional": "9000000", "applyMaxToMarket": false, "avgPriceMins": 5,
+			})
+		}
+		info.Symbols = append(info.Symbols, binance.Symbol{
+			Symbol:                     sym.Symbol,
+			Status:                     "TRADING",
+			BaseAsset:                  sym.BaseAsset,
+			BaseAssetPrecision:         8,
+			QuoteAsset:                 sym.QuoteAsset,
+			QuotePrecision:             8,
+			QuoteAssetPrecision:        8,
+			BaseCommissionPrecision:    8,
+			QuoteCommissionPrecision:   8,
+			OrderTypes:                 []string{typeLimit, typeLimitMaker, typeMarket},
+			QuoteOrderQtyMarketAllowed: true,
+			IsSpotTradingAllowed:       true,
+			Filters:                    filters,
+			Permissions:                []string{"SPOT"},
+		})
+	}
+	return info, nil
+}
+
+func depthLimit(r *request) int {
+	limit, err := strconv.Atoi(r.get("limit"))
+	if err != nil || limit <= 0 {
+		return 100
+	}
+	return limit
+}
+
+func (s *Server) spotDepth(r *request) (any, error) {
+	sym, err := s.spot.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	bids, asks := sym.depth(depthLimit(r))
+	return map[string]any{
+		"lastUpdateId": sym.lastUpdateID,
+		"bids":         levelsJSON(bids),
+		"asks":         levelsJSON(asks),
+	}, nil
+}
+
+// tickers return the result of f for the symbols of the request, a single object if a symbol is set
+func tickers(e *exchange, r *request, f func(sym *symbol) map[string]any) (any, error) {
+	names := symbolsParam(r)
+	if len(names) == 0 {
+		res := []map[string]any{}
+		for _, sym := range e.sortedSymbols() {
+			res = append(res, f(sym))
+		}
+		return res, nil
+	}
+	res := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		sym, err := e.symbol(name)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, f(sym))
+	}
+	if r.get("symbol") != "" {
+		return res[0], nil
+	}
+	return res, nil
+}
+
+func (s *Server) spotTickerPrice(r *request) (any, error) {
+	return tickers(s.spot, r, func(sym *symbol) map[string]any {
+		return map[string]any{"symbol": sym.Symbol, "price": sym.lastPrice.String()}
+	})
+}
+
+func (s *Server) spotBookTicker(r *request) (any, error) {
+	return tickers(s.spot, r, bookTicker)
+}
+
+func bookTicker(sym *symbol) map[string]any {
+	bids, asks := sym.depth(1)
+	res := map[string]any{"symbol": sym.Symbol, "bidPrice": "0", "bidQty": "0", "askPrice": "0", "askQty": "0"}
+	if len(bids) > 0 {
+		res["bidPrice"], res["bidQty"] = bids[0].price.String(), bids[0].qty.String()
+	}
+	if len(asks) > 0 {
+		res["askPrice"], res["askQty"] = asks[0].price.String(), asks[0].qty.String()
+	}
+	return res
+}
+
+// spotValidateOrder parse the order of r and check it against the filters and the balances
+func (s *Server) spotValidateOrder(a *Account, r *request) (*symbol, *order, error) {
+	sym, o, err := s.parseOrder(s.spot, a, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := sym.checkFilters(o, sym.notionalPrice(o), "NOTIONAL"); err != nil {
+		return nil, nil, err
+	}
+	if o.orderType == typeLimitMaker && sym.wouldTake(o) {
+		return nil, nil, errWouldTake
+	}
+	if a.house {
+		return sym, o, nil
+	}
+	switch {
+	case o.side == sideSell:
+		qty := o.origQty
+		if !o.quoteQty.IsZero() {
+			qty, _ = sym.available(o)
+		}
+		o.locked = qty
+		if a.balance(sym.BaseAsset).free.LessThan(qty) {
+			return nil, nil, errInsufficientBalance
+		}
+	case o.orderType == typeMarket:
+		cost := o.quoteQty
+		if cost.IsZero() {
+			_, cost = sym.available(o)
+		}
+		if a.balance(sym.QuoteAsset).free.LessThan(cost) {
+			return nil, nil, errInsufficientBalance
+		}
+	default:
+		o.locked = o.price.Mul(o.origQty)
+		if a.balance(sym.QuoteAsset).free.LessThan(o.locked) {
+			return nil, nil, errInsufficientBalance
+		}
+	}
+	return sym, o, nil
+}
+
+func (s *Server) spotTestOrder(a *Account, r *request) (any, error) {
+	if _, _, err := s.spotValidateOrder(a, r); err != nil {
+		return nil, err
+	}
+	return struct{}{}, nil
+}
+
+func (s *Server) spotCreateOrder(a *Account, r *request) (any, error) {
+	sym, o, err := s.spotValidateOrder(a, r)
+	if err != nil {
+		return nil, err
+	}
+	t := now()
+	s.spot.addOrder(o, t)
+	fills := s.spotPlace(sym, o, t)
+	switch r.get("newOrderRespType") {
+	case "ACK":
+		return map[string]any{
+			"symbol":        o.symbol,
+			"orderId":       o.id,
+			"orderListId":   -1,
+			"clientOrderId": o.clientOrderID,
+			"transactTime":  t,
+		}, nil
+	case "RESULT":
+		return spotCreateOrderResponse(sym, o, nil, t), nil
+	}
+	return spotCreateOrderResponse(sym, o, fills, t), nil
+}
+
+// spotPlace lock the balance of the order and match it, the balances of the accounts of the fills
+// are updated and the events are pushed to the streams
+func (s *Server) spotPlace(sym *symbol, o *order, t int64) []*fill {
+	a := o.account
+	if !a.house {
+		asset := sym.QuoteAsset
+		if o.side == sideSell {
+			asset = sym.BaseAsset
+		}
+		b := a.balance(asset)
+		b.free, b.locked = b.free.Sub(o.locked), b.locked.Add(o.locked)
+	}
+	s.pushSpotOrder(o, "NEW", nil, t)
+	if sym.wouldKill(o) {
+		o.status = statusExpired
+		s.spotRelease(sym, o)
+		s.pushSpotOrder(o, "EXPIRED", nil, t)
+		return nil
+	}
+
+	fills := s.spot.match(sym, o, t)
+	changed := map[*Account]bool{a: true}
+	for _, f := range fills {
+		for _, x := range []*order{f.taker, f.maker} {
+			s.spotApplyFill(sym, x, f)
+			changed[x.account] = true
+			s.pushSpotOrder(x, "TRADE", f, t)
+		}
+	}
+	for _, f := range fills {
+		if !f.maker.isOpen() {
+			s.spotRelease(sym, f.maker)
+		}
+	}
+	if !o.isOpen() {
+		s.spotRelease(sym, o)
+		if o.status == statusExpired {
+			s.pushSpotOrder(o, "EXPIRED", nil, t)
+		}
+	}
+	for account := range changed {
+		s.pushSpotBalances(account, sym, t)
+	}
+	s.publishTrades(MarketSpot, sym, fills)
+	s.publishBook(MarketSpot, sym, t)
+	return fills
+}
+
+// spotApplyFill update the balances of the account of x with the fill
+func (s *Server) spotApplyFill(sym *symbol, x *order, f *fill) {
+	a := x.account
+	if a.house {
+		return
+	}
+	base, quote := a.balance(sym.BaseAsset), a.balance(sym.QuoteAsset)
+	notional := f.price.Mul(f.qty)
+	if x.side == sideBuy {
+		if x.locked.IsPositive() {
+			// the quote quantity is locked at the price of the order
+			release := x.price.Mul(f.qty)
+			x.locked = x.locked.Sub(release)
+			quote.locked = quote.locked.Sub(release)
+			quote.free = quote.free.Add(release).Sub(notional)
+		} else {
+			quote.free = quote.free.Sub(notional)
+		}
+		base.free = base.free.Add(f.qty)
+		return
+	}
+	x.locked = x.locked.Sub(f.qty)
+	base.locked = base.locked.Sub(f.qty)
+	quote.free = quote.free.Add(notional)
+}
+
+// spotRelease unlock the balance still locked by a closed order
+func (s *Server) spotRelease(sym *symbol, o *order) {
+	if o.account.house || o.locked.IsZero() {
+		return
+	}
+	asset := sym.QuoteAsset
+	if o.side == sideSell {
+		asset = sym.BaseAsset
+	}
+	b := o.account.balance(asset)
+	b.free, b.locked = b.free.Add(o.locked), b.locked.Sub(o.locked)
+	o.locked = decimal.Zero
+}
+
+func (s *Server) spotCancel(sym *symbol, o *order, t int64) {
+	sym.remove(o)
+	o.status = statusCanceled
+	o.updateTime = t
+	s.spotRelease(sym, o)
+	s.pushSpotOrder(o, "CANCELED", nil, t)
+	s.pushSpotBalances(o.account, sym, t)
+	s.publishBook(MarketSpot, sym, t)
+}
+
+func (s *Server) spotGetOrder(a *Account, r *request) (any, error) {
+	o, err := s.spot.orderRef(a, r)
+	if err != nil {
+		return nil, err
+	}
+	return spotOrder(o), nil
+}
+
+func (s *Server) spotCancelOrder(a *Account, r *request) (any, error) {
+	o, err := s.spot.orderRef(a, r)
+	if err == errOrderNotFound || (err == nil && !o.isOpen()) {
+		return nil, errUnknownOrder
+	}
+	if err != nil {
+		return nil, err
+	}
+	t := now()
+	s.spotCancel(s.spot.symbols[o.symbol], o, t)
+	return spotCancelOrderResponse(o, r.get("newClientOrderId"), t), nil
+}
+
+func (s *Server) spotOpenOrders(a *Account, r *request) (any, error) {
+	res := []*binance.Order{}
+	for _, o := range s.spot.accountOrders(a, r.get("symbol"), true) {
+		res = append(res, spotOrder(o))
+	}
+	return res, nil
+}
+
+func (s *Server) spotCancelOpenOrders(a *Account, r *request) (any, error) {
+	sym, err := s.spot.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	t := now()
+	res := []*binance.CancelOrderResponse{}
+	for _, o := range s.spot.accountOrders(a, sym.Symbol, true) {
+		s.spotCancel(sym, o, t)
+		res = append(res, spotCancelOrderResponse(o, "", t))
+	}
+	return res, nil
+}
+
+func (s *Server) spotAllOrders(a *Account, r *request) (any, error) {
+	sym, err := s.spot.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	res := []*binance.Order{}
+	for _, o := range s.spot.accountOrders(a, sym.Symbol, false) {
+		res = append(res, spotOrder(o))
+	}
+	return res, nil
+}
+
+func (s *Server) spotAccount(a *Account, r *request) (any, error) {
+	res := &binance.Account{
+		CanTrade:    true,
+		CanWithdraw: true,
+		CanDeposit:  true,
+		UpdateTime:  uint64(now()),
+		AccountType: "SPOT",
+		Balances:    []binance.Balance{},
+		Permissions: []string{"SPOT"},
+		CommissionRates: binance.CommissionRates{
+			Maker: "0", Taker: "0", Buyer: "0", Seller: "0",
+		},
+	}
+	for _, asset := range a.sortedAssets() {
+		b := a.balances[asset]
+		res.Balances = append(res.Balances, binance.Balance{Asset: asset, Free: b.free.String(), Locked: b.locked.String()})
+	}
+	return res, nil
+}
+
+func (s *Server) spotMyTrades(a *Account, r *request) (any, error) {
+	sym, err := s.spot.symbol(r.get("symbol"))
+	if err != nil {
+		return nil, err
+	}
+	res := []*binance.TradeV3{}
+	for _, f := range s.spot.accountFills(a, sym.Symbol) {
+		// a self-trade is reported for both orders
+		for _, x := range []*order{f.maker, f.taker} {
+			if x.account != a {
+				continue
+			}
+			res = append(res, &binance.TradeV3{
+				ID:              f.tradeID,
+				Symbol:          sym.Symbol,
+				OrderID:         x.id,
+				OrderListId:     -1,
+				Price:           f.price.String(),
+				Quantity:        f.qty.String(),
+				QuoteQuantity:   f.price.Mul(f.qty).String(),
+				Commission:      "0",
+				CommissionAsset: commissionAsset(sym, x.side),
+				Time:            f.time,
+				IsBuyer:         x.side == sideBuy,
+				IsMaker:         x == f.maker,
+				IsBestMatch:     true,
+			})
+		}
+	}
+	return res, nil
+}
+
+// commissionAsset return the asset received by the side, in which the commission is charged
+func commissionAsset(sym *symbol, side string) string {
+	if side == sideBuy {
+		return sym.BaseAsset
+	}
+	return sym.QuoteAsset
+}
+
+func spotOrder(o *order) *binance.Order {
+	return &binance.Order{
+		Symbol:                   o.symbol,
+		OrderID:                  o.id,
+		OrderListId:              -1,
+		ClientOrderID:            o.clientOrderID,
+		Price:                    o.price.String(),
+		OrigQuantity:             o.origQty.String(),
+		ExecutedQuantity:         o.executedQty.String(),
+		CummulativeQuoteQuantity: o.cumQuote.String(),
+		Status:                   binance.OrderStatusType(o.status),
+		TimeInForce:              binance.TimeInForceType(o.timeInForce),
+		Type:                     binance.OrderType(o.orderType),
+		Side:                     binance.SideType(o.side),
+		StopPrice:                "0",
+		IcebergQuantity:          "0",
+		Time:                     o.time,
+		UpdateTime:               o.updateTime,
+		IsWorking:                true,
+		WorkingTime:              o.time,
+		OrigQuoteOrderQuantity:   o.quoteQty.String(),
+		SelfTradePreventionMode:  binance.SelfTradePreventionModeNone,
+	}
+}
+
+func spotCreateOrderResponse(sym *symbol, o *order, fills []*fill, t int64) *binance.CreateOrderResponse {
+	res := &binance.CreateOrderResponse{
+		Symbol:                   o.symbol,
+		OrderID:                  o.id,
+		ClientOrderID:            o.clientOrderID,
+		TransactTime:             t,
+		Price:                    o.price.String(),
+		OrigQuantity:             o.origQty.String(),
+		OrigQuoteOrderQuantity:   o.quoteQty.String(),
+		ExecutedQuantity:         o.executedQty.String(),
+		CummulativeQuoteQuantity: o.cumQuote.String(),
+		Status:                   binance.OrderStatusType(o.status),
+		TimeInForce:              binance.TimeInForceType(o.timeInForce),
+		Type:                     binance.OrderType(o.orderType),
+		Side:                     binance.SideType(o.side),
+		SelfTradePreventionMode:  binance.SelfTradePreventionModeNone,
+	}
+	if fills != nil {
+		res.Fills = []*binance.Fill{}
+		for _, f := range fills {
+			res.Fills = append(res.Fills, &binance.Fill{
+				TradeID:         f.tradeID,
+				Price:           f.price.String(),
+				Quantity:        f.qty.String(),
+				Commission:      "0",
+				CommissionAsset: commissionAsset(sym, o.side),
+			})
+		}
+	}
+	return res
+}
+
+func spotCancelOrderResponse(o *order, clientOrderID string, t int64) *binance.CancelOrderResponse {
+	if clientOrderID == "" {
+		clientOrderID = "cancel" + itoa(o.id)
+	}
+	return &binance.CancelOrderResponse{
+		Symbol:                   o.symbol,
+		OrigClientOrderID:        o.clientOrderID,
+		OrderID:                  o.id,
+		OrderListID:              -1,
+		ClientOrderID:            clientOrderID,
+		TransactTime:             t,
+		Price:                    o.price.String(),
+		OrigQuantity:             o.origQty.String(),
+		OrigQuoteOrderQuantity:   o.quoteQty.String(),
+		ExecutedQuantity:         o.executedQty.String(),
+		CummulativeQuoteQuantity: o.cumQuote.String(),
+		Status:                   binance.OrderStatusType(o.status),
+		TimeInForce:              binance.TimeInForceType(o.timeInForce),
+		Type:                     binance.OrderType(o.orderType),
+		Side:                     binance.SideType(o.side),
+		SelfTradePreventionMode:  binance.SelfTradePreventionModeNone,
+	}
+}
+
+// pushSpotOrder push an executionReport of o to the user data streams of its account
+func (s *Server) pushSpotOrder(o *order, executionType string, f *fill, t int64) {
+	if o.account.house {
+		return
+	}
+	event := map[string]any{
+		"e": "executionReport",
+		"E": t,
+		"s": o.symbol,
+		"c": o.clientOrderID,
+		"S": o.side,
+		"o": o.orderType,
+		"f": o.timeInForce,
+		"q": o.origQty.String(),
+		"p": o.price.String(),
+		"P": "0",
+		"F": "0",
+		"g": -1,
+		"C": "",
+		"x": executionType,
+		"X": o.status,
+		"r": "NONE",
+		"i": o.id,
+		"l": "0",
+		"z": o.executedQty.String(),
+		"L": "0",
+		"n": "0",
+		"N": nil,
+		"T": t,
+		"t": -1,
+		"w": o.isOpen(),
+		"m": false,
+		"O": o.time,
+		"Z": o.cumQuote.String(),
+		"Y": "0",
+		"Q": o.quoteQty.String(),
+		"V": string(binance.SelfTradePreventionModeNone),
+	}
+	if f != nil {
+		event["l"] = f.qty.String()
+		event["L"] = f.price.String()
+		event["Y"] = f.qty.Mul(f.price).String()
+		event["t"] = f.tradeID
+		event["m"] = o == f.maker
+		event["N"] = commissionAsset(s.spot.symbols[o.symbol], o.side)
+	}
+	s.hub.publishUser(MarketSpot, o.account, event)
+}
+
+// pushSpotBalances push an outboundAccountPosition with the balances of the assets of sym
+func (s *Server) pushSpotBalances(a *Account, sym *symbol, t int64) {
+	if a.house {
+		return
+	}
+	var balances []map[string]string
+	for _, asset := range []string{sym.BaseAsset, sym.QuoteAsset} {
+		b := a.balance(asset)
+		balances = append(balances, map[string]string{"a": asset, "f": b.free.String(), "l": b.locked.String()})
+	}
+	s.hub.publishUser(MarketSpot, a, map[string]any{
+		"e": "outboundAccountPosition",
+		"E": t,
+		"u": t,
+		"B": balances,
+	})
+}
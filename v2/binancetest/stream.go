@@ -0,0 +1,460 @@
+package binancetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// sendBufferSize is the number of messages buffered for a connection, a slower reader is
+// disconnected
+const sendBufferSize = 1024
+
+// subscriber define a stream or WebSocket API connection
+type subscriber struct {
+	market Market
+	send   chan []byte
+	done   chan struct{}
+	once   sync.Once
+
+	// the fields below are guarded by the mutex of the hub
+	combined bool
+	// streams maps the normalized names of the subscribed streams to their requested names
+	streams   map[string]string
+	account   *Account
+	listenKey string
+	// wrap is set on the WebSocket API connections subscribed to the user data stream, the events
+	// are sent in a {"subscriptionId":0,"event":...} envelope
+	wrap bool
+}
+
+// newSubscriber init a subscriber, the messages are buffered until its connection is started
+func newSubscriber(market Market) *subscriber {
+	return &subscriber{
+		market:  market,
+		send:    make(chan []byte, sendBufferSize),
+		done:    make(chan struct{}),
+		streams: make(map[string]string),
+	}
+}
+
+// start write the messages to conn until the subscriber is closed
+func (sub *subscriber) start(conn *websocket.Conn) {
+	go func() {
+		defer conn.Close()
+		for {
+			select {
+			case msg := <-sub.send:
+				_ = conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+				if err := conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+					sub.close()
+					return
+				}
+			case <-sub.done:
+				return
+			}
+		}
+	}()
+}
+
+// write queue a message, the connection is closed if its buffer is full
+func (sub *subscriber) write(msg []byte) {
+	select {
+	case <-sub.done:
+	case sub.send <- msg:
+	default:
+		sub.close()
+	}
+}
+
+func (sub *subscriber) writeJSON(v any) {
+	msg, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	sub.write(msg)
+}
+
+// close stop the subscriber, its connection is closed by the writer
+func (sub *subscriber) close() {
+	sub.once.Do(func() { close(sub.done) })
+}
+
+// hub define the connections of the server
+type hub struct {
+	mu   sync.Mutex
+	subs map[*subscriber]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[*subscriber]struct{})}
+}
+
+func (h *hub) add(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subs[sub] = struct{}{}
+}
+
+func (h *hub) remove(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs, sub)
+}
+
+// closeAll close all the connections
+func (h *hub) closeAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		sub.close()
+		delete(h.subs, sub)
+	}
+}
+
+// closeListenKey close the connections of the user data stream of key
+func (h *hub) closeListenKey(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if sub.listenKey == key {
+			sub.close()
+			delete(h.subs, sub)
+		}
+	}
+}
+
+// publish send the event to the connections subscribed to the stream of market
+func (h *hub) publish(market Market, stream string, event any) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var raw []byte
+	for sub := range h.subs {
+		name, ok := sub.streams[stream]
+		if sub.market != market || !ok {
+			continue
+		}
+		if raw == nil {
+			var err error
+			if raw, err = json.Marshal(event); err != nil {
+				return
+			}
+		}
+		if sub.combined {
+			sub.writeJSON(map[string]any{"stream": name, "data": json.RawMessage(raw)})
+		} else {
+			sub.write(raw)
+		}
+	}
+}
+
+// publishUser send the event to the user data streams of the account in market
+func (h *hub) publishUser(market Market, account *Account, event any) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var raw []byte
+	for sub := range h.subs {
+		if sub.market != market || sub.account != account {
+			continue
+		}
+		if raw == nil {
+			var err error
+			if raw, err = json.Marshal(event); err != nil {
+				return
+			}
+		}
+		switch {
+		case sub.wrap:
+			sub.writeJSON(map[string]any{"subscriptionId": 0, "event": json.RawMessage(raw)})
+		case sub.combined:
+			sub.writeJSON(map[string]any{"stream": sub.listenKey, "data": json.RawMessage(raw)})
+		default:
+			sub.write(raw)
+		}
+	}
+}
+
+// streamRate matches the update speed suffix of a stream name, e.g. @100ms or @1s
+var streamRate = regexp.MustCompile(`@\d+m?s$`)
+
+// normalizeStream return the name of a stream without its update speed, in lower case
+func normalizeStream(name string) string {
+	return strings.ToLower(streamRate.ReplaceAllString(name, ""))
+}
+
+// subscribe add the streams to the subscriber, a listen key subscribes to the user data stream of
+// its account
+func (s *Server) subscribe(sub *subscriber, names []string) *apiError {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if lk, ok := s.listenKeys[name]; ok {
+			if lk.market != sub.market {
+				return errUnknownListenKey
+			}
+			sub.account, sub.listenKey = lk.account, name
+			continue
+		}
+		sub.streams[normalizeStream(name)] = name
+	}
+	return nil
+}
+
+func (s *Server) unsubscribe(sub *subscriber, names []string) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	for _, name := range names {
+		if name == sub.listenKey {
+			sub.account, sub.listenKey = nil, ""
+			continue
+		}
+		delete(sub.streams, normalizeStream(name))
+	}
+}
+
+func (s *Server) subscriptions(sub *subscriber) []string {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	res := []string{}
+	for _, name := range sub.streams {
+		res = append(res, name)
+	}
+	if sub.listenKey != "" {
+		res = append(res, sub.listenKey)
+	}
+	return res
+}
+
+// serveStream serve a market or user data stream connection. The raw streams are served on
+// /ws/<stream>, the combined streams on /stream?streams=<stream>/<stream>, the futures paths are
+// prefixed by /fstream/{public,market,private}. The streams can be changed by SUBSCRIBE and
+// UNSUBSCRIBE messages.
+func (s *Server) serveStream(w http.ResponseWriter, r *http.Request, market Market) {
+	path := r.URL.Path
+	if market == MarketFutures {
+		// strip /fstream/<category>
+		parts := strings.SplitN(strings.TrimPrefix(path, "/fstream/"), "/", 2)
+		path = "/"
+		if len(parts) == 2 {
+			path += parts[1]
+		}
+	}
+	var names []string
+	combined := false
+	switch {
+	case path == "/stream":
+		combined = true
+		if streams := r.URL.Query().Get("streams"); streams != "" {
+			names = strings.Split(streams, "/")
+		}
+	case path == "/ws":
+	case strings.HasPrefix(path, "/ws/"):
+		names = strings.Split(strings.TrimPrefix(path, "/ws/"), "/")
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	// the streams are subscribed before the upgrade, the events published meanwhile are buffered
+	sub := newSubscriber(market)
+	sub.combined = combined
+	if err := s.subscribe(sub, names); err != nil {
+		writeError(w, err)
+		return
+	}
+	s.hub.add(sub)
+	defer s.hub.remove(sub)
+	defer sub.close()
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	sub.start(conn)
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req struct {
+			Method string          `json:"method"`
+			Params []string        `json:"params"`
+			ID     json.RawMessage `json:"id"`
+		}
+		if err := json.Unmarshal(msg, &req); err != nil {
+			sub.writeJSON(map[string]any{"error": map[string]any{"code": 2, "msg": "Invalid request"}, "id": nil})
+			continue
+		}
+		var result any
+		switch req.Method {
+		case "SUBSCRIBE":
+			if err := s.subscribe(sub, req.Params); err != nil {
+				sub.writeJSON(map[string]any{"error": err, "id": req.ID})
+				continue
+			}
+		case "UNSUBSCRIBE":
+			s.unsubscribe(sub, req.Params)
+		case "LIST_SUBSCRIPTIONS":
+			result = s.subscriptions(sub)
+		default:
+			sub.writeJSON(map[string]any{"error": map[string]any{"code": 1, "msg": "Unknown method"}, "id": req.ID})
+			continue
+		}
+		sub.writeJSON(map[string]any{"result": result, "id": req.ID})
+	}
+}
+
+// publishBook push the book ticker, the partial depth and the diff depth events of sym after a
+// change of its book
+func (s *Server) publishBook(market Market, sym *symbol, t int64) {
+	name := strings.ToLower(sym.Symbol)
+	bids, asks := sym.depth(0)
+
+	ticker := map[string]any{"u": sym.lastUpdateID, "s": sym.Symbol, "b": "0", "B": "0", "a": "0", "A": "0"}
+	if len(bids) > 0 {
+		ticker["b"], ticker["B"] = bids[0].price.String(), bids[0].qty.String()
+	}
+	if len(asks) > 0 {
+		ticker["a"], ticker["A"] = asks[0].price.String(), asks[0].qty.String()
+	}
+	if market == MarketFutures {
+		ticker["e"], ticker["E"], ticker["T"] = "bookTicker", t, t
+	}
+	s.hub.publish(market, name+"@bookticker", ticker)
+	s.hub.publish(market, "!bookticker", ticker)
+
+	for _, levels := range []int{5, 10, 20} {
+		b, a := bids, asks
+		if len(b) > levels {
+			b = b[:levels]
+		}
+		if len(a) > levels {
+			a = a[:levels]
+		}
+		stream := name + "@depth" + itoa(int64(levels))
+		if market == MarketSpot {
+			s.hub.publish(market, stream, map[string]any{
+				"lastUpdateId": sym.lastUpdateID,
+				"bids":         levelsJSON(b),
+				"asks":         levelsJSON(a),
+			})
+			continue
+		}
+		s.hub.publish(market, stream, map[string]any{
+			"e":  "depthUpdate",
+			"E":  t,
+			"T":  t,
+			"s":  sym.Symbol,
+			"U":  sym.lastUpdateID,
+			"u":  sym.lastUpdateID,
+			"pu": sym.publishedID,
+			"b":  levelsJSON(b),
+			"a":  levelsJSON(a),
+		})
+	}
+
+	if sym.lastUpdateID == sym.publishedID {
+		return
+	}
+	event := map[string]any{
+		"e": "depthUpdate",
+		"E": t,
+		"s": sym.Symbol,
+		"U": sym.publishedID + 1,
+		"u": sym.lastUpdateID,
+		"b": sym.diffLevels(sideBuy, bids),
+		"a": sym.diffLevels(sideSell, asks),
+	}
+	if market == MarketFutures {
+		event["T"], event["pu"] = t, sym.publishedID
+	}
+	sym.publishedID = sym.lastUpdateID
+	s.hub.publish(market, name+"@depth", event)
+}
+
+// diffLevels return the levels of a side of the book changed since the last diff depth event, the
+// removed levels have a zero quantity
+func (sym *symbol) diffLevels(side string, levels []level) [][]string {
+	prev := sym.published[side]
+	current := make(map[string]string, len(levels))
+	res := [][]string{}
+	for _, l := range levels {
+		price, qty := l.price.String(), l.qty.String()
+		current[price] = qty
+		if prev[price] != qty {
+			res = append(res, []string{price, qty})
+		}
+	}
+	for price := range prev {
+		if _, ok := current[price]; !ok {
+			res = append(res, []string{price, "0"})
+		}
+	}
+	sym.published[side] = current
+	return res
+}
+
+// publishTrades push the trade and aggregate trade events of the fills
+func (s *Server) publishTrades(market Market, sym *symbol, fills []*fill) {
+	name := strings.ToLower(sym.Symbol)
+	for _, f := range fills {
+		buyer, seller := f.taker, f.maker
+		if f.taker.side == sideSell {
+			buyer, seller = f.maker, f.taker
+		}
+		buyerMaker := buyer == f.maker
+		agg := map[string]any{
+			"e": "aggTrade",
+			"E": f.time,
+			"s": sym.Symbol,
+			"a": f.tradeID,
+			"p": f.price.String(),
+			"q": f.qty.String(),
+			"f": f.tradeID,
+			"l": f.tradeID,
+			"T": f.time,
+			"m": buyerMaker,
+		}
+		if market == MarketSpot {
+			agg["M"] = true
+			s.hub.publish(market, name+"@trade", map[string]any{
+				"e": "trade",
+				"E": f.time,
+				"s": sym.Symbol,
+				"t": f.tradeID,
+				"p": f.price.String(),
+				"q": f.qty.String(),
+				"b": buyer.id,
+				"a": seller.id,
+				"T": f.time,
+				"m": buyerMaker,
+				"M": true,
+			})
+		}
+		s.hub.publish(market, name+"@aggtrade", agg)
+	}
+}
+
+// publishMarkPrice push the mark price event of a futures symbol
+func (s *Server) publishMarkPrice(sym *symbol, t int64) {
+	s.hub.publish(MarketFutures, strings.ToLower(sym.Symbol)+"@markprice", map[string]any{
+		"e": "markPriceUpdate",
+		"E": t,
+		"s": sym.Symbol,
+		"p": sym.mark().String(),
+		"i": sym.mark().String(),
+		"P": sym.mark().String(),
+		"r": "0",
+		"T": 0,
+	})
+}
@@ -0,0 +1,166 @@
+package binancetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// wsAPIRequest define a request of the WebSocket API
+type wsAPIRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params map[string]any  `json:"params"`
+}
+
+// wsAPIHandler return the handler of a WebSocket API method, the parameters are the ones of the
+// REST endpoint of the method
+func (s *Server) wsAPIHandler(market Market, method string) handlerFunc {
+	switch method {
+	case "ping":
+		return ping
+	case "time":
+		return serverTime
+	}
+	if market == MarketSpot {
+		switch method {
+		case "depth":
+			return s.public(s.spotDepth)
+		case "ticker.price":
+			return s.public(s.spotTickerPrice)
+		case "ticker.book":
+			return s.public(s.spotBookTicker)
+		case "order.place":
+			return s.signed(s.spotCreateOrder)
+		case "order.test":
+			return s.signed(s.spotTestOrder)
+		case "order.status":
+			return s.signed(s.spotGetOrder)
+		case "order.cancel":
+			return s.signed(s.spotCancelOrder)
+		case "openOrders.status":
+			return s.signed(s.spotOpenOrders)
+		case "openOrders.cancelAll":
+			return s.signed(s.spotCancelOpenOrders)
+		case "allOrders":
+			return s.signed(s.spotAllOrders)
+		case "myTrades":
+			return s.signed(s.spotMyTrades)
+		case "account.status":
+			return s.signed(s.spotAccount)
+		}
+		return nil
+	}
+	switch method {
+	case "depth":
+		return s.public(s.futuresDepth)
+	case "ticker.price":
+		return s.public(s.futuresTickerPrice)
+	case "ticker.book":
+		return s.public(s.futuresBookTicker)
+	case "order.place":
+		return s.signed(s.futuresCreateOrder)
+	case "order.status":
+		return s.signed(s.futuresGetOrder)
+	case "order.cancel":
+		return s.signed(s.futuresCancelOrder)
+	case "account.status":
+		return s.signed(s.futuresAccount)
+	case "account.balance":
+		return s.signed(s.futuresBalance)
+	case "account.position":
+		return s.signed(s.futuresPositionRiskV3)
+	case "userDataStream.start":
+		return s.withAPIKey(s.startUserStream(MarketFutures))
+	case "userDataStream.ping":
+		return s.withAPIKey(s.keepaliveUserStream)
+	case "userDataStream.stop":
+		return s.withAPIKey(s.closeUserStream(MarketFutures))
+	}
+	return nil
+}
+
+// serveWsAPI serve a WebSocket API connection, the requests are handled in order
+func (s *Server) serveWsAPI(w http.ResponseWriter, r *http.Request, market Market) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	sub := newSubscriber(market)
+	sub.start(conn)
+	s.hub.add(sub)
+	defer s.hub.remove(sub)
+	defer sub.close()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req wsAPIRequest
+		decoder := json.NewDecoder(bytes.NewReader(msg))
+		decoder.UseNumber()
+		if err := decoder.Decode(&req); err != nil {
+			sub.writeJSON(wsAPIError(nil, newAPIError(http.StatusBadRequest, -1100, "Invalid JSON request.")))
+			continue
+		}
+		s.faults.delay()
+		used, allowed := s.limits.use(req.Method)
+		if !allowed {
+			sub.writeJSON(wsAPIError(req.ID, errTooManyRequests))
+			continue
+		}
+		if status, ok := s.faults.fail(req.Method); ok {
+			sub.writeJSON(wsAPIError(req.ID, newAPIError(status, errUnknown.Code, errUnknown.Msg)))
+			continue
+		}
+		res, err := s.handleWsAPI(sub, &req)
+		if err != nil {
+			e, ok := err.(*apiError)
+			if !ok {
+				e = newAPIError(http.StatusBadRequest, -1100, err.Error())
+			}
+			sub.writeJSON(wsAPIError(req.ID, e))
+			continue
+		}
+		sub.writeJSON(map[string]any{
+			"id":         req.ID,
+			"status":     http.StatusOK,
+			"result":     res,
+			"rateLimits": s.limits.wsRateLimits(used),
+		})
+	}
+}
+
+func (s *Server) handleWsAPI(sub *subscriber, req *wsAPIRequest) (any, error) {
+	r := newWsRequest(req.Params)
+	switch {
+	case sub.market == MarketSpot && req.Method == "userDataStream.subscribe.signature":
+		a, err := s.verify(r)
+		if err != nil {
+			return nil, err
+		}
+		s.hub.mu.Lock()
+		sub.account, sub.wrap = a, true
+		s.hub.mu.Unlock()
+		return map[string]int{"subscriptionId": 0}, nil
+	case sub.market == MarketSpot && req.Method == "userDataStream.unsubscribe":
+		s.hub.mu.Lock()
+		sub.account, sub.wrap = nil, false
+		s.hub.mu.Unlock()
+		return struct{}{}, nil
+	}
+	handler := s.wsAPIHandler(sub.market, req.Method)
+	if handler == nil {
+		return nil, newAPIError(http.StatusBadRequest, -1020, "Unknown method: "+req.Method)
+	}
+	return handler(r)
+}
+
+func wsAPIError(id json.RawMessage, e *apiError) map[string]any {
+	return map[string]any{
+		"id":     id,
+		"status": e.status,
+		"error":  e,
+	}
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/bitly/go-simplejson"
 
 	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/streamrec"
+	"github.com/adshao/go-binance/v2/common/websocket"
 	"github.com/adshao/go-binance/v2/delivery"
 	"github.com/adshao/go-binance/v2/futures"
 	"github.com/adshao/go-binance/v2/options"
@@ -135,6 +137,12 @@ type SelfTradePreventionMode string
 // CancelReplaceMode define cancel replace mode
 type CancelReplaceMode string
 
+// CancelRestrictions define the status an order must have to be canceled
+type CancelRestrictions string
+
+// OrderRateLimitExceededMode define what cancel replace does when the order rate limit is exceeded
+type OrderRateLimitExceededMode string
+
 type MarginAccountBorrowRepayType string
 
 // Global enums
@@ -166,6 +174,7 @@ const (
 	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
 	OrderStatusExpiredInMatch      OrderStatusType = "EXPIRED_IN_MATCH" // STP Expired
+	OrderStatusTypePendingNew      OrderStatusType = "PENDING_NEW"      // pending order of an order list
 
 	SymbolTypeSpot SymbolType = "SPOT"
 
@@ -193,6 +202,7 @@ const (
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
 	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
 	UserDataEventTypeExternalLockUpdate      UserDataEventType = "externalLockUpdate"
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
@@ -330,6 +340,12 @@ const (
 	CancelReplaceModeStopOnFailure CancelReplaceMode = "STOP_ON_FAILURE"
 	CancelReplaceModeAllowFailure  CancelReplaceMode = "ALLOW_FAILURE"
 
+	CancelRestrictionsOnlyNew             CancelRestrictions = "ONLY_NEW"
+	CancelRestrictionsOnlyPartiallyFilled CancelRestrictions = "ONLY_PARTIALLY_FILLED"
+
+	OrderRateLimitExceededModeDoNothing  OrderRateLimitExceededMode = "DO_NOTHING"
+	OrderRateLimitExceededModeCancelOnly OrderRateLimitExceededMode = "CANCEL_ONLY"
+
 	MarginAccountBorrow MarginAccountBorrowRepayType = "BORROW"
 	MarginAccountRepay  MarginAccountBorrowRepayType = "REPAY"
 
@@ -420,6 +436,8 @@ type Client struct {
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
+	// Endpoints overrides the endpoints selected by UseTestnet and UseDemo when set, see SetEndpoints
+	Endpoints *Endpoints
 
 	ProxyUrl string
 
@@ -427,6 +445,20 @@ type Client struct {
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+	// RateLimiter enables client-side rate limiting when set, see EnableRateLimiter
+	RateLimiter *common.RateLimiter
+	// TimeSync overrides TimeOffset with a synced offset when set, see EnableTimeSync
+	TimeSync *common.TimeSync
+	// RetryPolicy retries failed requests when set, it can be overridden with WithRetryPolicy
+	RetryPolicy *common.RetryPolicy
+	// WsApiClient is the connection shared by the websocket API services when set, see EnableWsApiConnection
+	WsApiClient websocket.Client
+	// StreamRecorder records the messages of the streams started afterwards when set
+	StreamRecorder *streamrec.Recorder
+	// StreamReplayer replays its recording to the streams started afterwards instead of opening
+	// their connection when set. The streams are not reconnected when the replay ends even if
+	// WebsocketAutoReconnect is enabled.
+	StreamReplayer *streamrec.Replayer
 }
 
 func (c *Client) SetUseTestnet() {
@@ -444,8 +476,11 @@ func (c *Client) getProxyUrl() *string {
 	return &c.ProxyUrl
 }
 
-// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
+// getCombinedEndpoint return the base endpoint of the combined stream according the Endpoints and the UseTestnet flag
 func (c *Client) getCombinedEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.CombinedStream != "" {
+		return c.Endpoints.CombinedStream
+	}
 	if c.UseTestnet {
 		return BaseCombinedTestnetURL
 	}
@@ -455,8 +490,11 @@ func (c *Client) getCombinedEndpoint() string {
 	return BaseCombinedMainURL
 }
 
-// getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
+// getWsEndpoint return the base endpoint of the WS according the Endpoints and the UseTestnet flag
 func (c *Client) getWsEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.Stream != "" {
+		return c.Endpoints.Stream
+	}
 	if c.UseTestnet {
 		return BaseWsTestnetURL
 	}
@@ -466,8 +504,11 @@ func (c *Client) getWsEndpoint() string {
 	return BaseWsMainURL
 }
 
-// getWsApiEndpoint return the base endpoint of the API WS according the UseTestnet flag
+// getWsApiEndpoint return the base endpoint of the API WS according the Endpoints and the UseTestnet flag
 func (c *Client) getWsApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.WsAPI != "" {
+		return c.Endpoints.WsAPI
+	}
 	if c.UseTestnet {
 		return BaseWsApiTestnetURL
 	}
@@ -477,8 +518,11 @@ func (c *Client) getWsApiEndpoint() string {
 	return BaseWsApiMainURL
 }
 
-// getAPIEndpoint return the base endpoint of the Rest API according the UseTestnet flag
+// getAPIEndpoint return the base endpoint of the Rest API according the Endpoints and the UseTestnet flag
 func (c *Client) getAPIEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.API != "" {
+		return c.Endpoints.API
+	}
 	if c.UseTestnet {
 		return BaseAPITestnetURL
 	}
@@ -509,7 +553,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	// @ is a safe character and does not require escape, So replace it back.
@@ -563,6 +607,55 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	// options are applied once so that retries send the same request
+	for _, opt := range opts {
+		opt(r)
+	}
+	policy := c.retryPolicy(r)
+	if policy != nil {
+		setClientOrderID(r)
+	}
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		data, err = c.doAPI(ctx, r)
+		if err == nil {
+			return data, nil
+		}
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			attempt--
+			continue
+		}
+		if ctx.Err() != nil || !policy.ShouldRetry(attempt, err) {
+			return data, err
+		}
+		switch {
+		case !common.IsUnknownStatusError(err):
+		case isOrderRequest(r):
+			// the order may have been placed, look it up before sending it again
+			orderData, found, qerr := c.queryPlacedOrder(ctx, r)
+			if qerr != nil {
+				return data, err
+			}
+			if found {
+				return orderData, nil
+			}
+		case r.method != http.MethodGet:
+			// e.g. a withdrawal or a transfer may have been executed, only the requests
+			// which never reached the server are resent
+			return data, err
+		}
+		if policy.Wait(ctx, attempt) != nil {
+			return data, err
+		}
+	}
+}
+
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	// wait before signing the request, so its timestamp is not stale once sent
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, err
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -585,6 +678,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	c.updateRateLimit(r, res)
 
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
@@ -611,6 +705,8 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
 		return nil, apiErr
 	}
 	return data, nil
@@ -0,0 +1,130 @@
+package common
+
+// Documented API error codes.
+// See https://developers.binance.com/docs/binance-spot-api-docs/errors
+// and https://developers.binance.com/docs/derivatives/usds-margined-futures/error-code
+const (
+	// 10xx - General server or network issues
+	ErrCodeUnknown             int64 = -1000 // an unknown error occurred while processing the request
+	ErrCodeDisconnected        int64 = -1001 // internal error, unable to process the request
+	ErrCodeUnauthorized        int64 = -1002 // not authorized to execute this request
+	ErrCodeTooManyRequests     int64 = -1003 // too many requests, the IP is rate limited
+	ErrCodeUnexpectedResp      int64 = -1006 // unexpected response, execution status unknown
+	ErrCodeTimeout             int64 = -1007 // timeout waiting for the backend, execution status unknown
+	ErrCodeServerBusy          int64 = -1008 // server overloaded, the request was rejected
+	ErrCodeInvalidMessage      int64 = -1013 // the request was rejected by a symbol filter
+	ErrCodeUnknownOrderComp    int64 = -1014 // unsupported order combination
+	ErrCodeTooManyOrders       int64 = -1015 // too many new orders, the account is rate limited
+	ErrCodeServiceShuttingDown int64 = -1016 // this service is no longer available
+	ErrCodeUnsupportedOp       int64 = -1020 // this operation is not supported
+	ErrCodeInvalidTimestamp    int64 = -1021 // timestamp outside of the recvWindow
+	ErrCodeInvalidSignature    int64 = -1022 // signature for this request is not valid
+
+	// 11xx - Request issues
+	ErrCodeIllegalChars       int64 = -1100 // illegal characters found in a parameter
+	ErrCodeTooManyParameters  int64 = -1101 // too many parameters sent
+	ErrCodeMandatoryParam     int64 = -1102 // a mandatory parameter was not sent, was empty or malformed
+	ErrCodeUnknownParam       int64 = -1103 // an unknown parameter was sent
+	ErrCodeUnreadParameters   int64 = -1104 // not all sent parameters were read
+	ErrCodeParamEmpty         int64 = -1105 // a parameter was empty
+	ErrCodeParamNotRequired   int64 = -1106 // a parameter was sent when not required
+	ErrCodeBadPrecision       int64 = -1111 // precision is over the maximum defined for this asset
+	ErrCodeNoDepth            int64 = -1112 // no orders on book for symbol
+	ErrCodeInvalidTIF         int64 = -1115 // invalid timeInForce
+	ErrCodeInvalidOrderType   int64 = -1116 // invalid orderType
+	ErrCodeInvalidSide        int64 = -1117 // invalid side
+	ErrCodeBadInterval        int64 = -1120 // invalid interval
+	ErrCodeBadSymbol          int64 = -1121 // invalid symbol
+	ErrCodeInvalidListenKey   int64 = -1125 // this listenKey does not exist
+	ErrCodeOptionalParamCombo int64 = -1128 // combination of optional parameters invalid
+	ErrCodeInvalidParameter   int64 = -1130 // invalid data sent for a parameter
+
+	// 20xx - Processing issues
+	ErrCodeNewOrderRejected      int64 = -2010 // new order rejected
+	ErrCodeCancelRejected        int64 = -2011 // cancel rejected
+	ErrCodeNoSuchOrder           int64 = -2013 // order does not exist
+	ErrCodeBadAPIKeyFmt          int64 = -2014 // API-key format invalid
+	ErrCodeRejectedMbxKey        int64 = -2015 // invalid API-key, IP, or permissions for action
+	ErrCodeNoTradingWindow       int64 = -2016 // no trading window could be found for the symbol
+	ErrCodeBalanceNotSufficient  int64 = -2018 // balance is insufficient
+	ErrCodeMarginNotSufficient   int64 = -2019 // margin is insufficient
+	ErrCodeUnableToFill          int64 = -2020 // unable to fill
+	ErrCodeOrderWouldTrigger     int64 = -2021 // order would immediately trigger
+	ErrCodeReduceOnlyReject      int64 = -2022 // reduceOnly order is rejected
+	ErrCodeMaxOpenOrdersExceeded int64 = -2025 // reached max open order limit
+	ErrCodeOrderArchived         int64 = -2026 // order was canceled or expired with no executed quantity over 90 days ago
+	ErrCodeMaxLeverageRatio      int64 = -2027 // exceeded the maximum allowable position at current leverage
+	ErrCodeMinLeverageRatio      int64 = -2028 // leverage is smaller than permitted
+
+	// 4xxx and 5xxx - Futures order issues
+	ErrCodeInvalidOrderStatus     int64 = -4000 // invalid order status
+	ErrCodePriceLessThanZero      int64 = -4001 // price less than 0
+	ErrCodePriceGreaterThanMax    int64 = -4002 // price greater than max price
+	ErrCodeQtyLessThanZero        int64 = -4003 // quantity less than zero
+	ErrCodeQtyLessThanMin         int64 = -4004 // quantity less than min quantity
+	ErrCodeQtyGreaterThanMax      int64 = -4005 // quantity greater than max quantity
+	ErrCodePriceNotIncreased      int64 = -4014 // price not increased by tick size
+	ErrCodePositionSideMismatch   int64 = -4061 // order's position side does not match user's setting
+	ErrCodePercentPriceReject     int64 = -4131 // counterparty best price does not meet the PERCENT_PRICE filter
+	ErrCodeMarketOrderNoLiquidity int64 = -4136 // market order rejected due to insufficient liquidity
+	ErrCodeMinNotional            int64 = -4164 // order's notional must be no smaller than the minimum
+	ErrCodeFOKRejected            int64 = -5021 // FOK order rejected as it could not be fully filled
+	ErrCodePostOnlyRejected       int64 = -5022 // post only order rejected as it could not be executed as maker
+	ErrCodeSamePriceModification  int64 = -5027 // no need to modify the order
+)
+
+// Sentinel API errors matching any *APIError with the same code through errors.Is
+var (
+	ErrUnknown              = &APIError{Code: ErrCodeUnknown}
+	ErrDisconnected         = &APIError{Code: ErrCodeDisconnected}
+	ErrUnauthorized         = &APIError{Code: ErrCodeUnauthorized}
+	ErrTooManyRequests      = &APIError{Code: ErrCodeTooManyRequests}
+	ErrUnexpectedResp       = &APIError{Code: ErrCodeUnexpectedResp}
+	ErrTimeout              = &APIError{Code: ErrCodeTimeout}
+	ErrServerBusy           = &APIError{Code: ErrCodeServerBusy}
+	ErrInvalidMessage       = &APIError{Code: ErrCodeInvalidMessage}
+	ErrTooManyOrders        = &APIError{Code: ErrCodeTooManyOrders}
+	ErrServiceShuttingDown  = &APIError{Code: ErrCodeServiceShuttingDown}
+	ErrInvalidTimestamp     = &APIError{Code: ErrCodeInvalidTimestamp}
+	ErrInvalidSignature     = &APIError{Code: ErrCodeInvalidSignature}
+	ErrMandatoryParam       = &APIError{Code: ErrCodeMandatoryParam}
+	ErrBadPrecision         = &APIError{Code: ErrCodeBadPrecision}
+	ErrBadSymbol            = &APIError{Code: ErrCodeBadSymbol}
+	ErrInvalidListenKey     = &APIError{Code: ErrCodeInvalidListenKey}
+	ErrNewOrderRejected     = &APIError{Code: ErrCodeNewOrderRejected}
+	ErrCancelRejected       = &APIError{Code: ErrCodeCancelRejected}
+	ErrNoSuchOrder          = &APIError{Code: ErrCodeNoSuchOrder}
+	ErrRejectedMbxKey       = &APIError{Code: ErrCodeRejectedMbxKey}
+	ErrBalanceNotSufficient = &APIError{Code: ErrCodeBalanceNotSufficient}
+	ErrMarginNotSufficient  = &APIError{Code: ErrCodeMarginNotSufficient}
+	ErrOrderWouldTrigger    = &APIError{Code: ErrCodeOrderWouldTrigger}
+	ErrReduceOnlyReject     = &APIError{Code: ErrCodeReduceOnlyReject}
+	ErrMinNotional          = &APIError{Code: ErrCodeMinNotional}
+	ErrFOKRejected          = &APIError{Code: ErrCodeFOKRejected}
+	ErrPostOnlyRejected     = &APIError{Code: ErrCodePostOnlyRejected}
+)
+
+// orderRejectedCodes are the codes of orders rejected by the matching engine or a filter
+var orderRejectedCodes = map[int64]bool{
+	ErrCodeInvalidMessage:         true,
+	ErrCodeNewOrderRejected:       true,
+	ErrCodeBalanceNotSufficient:   true,
+	ErrCodeMarginNotSufficient:    true,
+	ErrCodeUnableToFill:           true,
+	ErrCodeOrderWouldTrigger:      true,
+	ErrCodeReduceOnlyReject:       true,
+	ErrCodeMaxOpenOrdersExceeded:  true,
+	ErrCodeMaxLeverageRatio:       true,
+	ErrCodePriceLessThanZero:      true,
+	ErrCodePriceGreaterThanMax:    true,
+	ErrCodeQtyLessThanZero:        true,
+	ErrCodeQtyLessThanMin:         true,
+	ErrCodeQtyGreaterThanMax:      true,
+	ErrCodePriceNotIncreased:      true,
+	ErrCodePositionSideMismatch:   true,
+	ErrCodeMinNotional:            true,
+	ErrCodePercentPriceReject:     true,
+	ErrCodeMarketOrderNoLiquidity: true,
+	ErrCodeFOKRejected:            true,
+	ErrCodePostOnlyRejected:       true,
+}
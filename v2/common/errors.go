@@ -1,7 +1,10 @@
 package common
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 // APIError define API error when response status is 4xx or 5xx
@@ -9,6 +12,10 @@ type APIError struct {
 	Code     int64  `json:"code"`
 	Message  string `json:"msg"`
 	Response []byte `json:"-"` // Assign the body value when the Code and Message fields are invalid.
+	// StatusCode is the HTTP status of the response, 0 for websocket API errors
+	StatusCode int `json:"-"`
+	// Header is the header of the response, nil for websocket API errors
+	Header http.Header `json:"-"`
 }
 
 // Error return error code and message
@@ -23,8 +30,55 @@ func (e APIError) IsValid() bool {
 	return e.Code != 0 || e.Message != ""
 }
 
-// IsAPIError check if e is an API error
+// Is make errors.Is match an APIError against a sentinel with the same code, e.g. ErrNoSuchOrder
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code != 0 && t.Code == e.Code
+}
+
+// IsAPIError check if e is, or wraps, an API error
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
-	return ok
+	var apiErr *APIError
+	return errors.As(e, &apiErr)
+}
+
+// IsRateLimited check if err reports an exceeded rate limit: a -1003 or -1015 error,
+// a 429 or 418 response, or a RateLimitError of the client-side limiter
+func IsRateLimited(err error) bool {
+	var rlErr *RateLimitError
+	if errors.As(err, &rlErr) {
+		return true
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrCodeTooManyRequests, ErrCodeTooManyOrders:
+		return true
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode == http.StatusTeapot
+}
+
+// IsInsufficientBalance check if err reports an insufficient balance or margin.
+// Spot reports it as a -2010 rejection, which is told apart by its message.
+func IsInsufficientBalance(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrCodeBalanceNotSufficient, ErrCodeMarginNotSufficient:
+		return true
+	case ErrCodeNewOrderRejected:
+		return strings.Contains(strings.ToLower(apiErr.Message), "insufficient balance")
+	}
+	return false
+}
+
+// IsOrderRejected check if err reports an order rejected by the matching engine
+// or a symbol filter, the order was not placed and resending it as is will fail again
+func IsOrderRejected(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && orderRejectedCodes[apiErr.Code]
 }
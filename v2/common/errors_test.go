@@ -0,0 +1,52 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	assert := assert.New(t)
+	err := fmt.Errorf("place order: %w", &APIError{Code: -2013, Message: "Order does not exist."})
+
+	assert.True(errors.Is(err, ErrNoSuchOrder))
+	assert.False(errors.Is(err, ErrCancelRejected))
+	assert.False(errors.Is(&APIError{Message: "no code"}, &APIError{}))
+	assert.True(IsAPIError(err))
+	assert.False(IsAPIError(errors.New("plain")))
+
+	var apiErr *APIError
+	assert.True(errors.As(err, &apiErr))
+	assert.Equal("Order does not exist.", apiErr.Message)
+}
+
+func TestErrorClassification(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		err                 error
+		rateLimited         bool
+		insufficientBalance bool
+		orderRejected       bool
+	}{
+		{&APIError{Code: -1003, StatusCode: http.StatusTooManyRequests}, true, false, false},
+		{&APIError{StatusCode: http.StatusTeapot}, true, false, false},
+		{&APIError{Code: -1015}, true, false, false},
+		{&RateLimitError{RateLimitType: RateLimitTypeOrders}, true, false, false},
+		{&APIError{Code: -2010, Message: "Account has insufficient balance for requested action."}, false, true, true},
+		{&APIError{Code: -2010, Message: "Order would immediately match and take."}, false, false, true},
+		{&APIError{Code: -2019, Message: "Margin is insufficient."}, false, true, true},
+		{&APIError{Code: -4164, Message: "Order's notional must be no smaller than 5.0"}, false, false, true},
+		{fmt.Errorf("wrapped: %w", &APIError{Code: -5022}), false, false, true},
+		{&APIError{Code: -1021}, false, false, false},
+		{errors.New("insufficient balance"), false, false, false},
+	}
+	for i, test := range tests {
+		assert.Equal(test.rateLimited, IsRateLimited(test.err), i)
+		assert.Equal(test.insufficientBalance, IsInsufficientBalance(test.err), i)
+		assert.Equal(test.orderRejected, IsOrderRejected(test.err), i)
+	}
+}
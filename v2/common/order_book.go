@@ -0,0 +1,244 @@
+package common
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrOrderBookCrossed is returned when applying an update leaves the best bid
+// at or above the best ask, which means the local book is out of sync.
+var ErrOrderBookCrossed = errors.New("order book: best bid is not below best ask")
+
+// bookLevel is a price level with its parsed price, used for ordering.
+type bookLevel struct {
+	price decimal.Decimal
+	level PriceLevel
+}
+
+// OrderBook is a thread-safe local order book keyed by price.
+// Bids are kept in descending and asks in ascending price order.
+type OrderBook struct {
+	mu           sync.RWMutex
+	lastUpdateID int64
+	bids         []bookLevel
+	asks         []bookLevel
+}
+
+// NewOrderBook init an empty OrderBook
+func NewOrderBook() *OrderBook {
+	return &OrderBook{}
+}
+
+// Reset replaces the content of the book with a snapshot.
+func (b *OrderBook) Reset(lastUpdateID int64, bids, asks []PriceLevel) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastUpdateID = lastUpdateID
+	b.bids = b.bids[:0]
+	b.asks = b.asks[:0]
+	if err := b.apply(bids, asks); err != nil {
+		b.clear()
+		return err
+	}
+	return nil
+}
+
+// Update applies a diff to the book. A level with zero quantity removes the price.
+func (b *OrderBook) Update(lastUpdateID int64, bids, asks []PriceLevel) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.apply(bids, asks); err != nil {
+		return err
+	}
+	b.lastUpdateID = lastUpdateID
+	if b.isCrossed() {
+		return ErrOrderBookCrossed
+	}
+	return nil
+}
+
+// Clear removes all levels from the book.
+func (b *OrderBook) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clear()
+}
+
+func (b *OrderBook) clear() {
+	b.lastUpdateID = 0
+	b.bids = nil
+	b.asks = nil
+}
+
+func (b *OrderBook) apply(bids, asks []PriceLevel) error {
+	for _, l := range bids {
+		levels, err := setLevel(b.bids, l, true)
+		if err != nil {
+			return err
+		}
+		b.bids = levels
+	}
+	for _, l := range asks {
+		levels, err := setLevel(b.asks, l, false)
+		if err != nil {
+			return err
+		}
+		b.asks = levels
+	}
+	return nil
+}
+
+// setLevel inserts, replaces or removes a level in a sorted slice
+func setLevel(levels []bookLevel, l PriceLevel, desc bool) ([]bookLevel, error) {
+	price, err := decimal.NewFromString(l.Price)
+	if err != nil {
+		return levels, err
+	}
+	quantity, err := decimal.NewFromString(l.Quantity)
+	if err != nil {
+		return levels, err
+	}
+	i := searchLevel(levels, price, desc)
+	found := i < len(levels) && levels[i].price.Equal(price)
+	switch {
+	case quantity.IsZero() && found:
+		return append(levels[:i], levels[i+1:]...), nil
+	case quantity.IsZero():
+		return levels, nil
+	case found:
+		levels[i].level = l
+		return levels, nil
+	}
+	levels = append(levels, bookLevel{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = bookLevel{price: price, level: l}
+	return levels, nil
+}
+
+// searchLevel returns the index of price, or the index where it would be inserted
+func searchLevel(levels []bookLevel, price decimal.Decimal, desc bool) int {
+	return sort.Search(len(levels), func(i int) bool {
+		if desc {
+			return levels[i].price.LessThanOrEqual(price)
+		}
+		return levels[i].price.GreaterThanOrEqual(price)
+	})
+}
+
+func (b *OrderBook) isCrossed() bool {
+	return len(b.bids) > 0 && len(b.asks) > 0 && b.bids[0].price.GreaterThanOrEqual(b.asks[0].price)
+}
+
+// LastUpdateID return the update id of the last applied snapshot or diff
+func (b *OrderBook) LastUpdateID() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastUpdateID
+}
+
+// BestBid return the highest bid, ok is false if there are no bids
+func (b *OrderBook) BestBid() (level PriceLevel, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.bids) == 0 {
+		return PriceLevel{}, false
+	}
+	return b.bids[0].level, true
+}
+
+// BestAsk return the lowest ask, ok is false if there are no asks
+func (b *OrderBook) BestAsk() (level PriceLevel, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.asks) == 0 {
+		return PriceLevel{}, false
+	}
+	return b.asks[0].level, true
+}
+
+// Bids return the top n bids, all bids if n <= 0
+func (b *OrderBook) Bids(n int) []PriceLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return topLevels(b.bids, n)
+}
+
+// Asks return the top n asks, all asks if n <= 0
+func (b *OrderBook) Asks(n int) []PriceLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return topLevels(b.asks, n)
+}
+
+func topLevels(levels []bookLevel, n int) []PriceLevel {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+	res := make([]PriceLevel, n)
+	for i := 0; i < n; i++ {
+		res[i] = levels[i].level
+	}
+	return res
+}
+
+// BidQuantity return the bid quantity resting at price, "0" if there is none
+func (b *OrderBook) BidQuantity(price string) (string, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return quantityAt(b.bids, price, true)
+}
+
+// AskQuantity return the ask quantity resting at price, "0" if there is none
+func (b *OrderBook) AskQuantity(price string) (string, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return quantityAt(b.asks, price, false)
+}
+
+func quantityAt(levels []bookLevel, price string, desc bool) (string, error) {
+	p, err := decimal.NewFromString(price)
+	if err != nil {
+		return "", err
+	}
+	i := searchLevel(levels, p, desc)
+	if i < len(levels) && levels[i].price.Equal(p) {
+		return levels[i].level.Quantity, nil
+	}
+	return "0", nil
+}
+
+// BidDepth return the cumulative bid quantity at prices greater than or equal to price
+func (b *OrderBook) BidDepth(price string) (string, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return depthTo(b.bids, price, true)
+}
+
+// AskDepth return the cumulative ask quantity at prices less than or equal to price
+func (b *OrderBook) AskDepth(price string) (string, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return depthTo(b.asks, price, false)
+}
+
+func depthTo(levels []bookLevel, price string, desc bool) (string, error) {
+	p, err := decimal.NewFromString(price)
+	if err != nil {
+		return "", err
+	}
+	total := decimal.Zero
+	for _, l := range levels {
+		if (desc && l.price.LessThan(p)) || (!desc && l.price.GreaterThan(p)) {
+			break
+		}
+		q, err := decimal.NewFromString(l.level.Quantity)
+		if err != nil {
+			return "", err
+		}
+		total = total.Add(q)
+	}
+	return total.String(), nil
+}
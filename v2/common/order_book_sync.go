@@ -0,0 +1,252 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrOrderBookGap is reported when a depth event does not follow the previous one and the book is resynchronised
+var ErrOrderBookGap = errors.New("order book: depth event sequence gap, resyncing")
+
+// ErrSnapshotStale is returned when a depth snapshot is older than the first buffered depth
+// event, the snapshot is fetched again after RetryInterval
+var ErrSnapshotStale = errors.New("order book: depth snapshot older than the buffered events")
+
+// DepthDiff define the update ids and the levels of a diff depth event
+type DepthDiff struct {
+	FirstUpdateID int64
+	LastUpdateID  int64
+	// PrevLastUpdateID is the last update id of the previous event, only sent by the futures streams
+	PrevLastUpdateID int64
+	Bids             []PriceLevel
+	Asks             []PriceLevel
+}
+
+// DepthSnapshot define a depth snapshot fetched from the REST API
+type DepthSnapshot struct {
+	LastUpdateID int64
+	Bids         []PriceLevel
+	Asks         []PriceLevel
+}
+
+// DepthContinuity tell how a diff relates to the book it is applied to
+type DepthContinuity int
+
+// Depth continuities
+const (
+	// DepthStale is a diff already contained in the book, it is dropped
+	DepthStale DepthContinuity = iota
+	// DepthNext is a diff following the book, it is applied
+	DepthNext
+	// DepthGap is a diff following missed diffs, the book is resynchronised
+	DepthGap
+)
+
+// ContinuityCheck check how diff relates to a book at lastUpdateID, first is true for the
+// first diff applied after a snapshot
+type ContinuityCheck func(diff *DepthDiff, lastUpdateID int64, first bool) DepthContinuity
+
+// SpotDepthContinuity check the sequence of the spot diff depth stream: the first diff
+// after a snapshot has U <= lastUpdateId+1 <= u, the next ones have U == lastUpdateId+1
+func SpotDepthContinuity(diff *DepthDiff, lastUpdateID int64, first bool) DepthContinuity {
+	switch {
+	case diff.LastUpdateID <= lastUpdateID:
+		return DepthStale
+	case first && diff.FirstUpdateID <= lastUpdateID+1, !first && diff.FirstUpdateID == lastUpdateID+1:
+		return DepthNext
+	}
+	return DepthGap
+}
+
+// FuturesDepthContinuity check the sequence of the futures diff depth streams: the first
+// diff after a snapshot has U <= lastUpdateId <= u, the next ones have pu == lastUpdateId
+func FuturesDepthContinuity(diff *DepthDiff, lastUpdateID int64, first bool) DepthContinuity {
+	switch {
+	case diff.LastUpdateID < lastUpdateID:
+		return DepthStale
+	case first && diff.FirstUpdateID <= lastUpdateID, !first && diff.PrevLastUpdateID == lastUpdateID:
+		return DepthNext
+	}
+	return DepthGap
+}
+
+// OrderBookSync keep an OrderBook in sync with a diff depth stream: the diffs are buffered
+// until a depth snapshot is loaded and replayed on top of it, and a new snapshot is loaded
+// when a diff does not follow the previous one.
+type OrderBookSync struct {
+	// OnUpdate is called with every applied snapshot or diff, snapshot is true when the book
+	// has been (re)loaded and bids and asks hold the full book
+	OnUpdate func(lastUpdateID int64, bids, asks []PriceLevel, snapshot bool)
+	// OnError is called with the snapshot and sequence errors
+	OnError func(err error)
+	// RetryInterval is the delay before fetching a snapshot again after a failure
+	RetryInterval time.Duration
+	// MaxBufferedEvents is the maximum number of diffs buffered while waiting for a snapshot
+	MaxBufferedEvents int
+
+	snapshot   func(ctx context.Context) (*DepthSnapshot, error)
+	continuity ContinuityCheck
+	book       *OrderBook
+
+	mu      sync.Mutex
+	synced  int32
+	first   bool
+	buffer  []*DepthDiff
+	pending chan struct{}
+}
+
+// NewOrderBookSync init an OrderBookSync loading its snapshots with snapshot and checking
+// the sequence of the diffs with continuity
+func NewOrderBookSync(snapshot func(ctx context.Context) (*DepthSnapshot, error), continuity ContinuityCheck) *OrderBookSync {
+	return &OrderBookSync{
+		RetryInterval:     time.Second,
+		MaxBufferedEvents: 10000,
+		snapshot:          snapshot,
+		continuity:        continuity,
+		book:              NewOrderBook(),
+		pending:           make(chan struct{}, 1),
+	}
+}
+
+// Book return the synced order book
+func (s *OrderBookSync) Book() *OrderBook {
+	return s.book
+}
+
+// IsSynced return true when the book has been loaded from a snapshot and is not resyncing
+func (s *OrderBookSync) IsSynced() bool {
+	return atomic.LoadInt32(&s.synced) == 1
+}
+
+func (s *OrderBookSync) setSynced(synced bool) {
+	var v int32
+	if synced {
+		v = 1
+	}
+	atomic.StoreInt32(&s.synced, v)
+}
+
+// Resync drop the book and request a new snapshot, e.g. when the depth stream reconnects
+func (s *OrderBookSync) Resync() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resync()
+}
+
+// resync must be called with mu held
+func (s *OrderBookSync) resync() {
+	s.setSynced(false)
+	s.first = false
+	s.book.Clear()
+	select {
+	case s.pending <- struct{}{}:
+	default:
+	}
+}
+
+// Apply apply a diff of the depth stream, it is buffered while the book is not synced
+func (s *OrderBookSync) Apply(diff *DepthDiff) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.IsSynced() {
+		if s.MaxBufferedEvents > 0 && len(s.buffer) >= s.MaxBufferedEvents {
+			s.buffer = s.buffer[1:]
+		}
+		s.buffer = append(s.buffer, diff)
+		return
+	}
+	s.apply(diff)
+}
+
+// apply apply a diff to a synced book, must be called with mu held
+func (s *OrderBookSync) apply(diff *DepthDiff) {
+	switch s.continuity(diff, s.book.LastUpdateID(), s.first) {
+	case DepthStale:
+		return
+	case DepthGap:
+		s.onError(ErrOrderBookGap)
+		s.resync()
+		s.buffer = append(s.buffer[:0], diff)
+		return
+	}
+	if err := s.book.Update(diff.LastUpdateID, diff.Bids, diff.Asks); err != nil {
+		s.onError(err)
+		s.resync()
+		return
+	}
+	s.first = false
+	s.notify(diff.LastUpdateID, diff.Bids, diff.Asks, false)
+}
+
+// Run load a snapshot every time a resync is requested, until ctx is done
+func (s *OrderBookSync) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.pending:
+		}
+		for {
+			err := s.LoadSnapshot(ctx)
+			if err == nil {
+				break
+			}
+			s.onError(err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.RetryInterval):
+			}
+		}
+	}
+}
+
+// LoadSnapshot fetch a depth snapshot and replay the buffered diffs on top of it, it returns
+// ErrSnapshotStale when the snapshot is older than the first buffered diff
+func (s *OrderBookSync) LoadSnapshot(ctx context.Context) error {
+	res, err := s.snapshot(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.IsSynced() {
+		return nil
+	}
+	if len(s.buffer) > 0 && res.LastUpdateID < s.buffer[0].FirstUpdateID {
+		// the snapshot is older than the first buffered event, Run fetches it again after
+		// RetryInterval instead of hammering the depth endpoint
+		return ErrSnapshotStale
+	}
+	if err := s.book.Reset(res.LastUpdateID, res.Bids, res.Asks); err != nil {
+		return err
+	}
+	s.setSynced(true)
+	s.first = true
+	s.notify(res.LastUpdateID, res.Bids, res.Asks, true)
+	buffer := s.buffer
+	s.buffer = nil
+	for _, diff := range buffer {
+		if !s.IsSynced() {
+			s.buffer = append(s.buffer, diff)
+			continue
+		}
+		s.apply(diff)
+	}
+	return nil
+}
+
+func (s *OrderBookSync) onError(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+	}
+}
+
+func (s *OrderBookSync) notify(lastUpdateID int64, bids, asks []PriceLevel, snapshot bool) {
+	if s.OnUpdate != nil {
+		s.OnUpdate(lastUpdateID, bids, asks, snapshot)
+	}
+}
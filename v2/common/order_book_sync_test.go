@@ -0,0 +1,159 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpotDepthContinuity(t *testing.T) {
+	tests := []struct {
+		first, last int64
+		isFirst     bool
+		expected    DepthContinuity
+	}{
+		{5, 10, true, DepthStale},
+		{5, 12, true, DepthNext},
+		{11, 12, true, DepthNext},
+		{12, 14, true, DepthGap},
+		{11, 12, false, DepthNext},
+		{9, 12, false, DepthGap},
+		{13, 14, false, DepthGap},
+	}
+	for _, test := range tests {
+		diff := &DepthDiff{FirstUpdateID: test.first, LastUpdateID: test.last}
+		assert.Equal(t, test.expected, SpotDepthContinuity(diff, 10, test.isFirst), "%+v", test)
+	}
+}
+
+func TestFuturesDepthContinuity(t *testing.T) {
+	tests := []struct {
+		first, last, prev int64
+		isFirst           bool
+		expected          DepthContinuity
+	}{
+		{5, 9, 4, true, DepthStale},
+		{5, 10, 4, true, DepthNext},
+		{8, 12, 7, true, DepthNext},
+		{11, 12, 10, true, DepthGap},
+		{11, 14, 10, false, DepthNext},
+		{11, 14, 9, false, DepthGap},
+	}
+	for _, test := range tests {
+		diff := &DepthDiff{FirstUpdateID: test.first, LastUpdateID: test.last, PrevLastUpdateID: test.prev}
+		assert.Equal(t, test.expected, FuturesDepthContinuity(diff, 10, test.isFirst), "%+v", test)
+	}
+}
+
+func newTestOrderBookSync(snapshot *DepthSnapshot) (s *OrderBookSync, snapshots *int, errs *[]error) {
+	snapshots, errs = new(int), new([]error)
+	s = NewOrderBookSync(func(ctx context.Context) (*DepthSnapshot, error) {
+		*snapshots++
+		return snapshot, nil
+	}, SpotDepthContinuity)
+	s.OnError = func(err error) {
+		*errs = append(*errs, err)
+	}
+	return s, snapshots, errs
+}
+
+func TestOrderBookSync(t *testing.T) {
+	assert := assert.New(t)
+	s, _, errs := newTestOrderBookSync(&DepthSnapshot{
+		LastUpdateID: 10,
+		Bids:         []PriceLevel{{Price: "100.0", Quantity: "1.0"}, {Price: "99.0", Quantity: "2.0"}},
+		Asks:         []PriceLevel{{Price: "101.0", Quantity: "1.5"}},
+	})
+	var snapshots []bool
+	s.OnUpdate = func(lastUpdateID int64, bids, asks []PriceLevel, snapshot bool) {
+		snapshots = append(snapshots, snapshot)
+	}
+	s.Resync()
+	assert.Len(s.pending, 1)
+	<-s.pending
+
+	// the diffs are buffered until the snapshot is loaded, then the ones it misses are replayed
+	s.Apply(&DepthDiff{FirstUpdateID: 5, LastUpdateID: 8, Bids: []PriceLevel{{Price: "100.0", Quantity: "9"}}})
+	s.Apply(&DepthDiff{FirstUpdateID: 9, LastUpdateID: 12, Bids: []PriceLevel{{Price: "100.0", Quantity: "0"}}})
+	assert.False(s.IsSynced())
+	assert.Len(s.buffer, 2)
+
+	assert.NoError(s.LoadSnapshot(context.Background()))
+	assert.True(s.IsSynced())
+	assert.Empty(s.buffer)
+	assert.Equal([]bool{true, false}, snapshots)
+	assert.Equal(int64(12), s.Book().LastUpdateID())
+	bid, ok := s.Book().BestBid()
+	assert.True(ok)
+	assert.Equal("99.0", bid.Price)
+
+	s.Apply(&DepthDiff{FirstUpdateID: 13, LastUpdateID: 14})
+	assert.Equal(int64(14), s.Book().LastUpdateID())
+	assert.Empty(*errs)
+
+	// a gap drops the book, the diff is kept for the next snapshot
+	s.Apply(&DepthDiff{FirstUpdateID: 16, LastUpdateID: 17})
+	assert.False(s.IsSynced())
+	assert.Equal([]error{ErrOrderBookGap}, *errs)
+	_, ok = s.Book().BestBid()
+	assert.False(ok)
+	assert.Len(s.buffer, 1)
+	assert.Len(s.pending, 1)
+}
+
+func TestOrderBookSyncStaleSnapshot(t *testing.T) {
+	assert := assert.New(t)
+	s, snapshots, _ := newTestOrderBookSync(&DepthSnapshot{LastUpdateID: 10})
+	s.Resync()
+	<-s.pending
+	s.Apply(&DepthDiff{FirstUpdateID: 20, LastUpdateID: 22})
+
+	assert.Equal(ErrSnapshotStale, s.LoadSnapshot(context.Background()))
+	assert.Equal(1, *snapshots)
+	assert.False(s.IsSynced())
+	assert.Len(s.buffer, 1)
+	// Run retries after RetryInterval, no resync is requested
+	assert.Empty(s.pending)
+}
+
+func TestOrderBookSyncMaxBufferedEvents(t *testing.T) {
+	assert := assert.New(t)
+	s, _, _ := newTestOrderBookSync(&DepthSnapshot{LastUpdateID: 10})
+	s.MaxBufferedEvents = 2
+	for i := int64(1); i <= 3; i++ {
+		s.Apply(&DepthDiff{FirstUpdateID: i, LastUpdateID: i})
+	}
+	assert.Len(s.buffer, 2)
+	assert.Equal(int64(2), s.buffer[0].FirstUpdateID)
+}
+
+func TestOrderBookSyncRun(t *testing.T) {
+	assert := assert.New(t)
+	failures := 1
+	s := NewOrderBookSync(func(ctx context.Context) (*DepthSnapshot, error) {
+		if failures > 0 {
+			failures--
+			return nil, errors.New("unavailable")
+		}
+		return &DepthSnapshot{LastUpdateID: 10}, nil
+	}, FuturesDepthContinuity)
+	s.RetryInterval = 0
+	errs := make(chan error, 1)
+	s.OnError = func(err error) {
+		errs <- err
+	}
+	synced := make(chan struct{})
+	s.OnUpdate = func(lastUpdateID int64, bids, asks []PriceLevel, snapshot bool) {
+		close(synced)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Resync()
+	go s.Run(ctx)
+
+	<-synced
+	assert.True(s.IsSynced())
+	assert.EqualError(<-errs, "unavailable")
+}
@@ -0,0 +1,67 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderBook(t *testing.T) {
+	assert := assert.New(t)
+	b := NewOrderBook()
+	err := b.Reset(10, []PriceLevel{
+		{Price: "99.0", Quantity: "1"},
+		{Price: "100.0", Quantity: "2"},
+	}, []PriceLevel{
+		{Price: "102.0", Quantity: "4"},
+		{Price: "101.0", Quantity: "3"},
+	})
+	assert.NoError(err)
+	assert.Equal(int64(10), b.LastUpdateID())
+
+	bid, ok := b.BestBid()
+	assert.True(ok)
+	assert.Equal("100.0", bid.Price)
+	ask, ok := b.BestAsk()
+	assert.True(ok)
+	assert.Equal("101.0", ask.Price)
+
+	err = b.Update(11, []PriceLevel{
+		{Price: "100", Quantity: "0"},
+		{Price: "98.5", Quantity: "5"},
+	}, []PriceLevel{
+		{Price: "101.5", Quantity: "1"},
+		{Price: "103", Quantity: "0"},
+	})
+	assert.NoError(err)
+	assert.Equal(int64(11), b.LastUpdateID())
+	assert.Equal([]PriceLevel{
+		{Price: "99.0", Quantity: "1"},
+		{Price: "98.5", Quantity: "5"},
+	}, b.Bids(0))
+	assert.Equal([]PriceLevel{
+		{Price: "101.0", Quantity: "3"},
+		{Price: "101.5", Quantity: "1"},
+	}, b.Asks(2))
+
+	q, err := b.BidQuantity("99")
+	assert.NoError(err)
+	assert.Equal("1", q)
+	q, err = b.AskQuantity("100")
+	assert.NoError(err)
+	assert.Equal("0", q)
+	d, err := b.BidDepth("98.5")
+	assert.NoError(err)
+	assert.Equal("6", d)
+	d, err = b.AskDepth("101.5")
+	assert.NoError(err)
+	assert.Equal("4", d)
+
+	err = b.Update(12, []PriceLevel{{Price: "101.0", Quantity: "1"}}, nil)
+	assert.Equal(ErrOrderBookCrossed, err)
+
+	b.Clear()
+	_, ok = b.BestBid()
+	assert.False(ok)
+	assert.Equal(int64(0), b.LastUpdateID())
+}
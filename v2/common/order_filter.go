@@ -0,0 +1,143 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode define how the order validators align a price or a quantity to its tick or step size
+type RoundingMode int
+
+const (
+	// RoundingModeNone doesn't round, a value off its step is a violation
+	RoundingModeNone RoundingMode = iota
+	// RoundingModeDown round toward zero
+	RoundingModeDown
+	// RoundingModeUp round away from zero
+	RoundingModeUp
+	// RoundingModeNearest round to the nearest step, half away from zero
+	RoundingModeNearest
+)
+
+// FilterViolation define an order parameter breaking a symbol filter
+type FilterViolation struct {
+	// Filter is the filter type, e.g. LOT_SIZE
+	Filter string
+	// Field is the order parameter, e.g. quantity
+	Field  string
+	Value  string
+	Reason string
+}
+
+func (v FilterViolation) String() string {
+	return fmt.Sprintf("%s: %s %s %s", v.Filter, v.Field, v.Value, v.Reason)
+}
+
+// FilterViolations define the filter violations of an order, returned as error by the order validators
+type FilterViolations []FilterViolation
+
+func (v FilterViolations) Error() string {
+	msgs := make([]string, len(v))
+	for i, violation := range v {
+		msgs[i] = violation.String()
+	}
+	return "order violates symbol filters: " + strings.Join(msgs, "; ")
+}
+
+// Has return true if a violation of filter is in v
+func (v FilterViolations) Has(filter string) bool {
+	for _, violation := range v {
+		if violation.Filter == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// RoundToStep round value to a multiple of step with mode, value is returned unchanged
+// for RoundingModeNone or a null step
+func RoundToStep(value, step decimal.Decimal, mode RoundingMode) decimal.Decimal {
+	if mode == RoundingModeNone || !step.IsPositive() {
+		return value
+	}
+	n := value.Div(step)
+	switch mode {
+	case RoundingModeUp:
+		if value.IsNegative() {
+			n = n.Floor()
+		} else {
+			n = n.Ceil()
+		}
+	case RoundingModeNearest:
+		n = n.Round(0)
+	default:
+		n = n.Truncate(0)
+	}
+	return n.Mul(step)
+}
+
+// StepFilter define a filter bounding a value and aligning it to a step, like PRICE_FILTER or
+// LOT_SIZE. A null Min, Max or Step is not checked.
+type StepFilter struct {
+	Filter string
+	Field  string
+	Min    string
+	Max    string
+	Step   string
+}
+
+// Apply round value to the step of the filter with mode, then check it against the bounds.
+// The rounded value is returned with the violations.
+func (f StepFilter) Apply(value decimal.Decimal, mode RoundingMode) (decimal.Decimal, FilterViolations) {
+	var violations FilterViolations
+	violation := func(reason string) {
+		violations = append(violations, FilterViolation{
+			Filter: f.Filter,
+			Field:  f.Field,
+			Value:  value.String(),
+			Reason: reason,
+		})
+	}
+	minValue := decimalOrZero(f.Min)
+	maxValue := decimalOrZero(f.Max)
+	step := decimalOrZero(f.Step)
+
+	if step.IsPositive() && !value.LessThan(minValue) {
+		// steps are counted from the minimum
+		value = RoundToStep(value.Sub(minValue), step, mode).Add(minValue)
+		if !value.Sub(minValue).Mod(step).IsZero() {
+			violation("is not a multiple of step " + f.Step)
+		}
+	}
+	if minValue.IsPositive() && value.LessThan(minValue) {
+		violation("is below minimum " + f.Min)
+	}
+	if maxValue.IsPositive() && value.GreaterThan(maxValue) {
+		violation("is above maximum " + f.Max)
+	}
+	return value, violations
+}
+
+// CheckRange return a violation of filter if value is not within [lower, upper], a null bound is not checked
+func CheckRange(filter, field string, value, lower, upper decimal.Decimal) FilterViolations {
+	var reason string
+	switch {
+	case lower.IsPositive() && value.LessThan(lower):
+		reason = "is below minimum " + lower.String()
+	case upper.IsPositive() && value.GreaterThan(upper):
+		reason = "is above maximum " + upper.String()
+	default:
+		return nil
+	}
+	return FilterViolations{{Filter: filter, Field: field, Value: value.String(), Reason: reason}}
+}
+
+func decimalOrZero(v string) decimal.Decimal {
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
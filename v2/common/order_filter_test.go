@@ -0,0 +1,58 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundToStep(t *testing.T) {
+	step := decimal.RequireFromString("0.05")
+	value := decimal.RequireFromString("1.234")
+	assert.Equal(t, "1.2", RoundToStep(value, step, RoundingModeDown).String())
+	assert.Equal(t, "1.25", RoundToStep(value, step, RoundingModeUp).String())
+	assert.Equal(t, "1.25", RoundToStep(value, step, RoundingModeNearest).String())
+	assert.Equal(t, "1.234", RoundToStep(value, step, RoundingModeNone).String())
+	assert.Equal(t, "1.234", RoundToStep(value, decimal.Zero, RoundingModeDown).String())
+	assert.Equal(t, "-1.25", RoundToStep(value.Neg(), step, RoundingModeUp).String())
+}
+
+func TestStepFilterApply(t *testing.T) {
+	f := StepFilter{Filter: "LOT_SIZE", Field: "quantity", Min: "0.001", Max: "100", Step: "0.001"}
+
+	v, violations := f.Apply(decimal.RequireFromString("1.23456"), RoundingModeDown)
+	assert.Empty(t, violations)
+	assert.Equal(t, "1.234", v.String())
+
+	_, violations = f.Apply(decimal.RequireFromString("1.23456"), RoundingModeNone)
+	assert.Equal(t, FilterViolations{{
+		Filter: "LOT_SIZE", Field: "quantity", Value: "1.23456", Reason: "is not a multiple of step 0.001",
+	}}, violations)
+
+	// values below the minimum are not rounded up to it
+	v, violations = f.Apply(decimal.RequireFromString("0.0004"), RoundingModeUp)
+	assert.Equal(t, "0.0004", v.String())
+	assert.True(t, violations.Has("LOT_SIZE"))
+	assert.Equal(t, "is below minimum 0.001", violations[0].Reason)
+
+	_, violations = f.Apply(decimal.RequireFromString("100.0005"), RoundingModeUp)
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "is above maximum 100", violations[0].Reason)
+
+	// a null bound or step is not checked
+	f = StepFilter{Filter: "PRICE_FILTER", Field: "price", Min: "0", Max: "0", Step: "0.01"}
+	v, violations = f.Apply(decimal.RequireFromString("1000000.006"), RoundingModeNearest)
+	assert.Empty(t, violations)
+	assert.Equal(t, "1000000.01", v.String())
+}
+
+func TestFilterViolationsError(t *testing.T) {
+	var err error = FilterViolations{
+		{Filter: "LOT_SIZE", Field: "quantity", Value: "0.0001", Reason: "is below minimum 0.001"},
+		{Filter: "NOTIONAL", Field: "notional", Value: "2", Reason: "is below minimum 5"},
+	}
+	assert.EqualError(t, err, "order violates symbol filters: LOT_SIZE: quantity 0.0001 is below minimum 0.001; "+
+		"NOTIONAL: notional 2 is below minimum 5")
+	assert.Nil(t, CheckRange("NOTIONAL", "notional", decimal.NewFromInt(5), decimal.NewFromInt(5), decimal.Zero))
+}
@@ -0,0 +1,285 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate limit types published in exchangeInfo
+const (
+	RateLimitTypeRequestWeight = "REQUEST_WEIGHT"
+	RateLimitTypeOrders        = "ORDERS"
+	RateLimitTypeRawRequests   = "RAW_REQUESTS"
+)
+
+// DefaultRetryAfter is used to pause callers when a 429/418 response has no Retry-After header
+var DefaultRetryAfter = 60 * time.Second
+
+// RateLimitRule define a rate limit as published in exchangeInfo
+type RateLimitRule struct {
+	RateLimitType string
+	Interval      string
+	IntervalNum   int64
+	Limit         int64
+}
+
+// duration return the length of the rule window
+func (r RateLimitRule) duration() time.Duration {
+	var unit time.Duration
+	switch r.Interval {
+	case "SECOND":
+		unit = time.Second
+	case "MINUTE":
+		unit = time.Minute
+	case "HOUR":
+		unit = time.Hour
+	case "DAY":
+		unit = 24 * time.Hour
+	default:
+		return 0
+	}
+	n := r.IntervalNum
+	if n <= 0 {
+		n = 1
+	}
+	return unit * time.Duration(n)
+}
+
+// headerSuffix return the interval suffix used by the X-Mbx-* headers, e.g. 1m or 10s
+func (r RateLimitRule) headerSuffix() string {
+	if r.Interval == "" {
+		return ""
+	}
+	return fmt.Sprintf("%d%s", r.IntervalNum, strings.ToLower(r.Interval[:1]))
+}
+
+// RateLimitError is returned by a fail-fast RateLimiter when a request would exceed a limit,
+// or when callers are paused after a 429/418 response
+type RateLimitError struct {
+	RateLimitType string
+	Interval      string
+	IntervalNum   int64
+	Limit         int64
+	RetryAfter    time.Duration
+}
+
+// Error return the exceeded limit and the time to wait
+func (e *RateLimitError) Error() string {
+	if e.RateLimitType == "" {
+		return fmt.Sprintf("<RateLimitError> requests paused, retry after %s", e.RetryAfter)
+	}
+	return fmt.Sprintf("<RateLimitError> %s limit %d per %d %s exceeded, retry after %s",
+		e.RateLimitType, e.Limit, e.IntervalNum, e.Interval, e.RetryAfter)
+}
+
+// IsRateLimitError check if e is a RateLimitError, or wraps one
+func IsRateLimitError(e error) bool {
+	var err *RateLimitError
+	return errors.As(e, &err)
+}
+
+type rateLimitBucket struct {
+	rule        RateLimitRule
+	windowStart time.Time
+	used        int64
+}
+
+// reset start a new window if the current one is over
+func (b *rateLimitBucket) reset(now time.Time) {
+	start := now.Truncate(b.rule.duration())
+	if !start.Equal(b.windowStart) {
+		b.windowStart = start
+		b.used = 0
+	}
+}
+
+// RateLimiter is a client-side limiter of request weight and order count.
+// Its buckets are seeded from exchangeInfo rate limits and reconciled with the
+// X-Mbx-Used-Weight-* and X-Mbx-Order-Count-* response headers. It can be shared
+// by several clients hitting the same limits.
+type RateLimiter struct {
+	// FailFast makes Wait return a RateLimitError instead of blocking
+	FailFast bool
+
+	mu          sync.Mutex
+	buckets     []*rateLimitBucket
+	pausedUntil time.Time
+	now         func() time.Time
+}
+
+// NewRateLimiter init a RateLimiter with rules, usually taken from exchangeInfo
+func NewRateLimiter(rules []RateLimitRule) *RateLimiter {
+	l := &RateLimiter{now: time.Now}
+	l.SetRules(rules)
+	return l
+}
+
+// SetRules replace the rules of the limiter, counters are reset
+func (l *RateLimiter) SetRules(rules []RateLimitRule) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buckets = l.buckets[:0]
+	for _, rule := range rules {
+		if rule.duration() == 0 || rule.Limit <= 0 {
+			continue
+		}
+		l.buckets = append(l.buckets, &rateLimitBucket{rule: rule})
+	}
+}
+
+// cost return the amount a request consumes from a bucket
+func (b *rateLimitBucket) cost(weight, orders int64) int64 {
+	switch b.rule.RateLimitType {
+	case RateLimitTypeRequestWeight:
+		return weight
+	case RateLimitTypeOrders:
+		return orders
+	case RateLimitTypeRawRequests:
+		return 1
+	}
+	return 0
+}
+
+// Wait block until a request of weight placing orders orders fits in every
+// bucket, or ctx is done. In fail-fast mode it returns a RateLimitError
+// instead of waiting.
+func (l *RateLimiter) Wait(ctx context.Context, weight, orders int64) error {
+	for {
+		delay, err := l.reserve(weight, orders)
+		if err != nil {
+			return err
+		}
+		if delay == 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve consume the request from every bucket, or return how long to wait
+func (l *RateLimiter) reserve(weight, orders int64) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Before(l.pausedUntil) {
+		delay := l.pausedUntil.Sub(now)
+		if l.FailFast {
+			return 0, &RateLimitError{RetryAfter: delay}
+		}
+		return delay, nil
+	}
+	for _, b := range l.buckets {
+		b.reset(now)
+		cost := b.cost(weight, orders)
+		// a request heavier than the limit is let through on an empty window
+		if cost == 0 || b.used == 0 || b.used+cost <= b.rule.Limit {
+			continue
+		}
+		delay := b.windowStart.Add(b.rule.duration()).Sub(now)
+		if l.FailFast {
+			return 0, &RateLimitError{
+				RateLimitType: b.rule.RateLimitType,
+				Interval:      b.rule.Interval,
+				IntervalNum:   b.rule.IntervalNum,
+				Limit:         b.rule.Limit,
+				RetryAfter:    delay,
+			}
+		}
+		return delay, nil
+	}
+	for _, b := range l.buckets {
+		b.used += b.cost(weight, orders)
+	}
+	return 0, nil
+}
+
+// Update reconcile the buckets with the response headers and pause every
+// caller when the response is a 429 or 418 with Retry-After
+func (l *RateLimiter) Update(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for _, b := range l.buckets {
+		var key string
+		switch b.rule.RateLimitType {
+		case RateLimitTypeRequestWeight:
+			key = "X-Mbx-Used-Weight-" + b.rule.headerSuffix()
+		case RateLimitTypeOrders:
+			key = "X-Mbx-Order-Count-" + b.rule.headerSuffix()
+		default:
+			continue
+		}
+		v := header.Get(key)
+		if v == "" {
+			continue
+		}
+		used, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			continue
+		}
+		b.reset(now)
+		if used > b.used {
+			b.used = used
+		}
+	}
+	l.updateRetryAfter(now, statusCode, header)
+}
+
+// UpdateRetryAfter pause every caller when the response is a 429 or 418 with Retry-After,
+// for the responses of the endpoints which are not counted by the buckets
+func (l *RateLimiter) UpdateRetryAfter(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.updateRetryAfter(l.now(), statusCode, header)
+}
+
+func (l *RateLimiter) updateRetryAfter(now time.Time, statusCode int, header http.Header) {
+	if statusCode != http.StatusTooManyRequests && statusCode != http.StatusTeapot {
+		return
+	}
+	retryAfter := DefaultRetryAfter
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+	}
+	l.pause(now.Add(retryAfter))
+}
+
+// Pause block every caller for d
+func (l *RateLimiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pause(l.now().Add(d))
+}
+
+func (l *RateLimiter) pause(until time.Time) {
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// Used return the consumed amount of the current window of each rule
+func (l *RateLimiter) Used() map[RateLimitRule]int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	res := make(map[RateLimitRule]int64, len(l.buckets))
+	for _, b := range l.buckets {
+		b.reset(now)
+		res[b.rule] = b.used
+	}
+	return res
+}
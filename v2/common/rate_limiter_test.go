@@ -0,0 +1,103 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter(now *time.Time) *RateLimiter {
+	l := NewRateLimiter([]RateLimitRule{
+		{RateLimitType: RateLimitTypeRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 10},
+		{RateLimitType: RateLimitTypeOrders, Interval: "SECOND", IntervalNum: 10, Limit: 2},
+	})
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(&now)
+	l.FailFast = true
+	ctx := context.Background()
+
+	assert.NoError(l.Wait(ctx, 6, 0))
+	err := l.Wait(ctx, 5, 0)
+	assert.True(IsRateLimitError(err))
+	rlErr := err.(*RateLimitError)
+	assert.Equal(RateLimitTypeRequestWeight, rlErr.RateLimitType)
+	assert.Equal(30*time.Second, rlErr.RetryAfter)
+
+	assert.NoError(l.Wait(ctx, 1, 1))
+	assert.NoError(l.Wait(ctx, 1, 1))
+	err = l.Wait(ctx, 1, 1)
+	assert.True(IsRateLimitError(err))
+	assert.Equal(RateLimitTypeOrders, err.(*RateLimitError).RateLimitType)
+	assert.True(IsRateLimitError(fmt.Errorf("place order: %w", err)))
+	assert.False(IsRateLimitError(errors.New("plain")))
+
+	now = now.Add(30 * time.Second)
+	assert.NoError(l.Wait(ctx, 10, 1))
+	assert.Equal(int64(10), l.Used()[RateLimitRule{RateLimitType: RateLimitTypeRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 10}])
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(&now)
+	l.FailFast = true
+	ctx := context.Background()
+
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "9")
+	header.Set("X-Mbx-Order-Count-10s", "2")
+	l.Update(http.StatusOK, header)
+	assert.NoError(l.Wait(ctx, 1, 0))
+	assert.Error(l.Wait(ctx, 1, 0))
+	assert.Error(l.Wait(ctx, 0, 1))
+
+	now = now.Add(time.Minute)
+	header = http.Header{}
+	header.Set("Retry-After", "5")
+	l.Update(http.StatusTooManyRequests, header)
+	err := l.Wait(ctx, 1, 0)
+	assert.True(IsRateLimitError(err))
+	assert.Equal(5*time.Second, err.(*RateLimitError).RetryAfter)
+	now = now.Add(5 * time.Second)
+	assert.NoError(l.Wait(ctx, 1, 0))
+}
+
+func TestRateLimiterUpdateRetryAfter(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(&now)
+	l.FailFast = true
+	ctx := context.Background()
+
+	// the weight of the uncounted endpoints is ignored
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "10")
+	l.UpdateRetryAfter(http.StatusOK, header)
+	assert.NoError(l.Wait(ctx, 1, 0))
+
+	header.Set("Retry-After", "3")
+	l.UpdateRetryAfter(http.StatusTeapot, header)
+	err := l.Wait(ctx, 1, 0)
+	assert.True(IsRateLimitError(err))
+	assert.Equal(3*time.Second, err.(*RateLimitError).RetryAfter)
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(&now)
+	l.Pause(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, l.Wait(ctx, 1, 0))
+}
@@ -0,0 +1,148 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy define how failed REST requests are retried.
+//
+// Order placements are never blindly resent: when their outcome is unknown
+// (timeout, connection reset, 5xx, -1006, -1007) the order is first looked up
+// by its client order id and only resent if it does not exist. The response of
+// an order found this way is the one of the order query, see
+// SpotOrderPlacementResponse for the spot orders. The other requests which
+// are not GET, e.g. withdrawals, transfers or loans, are only retried when
+// they never reached the server (connection refused, -1001, -1008).
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt
+	MaxRetries int
+	// MinBackoff is the delay before the first retry, doubled on every retry
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+	// Retryable decides if an error can be retried, IsRetryable if nil
+	Retryable func(err error) bool
+}
+
+// NewRetryPolicy init a RetryPolicy retrying up to maxRetries times with the default backoff
+func NewRetryPolicy(maxRetries int) *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: maxRetries,
+		MinBackoff: 200 * time.Millisecond,
+		MaxBackoff: 5 * time.Second,
+	}
+}
+
+// ShouldRetry check if attempt, counted from 0, can be followed by a retry after err
+func (p *RetryPolicy) ShouldRetry(attempt int, err error) bool {
+	if p == nil || err == nil || attempt >= p.MaxRetries {
+		return false
+	}
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// Backoff return the delay before the retry following attempt, with jitter
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 0; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// wait between half and the full delay so that concurrent callers spread out
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// Wait sleep for the backoff of attempt or until ctx is done
+func (p *RetryPolicy) Wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.Backoff(attempt))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsRetryable check if err is transient: a transport error, a 5xx response
+// or one of the -1001, -1006, -1007 and -1008 API errors
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case ErrCodeDisconnected, ErrCodeUnexpectedResp, ErrCodeTimeout, ErrCodeServerBusy:
+			return true
+		}
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// IsUnknownStatusError check if err leaves the execution status of the request
+// unknown, i.e. the server may have processed it
+func IsUnknownStatusError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case ErrCodeUnexpectedResp, ErrCodeTimeout:
+			return true
+		case ErrCodeDisconnected, ErrCodeServerBusy:
+			return false
+		}
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	// the request never left when the connection could not be established
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return false
+	}
+	return IsRetryable(err)
+}
+
+// IsNoSuchOrderError check if err reports an order that does not exist
+func IsNoSuchOrderError(err error) bool {
+	return errors.Is(err, ErrNoSuchOrder)
+}
+
+// SpotOrderPlacementResponse convert the response of a spot order query to the shape of
+// the response of the order placement. The transactTime is the time of the order and the
+// fills are empty, the trades of the order are not part of the query response.
+func SpotOrderPlacementResponse(data []byte) ([]byte, error) {
+	var order map[string]json.RawMessage
+	if err := json.Unmarshal(data, &order); err != nil {
+		return nil, err
+	}
+	if _, ok := order["transactTime"]; !ok {
+		if t, ok := order["time"]; ok {
+			order["transactTime"] = t
+		}
+	}
+	order["fills"] = json.RawMessage("[]")
+	return json.Marshal(order)
+}
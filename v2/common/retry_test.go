@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	assert := assert.New(t)
+	p := NewRetryPolicy(2)
+	serverErr := &APIError{Code: -1000, StatusCode: http.StatusBadGateway}
+
+	assert.True(p.ShouldRetry(0, serverErr))
+	assert.True(p.ShouldRetry(1, serverErr))
+	assert.False(p.ShouldRetry(2, serverErr))
+	assert.False(p.ShouldRetry(0, &APIError{Code: -2010, StatusCode: http.StatusBadRequest}))
+	assert.False(p.ShouldRetry(0, nil))
+
+	var nilPolicy *RetryPolicy
+	assert.False(nilPolicy.ShouldRetry(0, serverErr))
+
+	p.Retryable = func(err error) bool { return false }
+	assert.False(p.ShouldRetry(0, serverErr))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	assert := assert.New(t)
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+		d := p.Backoff(attempt)
+		assert.GreaterOrEqual(d, max/2, fmt.Sprint(attempt))
+		assert.LessOrEqual(d, max, fmt.Sprint(attempt))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(p.Wait(ctx, 10), context.Canceled)
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert := assert.New(t)
+	dialErr := &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}
+	readErr := &net.OpError{Op: "read", Err: syscall.ECONNRESET}
+	tests := []struct {
+		err       error
+		retryable bool
+		unknown   bool
+	}{
+		{&APIError{Code: ErrCodeDisconnected}, true, false},
+		{&APIError{Code: ErrCodeServerBusy}, true, false},
+		{&APIError{Code: ErrCodeTimeout}, true, true},
+		{&APIError{Code: ErrCodeUnexpectedResp}, true, true},
+		{&APIError{StatusCode: http.StatusServiceUnavailable}, true, true},
+		{&APIError{Code: -1021, StatusCode: http.StatusBadRequest}, false, false},
+		{fmt.Errorf("wrapped: %w", &APIError{Code: ErrCodeTimeout}), true, true},
+		{dialErr, true, false},
+		{readErr, true, true},
+		{io.ErrUnexpectedEOF, true, true},
+		{context.Canceled, false, false},
+		{errors.New("invalid"), false, false},
+	}
+	for i, test := range tests {
+		assert.Equal(test.retryable, IsRetryable(test.err), i)
+		assert.Equal(test.unknown, IsUnknownStatusError(test.err), i)
+	}
+}
+
+func TestIsNoSuchOrderError(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsNoSuchOrderError(&APIError{Code: ErrCodeNoSuchOrder}))
+	assert.False(IsNoSuchOrderError(&APIError{Code: -2011}))
+	assert.False(IsNoSuchOrderError(errors.New("-2013")))
+}
+
+func TestSpotOrderPlacementResponse(t *testing.T) {
+	data, err := SpotOrderPlacementResponse([]byte(`{"orderId":28,"time":1499827319559,"updateTime":1499827319560}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"orderId":28,"time":1499827319559,"updateTime":1499827319560,"transactTime":1499827319559,"fills":[]}`, string(data))
+
+	_, err = SpotOrderPlacementResponse([]byte(`[]`))
+	assert.Error(t, err)
+}
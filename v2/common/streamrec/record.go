@@ -0,0 +1,246 @@
+// Package streamrec records the raw messages of websocket streams to gzip compressed JSON lines
+// and replays them later through the handlers of the stream functions, for backtesting and for
+// reproducing bugs.
+package streamrec
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned when recording to a closed Recorder
+var ErrClosed = errors.New("streamrec: recorder closed")
+
+// Record define a message received from a stream
+type Record struct {
+	// Time is the local receive time in nanoseconds since the epoch
+	Time int64 `json:"time"`
+	// Stream is the name of the stream, or the names of the streams joined by "/" for a combined
+	// stream endpoint
+	Stream string `json:"stream"`
+	// Combined is true if the message was received from a combined stream endpoint and is
+	// wrapped in the {"stream":...,"data":...} envelope
+	Combined bool `json:"combined,omitempty"`
+	// Data is the message as received
+	Data json.RawMessage `json:"data"`
+}
+
+// ReceiveTime return the local receive time of the message
+func (r *Record) ReceiveTime() time.Time {
+	return time.Unix(0, r.Time)
+}
+
+// Streams return the names of the streams of the endpoint the message was received from
+func (r *Record) Streams() []string {
+	if r.Stream == "" {
+		return nil
+	}
+	return strings.Split(r.Stream, "/")
+}
+
+// ParseEndpoint return the stream names of a stream endpoint and whether it is a combined
+// stream endpoint, e.g. wss://stream.binance.com:9443/ws/btcusdt@depth or
+// wss://fstream.binance.com/market/stream?streams=btcusdt@aggTrade/ethusdt@aggTrade
+func ParseEndpoint(endpoint string) (streams []string, combined bool) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, false
+	}
+	if u.Path == "/stream" || strings.HasSuffix(u.Path, "/stream") {
+		if names := u.Query().Get("streams"); names != "" {
+			streams = strings.Split(names, "/")
+		}
+		return streams, true
+	}
+	name := u.Path
+	if i := strings.LastIndex(name, "/ws/"); i >= 0 {
+		name = name[i+len("/ws/"):]
+	} else if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "" || name == "ws" {
+		return nil, false
+	}
+	return []string{name}, false
+}
+
+// Recorder write the messages of streams to a gzip compressed JSON lines file, one Record per
+// line. It is safe for concurrent use by several streams.
+type Recorder struct {
+	mu     sync.Mutex
+	gz     *gzip.Writer
+	closer io.Closer
+	closed bool
+	err    error
+	now    func() time.Time
+}
+
+// NewRecorder init a Recorder writing to a new file at path
+func NewRecorder(path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	r := NewRecorderWriter(f)
+	r.closer = f
+	return r, nil
+}
+
+// NewRecorderWriter init a Recorder writing to w, closing the Recorder does not close w
+func NewRecorderWriter(w io.Writer) *Recorder {
+	return &Recorder{
+		gz:  gzip.NewWriter(w),
+		now: time.Now,
+	}
+}
+
+// Record write a message received from the stream endpoint, the message must be JSON
+func (r *Recorder) Record(endpoint string, message []byte) error {
+	if !json.Valid(message) {
+		return errors.New("streamrec: message is not valid JSON")
+	}
+	streams, combined := ParseEndpoint(endpoint)
+	record := Record{
+		Stream:   strings.Join(streams, "/"),
+		Combined: combined,
+		Data:     message,
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return ErrClosed
+	}
+	// take the time under the lock to keep the records in time order
+	record.Time = r.now().UnixNano()
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := r.gz.Write(append(line, '\n')); err != nil {
+		if r.err == nil {
+			r.err = err
+		}
+		return err
+	}
+	return nil
+}
+
+// Handler return a handler recording the messages of the stream endpoint before passing them to
+// handler, the recording errors are kept for Err
+func (r *Recorder) Handler(endpoint string, handler func(message []byte)) func(message []byte) {
+	return func(message []byte) {
+		if err := r.Record(endpoint, message); err != nil && err != ErrClosed {
+			r.mu.Lock()
+			if r.err == nil {
+				r.err = err
+			}
+			r.mu.Unlock()
+		}
+		handler(message)
+	}
+}
+
+// Err return the first error met while recording from a Handler
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Flush write the buffered records, the file stays readable up to them if the process dies
+func (r *Recorder) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return ErrClosed
+	}
+	return r.gz.Flush()
+}
+
+// Close flush the records and close the file, the messages received afterwards are not recorded
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	err := r.gz.Close()
+	if r.closer != nil {
+		if cerr := r.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Reader read the records of a recording in order
+type Reader struct {
+	decoder *json.Decoder
+	closers []io.Closer
+}
+
+// Open init a Reader on the recording at path
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r.closers = append(r.closers, f)
+	return r, nil
+}
+
+// NewReader init a Reader on a recording, which may be gzip compressed or plain JSON lines
+func NewReader(rd io.Reader) (*Reader, error) {
+	br := bufio.NewReader(rd)
+	r := &Reader{}
+	magic, err := br.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		r.closers = append(r.closers, gz)
+		r.decoder = json.NewDecoder(gz)
+	} else {
+		r.decoder = json.NewDecoder(br)
+	}
+	return r, nil
+}
+
+// Next return the next record, io.EOF at the end of the recording
+func (r *Reader) Next() (*Record, error) {
+	record := new(Record)
+	if err := r.decoder.Decode(record); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			// the last line of a recording whose process died may be truncated
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// Close close the recording
+func (r *Reader) Close() error {
+	var err error
+	for i := len(r.closers) - 1; i >= 0; i-- {
+		if cerr := r.closers[i].Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
@@ -0,0 +1,100 @@
+package streamrec
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	cases := []struct {
+		endpoint string
+		streams  []string
+		combined bool
+	}{
+		{"wss://stream.binance.com:9443/ws/btcusdt@depth", []string{"btcusdt@depth"}, false},
+		{"wss://fstream.binance.com/market/ws/btcusdt@markPrice@1s", []string{"btcusdt@markPrice@1s"}, false},
+		{"wss://stream.binance.com:9443/stream?streams=btcusdt@trade/ethusdt@trade", []string{"btcusdt@trade", "ethusdt@trade"}, true},
+		{"ws://127.0.0.1:8080/fstream/market/stream?streams=btcusdt@aggTrade", []string{"btcusdt@aggTrade"}, true},
+		{"wss://stream.binance.com:9443/stream", nil, true},
+		{"wss://stream.binance.com:9443/ws", nil, false},
+	}
+	for _, c := range cases {
+		streams, combined := ParseEndpoint(c.endpoint)
+		assert.Equal(t, c.streams, streams, c.endpoint)
+		assert.Equal(t, c.combined, combined, c.endpoint)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "streams.jsonl.gz")
+	rec, err := NewRecorder(path)
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	rec.now = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+
+	var received [][]byte
+	handler := rec.Handler("wss://stream.binance.com:9443/ws/btcusdt@trade", func(message []byte) {
+		received = append(received, message)
+	})
+	handler([]byte(`{"e":"trade","s":"BTCUSDT",
+		"p":"1.0"}`))
+	handler([]byte(`not json`))
+	require.NoError(t, rec.Record("wss://stream.binance.com:9443/stream?streams=btcusdt@trade/ethusdt@trade",
+		[]byte(`{"stream":"ethusdt@trade","data":{"e":"trade"}}`)))
+	assert.Len(t, received, 2)
+	assert.Error(t, rec.Err())
+	require.NoError(t, rec.Close())
+	assert.Equal(t, ErrClosed, rec.Record("wss://stream.binance.com:9443/ws/btcusdt@trade", []byte(`{}`)))
+
+	reader, err := Open(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	first, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "btcusdt@trade", first.Stream)
+	assert.False(t, first.Combined)
+	assert.Equal(t, `{"e":"trade","s":"BTCUSDT","p":"1.0"}`, string(first.Data))
+	assert.Equal(t, time.Unix(1700000000, int64(time.Millisecond)), first.ReceiveTime())
+	second, err := reader.Next()
+	require.NoError(t, err)
+	assert.True(t, second.Combined)
+	assert.Equal(t, []string{"btcusdt@trade", "ethusdt@trade"}, second.Streams())
+	assert.Greater(t, second.Time, first.Time)
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReaderPlainJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	for _, r := range []Record{
+		{Time: 1, Stream: "btcusdt@depth", Data: json.RawMessage(`{"u":1}`)},
+		{Time: 2, Stream: "btcusdt@depth", Data: json.RawMessage(`{"u":2}`)},
+	} {
+		line, _ := json.Marshal(r)
+		buf.Write(append(line, '\n'))
+	}
+	buf.WriteString(`{"time":3,"stream":"btc`)
+
+	reader, err := NewReader(&buf)
+	require.NoError(t, err)
+	var ids []int64
+	for {
+		r, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		ids = append(ids, r.Time)
+	}
+	assert.Equal(t, []int64{1, 2}, ids)
+}
@@ -0,0 +1,235 @@
+package streamrec
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Replay speeds
+const (
+	// SpeedMax replay the messages as fast as possible
+	SpeedMax float64 = 0
+	// SpeedOriginal replay the messages at the pace they were received, a speed of 10 replays
+	// them 10 times faster
+	SpeedOriginal float64 = 1
+)
+
+// ErrReplayFinished is returned when serving a stream from a Replayer whose Run returned
+var ErrReplayFinished = errors.New("streamrec: replay finished")
+
+// Replayer feed the messages of a recording to the handlers of the streams served by it. The
+// messages of a stream are converted between the raw and the combined stream formats, so a
+// recording of WsCombinedDepthServe can be replayed through WsDepthServe and the other way
+// round. The handlers are called from Run in the order of the recording, which makes a replay
+// deterministic across streams.
+//
+// Streams needing a connection, e.g. the ones of a StreamManager, are not supported.
+type Replayer struct {
+	path  string
+	speed float64
+
+	mu       sync.Mutex
+	streams  []*replayStream
+	running  bool
+	finished bool
+	stopC    chan struct{}
+	stopOnce sync.Once
+
+	// dispatchMu is held while calling a handler, so no handler is called once doneC is closed
+	dispatchMu sync.Mutex
+}
+
+type replayStream struct {
+	streams    map[string]bool
+	combined   bool
+	handler    func(message []byte)
+	errHandler func(err error)
+	doneC      chan struct{}
+	stopC      chan struct{}
+	finishC    chan struct{}
+	stopped    bool
+}
+
+// NewReplayer init a Replayer on the recording at path replayed at speed, SpeedMax or a
+// multiple of SpeedOriginal
+func NewReplayer(path string, speed float64) *Replayer {
+	if speed < 0 {
+		speed = SpeedMax
+	}
+	return &Replayer{
+		path:  path,
+		speed: speed,
+		stopC: make(chan struct{}),
+	}
+}
+
+// Serve register the stream endpoint, its handler receives the recorded messages of the
+// streams of the endpoint once Run is called. doneC is closed at the end of the recording or
+// once stopC is closed, errHandler receives the error which ended the replay if any.
+func (r *Replayer) Serve(endpoint string, handler func(message []byte), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	streams, combined := ParseEndpoint(endpoint)
+	if len(streams) == 0 {
+		return nil, nil, errors.New("streamrec: no stream to replay in " + endpoint)
+	}
+	s := &replayStream{
+		streams:    make(map[string]bool, len(streams)),
+		combined:   combined,
+		handler:    handler,
+		errHandler: errHandler,
+		doneC:      make(chan struct{}),
+		stopC:      make(chan struct{}),
+		finishC:    make(chan struct{}),
+	}
+	for _, stream := range streams {
+		s.streams[strings.ToLower(stream)] = true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.finished {
+		return nil, nil, ErrReplayFinished
+	}
+	r.streams = append(r.streams, s)
+	go func() {
+		select {
+		case <-s.stopC:
+		case <-s.finishC:
+		}
+		r.dispatchMu.Lock()
+		r.mu.Lock()
+		s.stopped = true
+		r.mu.Unlock()
+		r.dispatchMu.Unlock()
+		close(s.doneC)
+	}()
+	return s.doneC, s.stopC, nil
+}
+
+// Run replay the recording to the streams served so far and the ones served while it runs, it
+// returns at the end of the recording or once Stop is called. A Replayer runs once.
+func (r *Replayer) Run() (err error) {
+	r.mu.Lock()
+	if r.running || r.finished {
+		r.mu.Unlock()
+		return errors.New("streamrec: replayer already run")
+	}
+	r.running = true
+	r.mu.Unlock()
+	defer func() {
+		r.finish(err)
+	}()
+
+	reader, err := Open(r.path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	var start time.Time
+	var origin int64
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if start.IsZero() {
+			start, origin = time.Now(), record.Time
+		}
+		if r.speed > 0 {
+			due := start.Add(time.Duration(float64(record.Time-origin) / r.speed))
+			if wait := time.Until(due); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-timer.C:
+				case <-r.stopC:
+					timer.Stop()
+					return nil
+				}
+			}
+		}
+		select {
+		case <-r.stopC:
+			return nil
+		default:
+		}
+		r.dispatch(record)
+	}
+}
+
+// Stop make Run return before the end of the recording
+func (r *Replayer) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stopC)
+	})
+}
+
+// finish end the streams, passing them err if not nil
+func (r *Replayer) finish(err error) {
+	r.mu.Lock()
+	r.finished = true
+	streams := r.streams
+	r.streams = nil
+	r.mu.Unlock()
+	for _, s := range streams {
+		if err != nil && !s.isStopped(r) {
+			s.errHandler(err)
+		}
+		close(s.finishC)
+	}
+}
+
+func (s *replayStream) isStopped(r *Replayer) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return s.stopped
+}
+
+// combinedEnvelope define the envelope of the messages of a combined stream
+type combinedEnvelope struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// dispatch pass a record to the streams it belongs to, converted to their format
+func (r *Replayer) dispatch(record *Record) {
+	stream, data, wrapped := record.Stream, []byte(record.Data), []byte(nil)
+	if record.Combined {
+		var envelope combinedEnvelope
+		if err := json.Unmarshal(record.Data, &envelope); err != nil || envelope.Stream == "" {
+			// e.g. the responses to the SUBSCRIBE messages
+			return
+		}
+		stream, data, wrapped = envelope.Stream, envelope.Data, record.Data
+	}
+	stream = strings.ToLower(stream)
+
+	r.mu.Lock()
+	streams := make([]*replayStream, 0, len(r.streams))
+	for _, s := range r.streams {
+		if s.streams[stream] {
+			streams = append(streams, s)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, s := range streams {
+		message := data
+		if s.combined {
+			if wrapped == nil {
+				wrapped, _ = json.Marshal(combinedEnvelope{Stream: record.Stream, Data: record.Data})
+			}
+			message = wrapped
+		}
+		r.dispatchMu.Lock()
+		if !s.isStopped(r) {
+			s.handler(message)
+		}
+		r.dispatchMu.Unlock()
+	}
+}
@@ -0,0 +1,132 @@
+package streamrec
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type replayerTestRecord struct {
+	offset   time.Duration
+	endpoint string
+	message  string
+}
+
+func writeRecording(t *testing.T, records []replayerTestRecord) string {
+	path := filepath.Join(t.TempDir(), "streams.jsonl.gz")
+	rec, err := NewRecorder(path)
+	require.NoError(t, err)
+	start := time.Unix(1700000000, 0)
+	for _, r := range records {
+		offset := r.offset
+		rec.now = func() time.Time { return start.Add(offset) }
+		require.NoError(t, rec.Record(r.endpoint, []byte(r.message)))
+	}
+	require.NoError(t, rec.Close())
+	return path
+}
+
+type replayerTestCollector struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (c *replayerTestCollector) handler(name string) func(message []byte) {
+	return func(message []byte) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.messages = append(c.messages, name+" "+string(message))
+	}
+}
+
+func TestReplayerFormats(t *testing.T) {
+	path := writeRecording(t, []replayerTestRecord{
+		{0, "wss://stream.binance.com:9443/ws/btcusdt@depth", `{"u":1}`},
+		{time.Millisecond, "wss://stream.binance.com:9443/stream?streams=ethusdt@depth/bnbusdt@depth", `{"stream":"ethusdt@depth","data":{"u":2}}`},
+		{2 * time.Millisecond, "wss://stream.binance.com:9443/stream?streams=ethusdt@depth/bnbusdt@depth", `{"result":null,"id":1}`},
+		{3 * time.Millisecond, "wss://stream.binance.com:9443/ws/btcusdt@trade", `{"t":3}`},
+		{4 * time.Millisecond, "wss://stream.binance.com:9443/stream?streams=ethusdt@depth/bnbusdt@depth", `{"stream":"bnbusdt@depth","data":{"u":4}}`},
+	})
+	r := NewReplayer(path, SpeedMax)
+	c := new(replayerTestCollector)
+	errHandler := func(err error) { t.Error(err) }
+	rawDoneC, _, err := r.Serve("ws://local/ws/btcusdt@depth", c.handler("raw"), errHandler)
+	require.NoError(t, err)
+	_, _, err = r.Serve("ws://local/ws/ethusdt@depth", c.handler("raw"), errHandler)
+	require.NoError(t, err)
+	_, _, err = r.Serve("ws://local/stream?streams=btcusdt@depth/ethusdt@depth", c.handler("combined"), errHandler)
+	require.NoError(t, err)
+	_, _, err = r.Serve("ws://local/ws", c.handler("none"), errHandler)
+	assert.Error(t, err)
+
+	require.NoError(t, r.Run())
+	<-rawDoneC
+	assert.Equal(t, []string{
+		`raw {"u":1}`,
+		`combined {"stream":"btcusdt@depth","data":{"u":1}}`,
+		`raw {"u":2}`,
+		`combined {"stream":"ethusdt@depth","data":{"u":2}}`,
+	}, c.messages)
+
+	_, _, err = r.Serve("ws://local/ws/btcusdt@depth", c.handler("late"), errHandler)
+	assert.Equal(t, ErrReplayFinished, err)
+	assert.Error(t, r.Run())
+}
+
+func TestReplayerSpeed(t *testing.T) {
+	path := writeRecording(t, []replayerTestRecord{
+		{0, "wss://stream.binance.com:9443/ws/btcusdt@trade", `{"t":1}`},
+		{400 * time.Millisecond, "wss://stream.binance.com:9443/ws/btcusdt@trade", `{"t":2}`},
+	})
+	c := new(replayerTestCollector)
+
+	r := NewReplayer(path, 4*SpeedOriginal)
+	_, _, err := r.Serve("ws://local/ws/btcusdt@trade", c.handler("trade"), nil)
+	require.NoError(t, err)
+	start := time.Now()
+	require.NoError(t, r.Run())
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	assert.Len(t, c.messages, 2)
+}
+
+func TestReplayerStop(t *testing.T) {
+	path := writeRecording(t, []replayerTestRecord{
+		{0, "wss://stream.binance.com:9443/ws/btcusdt@trade", `{"t":1}`},
+		{time.Hour, "wss://stream.binance.com:9443/ws/btcusdt@trade", `{"t":2}`},
+	})
+	c := new(replayerTestCollector)
+	r := NewReplayer(path, SpeedOriginal)
+	doneC, stopC, err := r.Serve("ws://local/ws/btcusdt@trade", c.handler("trade"), nil)
+	require.NoError(t, err)
+	otherDoneC, _, err := r.Serve("ws://local/ws/btcusdt@trade", c.handler("other"), nil)
+	require.NoError(t, err)
+
+	runC := make(chan error)
+	go func() {
+		runC <- r.Run()
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(stopC)
+	<-doneC
+	r.Stop()
+	require.NoError(t, <-runC)
+	<-otherDoneC
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.Equal(t, []string{`trade {"t":1}`, `other {"t":1}`}, c.messages)
+}
+
+func TestReplayerMissingFile(t *testing.T) {
+	r := NewReplayer(filepath.Join(t.TempDir(), "missing.jsonl.gz"), SpeedMax)
+	var got error
+	doneC, _, err := r.Serve("ws://local/ws/btcusdt@trade", func([]byte) {}, func(err error) { got = err })
+	require.NoError(t, err)
+	assert.Error(t, r.Run())
+	<-doneC
+	assert.Error(t, got)
+}
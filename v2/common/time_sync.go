@@ -0,0 +1,172 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultTimeSyncSamples is the number of /time samples taken by each sync
+var DefaultTimeSyncSamples = 3
+
+// ServerTimeFunc return the server time in milliseconds
+type ServerTimeFunc func(ctx context.Context) (int64, error)
+
+// TimeSync keeps the offset between the local clock and the server clock.
+// Each sync takes several samples of the server time and keeps the one with
+// the lowest round trip, assuming the server read its clock halfway through it.
+// The offset is read atomically so a TimeSync can be shared by a client and
+// every websocket API service created from it.
+type TimeSync struct {
+	// Samples is the number of samples taken by each sync, DefaultTimeSyncSamples if <= 0
+	Samples int
+	// OnError is called with the errors of the background syncs
+	OnError func(err error)
+
+	serverTime ServerTimeFunc
+	now        func() time.Time
+
+	offset   int64
+	drift    int64
+	rtt      int64
+	lastSync int64
+	synced   int32
+
+	syncMu sync.Mutex
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	doneC  chan struct{}
+}
+
+// NewTimeSync init a TimeSync sampling the server time with serverTime
+func NewTimeSync(serverTime ServerTimeFunc) *TimeSync {
+	return &TimeSync{
+		serverTime: serverTime,
+		now:        time.Now,
+	}
+}
+
+// Offset return the local clock minus the server clock in milliseconds
+func (t *TimeSync) Offset() int64 {
+	return atomic.LoadInt64(&t.offset)
+}
+
+// OffsetOr return the synced offset, or fallback when t is nil or has never synced
+func (t *TimeSync) OffsetOr(fallback int64) int64 {
+	if t == nil || atomic.LoadInt32(&t.synced) == 0 {
+		return fallback
+	}
+	return t.Offset()
+}
+
+// Drift return the change of the offset measured by the last sync in milliseconds
+func (t *TimeSync) Drift() int64 {
+	return atomic.LoadInt64(&t.drift)
+}
+
+// RTT return the round trip of the sample used by the last sync
+func (t *TimeSync) RTT() time.Duration {
+	return time.Duration(atomic.LoadInt64(&t.rtt))
+}
+
+// LastSync return the time of the last successful sync, zero if it never synced
+func (t *TimeSync) LastSync() time.Time {
+	v := atomic.LoadInt64(&t.lastSync)
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, v)
+}
+
+// Sync sample the server time and update the offset
+func (t *TimeSync) Sync(ctx context.Context) error {
+	t.syncMu.Lock()
+	defer t.syncMu.Unlock()
+	samples := t.Samples
+	if samples <= 0 {
+		samples = DefaultTimeSyncSamples
+	}
+	var (
+		bestOffset int64
+		bestRTT    time.Duration = -1
+		lastErr    error
+	)
+	for i := 0; i < samples; i++ {
+		start := t.now()
+		serverTime, err := t.serverTime(ctx)
+		end := t.now()
+		if err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			lastErr = err
+			continue
+		}
+		rtt := end.Sub(start)
+		if bestRTT >= 0 && rtt >= bestRTT {
+			continue
+		}
+		local := start.Add(rtt/2).UnixNano() / int64(time.Millisecond)
+		bestOffset = local - serverTime
+		bestRTT = rtt
+	}
+	if bestRTT < 0 {
+		return lastErr
+	}
+	if atomic.LoadInt32(&t.synced) == 1 {
+		atomic.StoreInt64(&t.drift, bestOffset-t.Offset())
+	}
+	atomic.StoreInt64(&t.offset, bestOffset)
+	atomic.StoreInt64(&t.rtt, int64(bestRTT))
+	atomic.StoreInt64(&t.lastSync, t.now().UnixNano())
+	atomic.StoreInt32(&t.synced, 1)
+	return nil
+}
+
+// Start sync the offset every interval in background until Stop is called
+func (t *TimeSync) Start(interval time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	t.doneC = make(chan struct{})
+	go func(doneC chan struct{}) {
+		defer close(doneC)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := t.Sync(ctx); err != nil && ctx.Err() == nil && t.OnError != nil {
+				t.OnError(err)
+			}
+		}
+	}(t.doneC)
+}
+
+// Stop the background sync and wait for it to return
+func (t *TimeSync) Stop() {
+	t.mu.Lock()
+	cancel, doneC := t.cancel, t.doneC
+	t.cancel, t.doneC = nil, nil
+	t.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-doneC
+}
+
+// IsInvalidTimestampError check if err is a -1021 API error
+func IsInvalidTimestampError(err error) bool {
+	return errors.Is(err, ErrInvalidTimestamp)
+}
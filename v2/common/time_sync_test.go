@@ -0,0 +1,101 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSyncLowestRTT(t *testing.T) {
+	assert := assert.New(t)
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := base
+	// each sample: round trip in ms and server time reported
+	samples := []struct {
+		rtt        int64
+		serverTime int64
+	}{
+		{200, base.UnixMilli() - 500},
+		{20, base.UnixMilli() + 1000 - 490},
+		{100, base.UnixMilli() + 2000 - 400},
+	}
+	i := 0
+	ts := NewTimeSync(func(ctx context.Context) (int64, error) {
+		s := samples[i]
+		i++
+		res := s.serverTime
+		now = now.Add(time.Duration(s.rtt) * time.Millisecond)
+		return res, nil
+	})
+	ts.now = func() time.Time { return now }
+
+	assert.Equal(int64(7), ts.OffsetOr(7))
+	assert.NoError(ts.Sync(context.Background()))
+	assert.Equal(3, i)
+	assert.Equal(20*time.Millisecond, ts.RTT())
+	// local mid point is base+200ms+10ms, server is base+510ms
+	assert.Equal(int64(200+10-510), ts.Offset())
+	assert.Equal(ts.Offset(), ts.OffsetOr(7))
+	assert.Equal(int64(0), ts.Drift())
+	assert.False(ts.LastSync().IsZero())
+}
+
+func TestTimeSyncDrift(t *testing.T) {
+	assert := assert.New(t)
+	offset := int64(100)
+	ts := NewTimeSync(func(ctx context.Context) (int64, error) {
+		return time.Now().UnixMilli() - offset, nil
+	})
+	ts.Samples = 1
+	assert.NoError(ts.Sync(context.Background()))
+	assert.InDelta(100, ts.Offset(), 5)
+
+	offset = 300
+	assert.NoError(ts.Sync(context.Background()))
+	assert.InDelta(300, ts.Offset(), 5)
+	assert.InDelta(200, ts.Drift(), 10)
+}
+
+func TestTimeSyncError(t *testing.T) {
+	assert := assert.New(t)
+	errSample := errors.New("sample error")
+	ts := NewTimeSync(func(ctx context.Context) (int64, error) {
+		return 0, errSample
+	})
+	assert.ErrorIs(ts.Sync(context.Background()), errSample)
+	assert.Equal(int64(5), ts.OffsetOr(5))
+
+	var nilSync *TimeSync
+	assert.Equal(int64(5), nilSync.OffsetOr(5))
+}
+
+func TestTimeSyncStartStop(t *testing.T) {
+	calls := make(chan struct{}, 10)
+	ts := NewTimeSync(func(ctx context.Context) (int64, error) {
+		select {
+		case calls <- struct{}{}:
+		default:
+		}
+		return time.Now().UnixMilli(), nil
+	})
+	ts.Samples = 1
+	ts.Start(10 * time.Millisecond)
+	ts.Start(10 * time.Millisecond)
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("time sync did not run")
+	}
+	ts.Stop()
+	ts.Stop()
+}
+
+func TestIsInvalidTimestampError(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsInvalidTimestampError(&APIError{Code: -1021}))
+	assert.False(IsInvalidTimestampError(&APIError{Code: -1022}))
+	assert.False(IsInvalidTimestampError(errors.New("-1021")))
+}
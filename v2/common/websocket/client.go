@@ -1,7 +1,6 @@
 package websocket
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -44,6 +43,19 @@ type messageId struct {
 	Id string `json:"id"`
 }
 
+// syncResponse define the response of a request sent with WriteSync, or the read error
+// which ended the wait
+type syncResponse struct {
+	data []byte
+	err  error
+}
+
+// queuedMessage define a message waiting to be sent to the read channel
+type queuedMessage struct {
+	id      string
+	message []byte
+}
+
 // client define API websocket client
 type client struct {
 	Debug                       bool
@@ -56,6 +68,15 @@ type client struct {
 	readC                       chan []byte
 	readErrChan                 chan error
 	reconnectCount              int64
+
+	// pending route the responses of WriteSync by request id, the other messages go to readC
+	pending   map[string]chan syncResponse
+	pendingMu sync.Mutex
+	// queue keep the messages not routed to a WriteSync until they are read from readC, so
+	// that a slow reader does not block the responses of WriteSync
+	queue       []queuedMessage
+	queueMu     sync.Mutex
+	queueSignal chan struct{}
 }
 
 func (c *client) debug(format string, v ...any) {
@@ -77,12 +98,15 @@ func NewClient(conn Connection) (Client, error) {
 		reconnectSignal:             make(chan struct{}, 1),
 		connectionEstablishedSignal: make(chan struct{}, 1),
 		requestsList:                NewRequestList(),
+		pending:                     make(map[string]chan syncResponse),
+		queueSignal:                 make(chan struct{}, 1),
 		readErrChan:                 make(chan error, 1),
 		readC:                       make(chan []byte),
 	}
 
 	go client.handleReconnect()
 	go client.read()
+	go client.forward()
 
 	return client, nil
 }
@@ -116,42 +140,69 @@ func (c *client) Write(id string, data []byte) error {
 	return nil
 }
 
-// WriteSync sends data to the websocket connection and waits for a response synchronously
-// Should be used separately from the asynchronous Write method (do not send anything in parallel)
+// WriteSync sends data to the websocket connection and waits for a response synchronously.
+// The response is routed by id, so it can be used in parallel with other WriteSync calls and
+// with Write, whose responses are still sent to the read channel.
 func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
-	c.connMu.Lock()
-	defer c.connMu.Unlock()
+	respC := make(chan syncResponse, 1)
+	c.pendingMu.Lock()
+	if _, ok := c.pending[id]; ok {
+		c.pendingMu.Unlock()
+		return nil, ErrorWsIdAlreadySent
+	}
+	c.pending[id] = respC
+	c.pendingMu.Unlock()
+	defer c.removePending(id)
 
-	if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+	c.connMu.Lock()
+	err := c.conn.WriteMessage(websocket.TextMessage, data)
+	c.connMu.Unlock()
+	if err != nil {
 		c.debug("write sync: unable to write message into websocket conn '%v'", err)
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
 
-	for {
-		select {
-		case <-ctx.Done():
-			c.debug("write sync: timeout expired")
-			return nil, ErrorWsReadConnectionTimeout
-		case rawData := <-c.readC:
-			// check that the correct response from websocket has been read
-			msg := messageId{}
-			err := json.Unmarshal(rawData, &msg)
-			if err != nil {
-				return nil, err
-			}
-			if msg.Id != id {
-				c.debug("write sync: wrong response with id '%v' has been read", msg.Id)
-				continue
-			}
-
-			return rawData, nil
-		case err := <-c.readErrChan:
-			c.debug("write sync: error read '%v'", err)
-			return nil, err
+	select {
+	case <-timer.C:
+		c.debug("write sync: timeout expired")
+		return nil, ErrorWsReadConnectionTimeout
+	case res := <-respC:
+		if res.err != nil {
+			c.debug("write sync: error read '%v'", res.err)
 		}
+		return res.data, res.err
+	}
+}
+
+func (c *client) removePending(id string) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	delete(c.pending, id)
+}
+
+// deliver send message to the WriteSync waiting for id, false if there is none
+func (c *client) deliver(id string, message []byte) bool {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	respC, ok := c.pending[id]
+	if !ok {
+		return false
+	}
+	delete(c.pending, id)
+	respC <- syncResponse{data: message}
+	return true
+}
+
+// failPending end the WriteSync calls waiting for a response with err
+func (c *client) failPending(err error) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	for id, respC := range c.pending {
+		delete(c.pending, id)
+		respC <- syncResponse{err: err}
 	}
 }
 
@@ -181,8 +232,9 @@ func (c *client) read() {
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
 			c.debug("read: error reading message '%v'", err)
+			c.failPending(err)
 			c.reconnectSignal <- struct{}{}
-			c.readErrChan <- err
+			c.readError(err)
 
 			c.debug("read: wait to get connected")
 			<-c.connectionEstablishedSignal
@@ -199,15 +251,57 @@ func (c *client) read() {
 		err = json.Unmarshal(message, &msg)
 		if err != nil {
 			c.debug("read: error unmarshalling message '%v'", err)
-			c.readErrChan <- err
+			c.readError(err)
 			continue
 		}
 
-		c.debug("read: sending message into read channel '%v'", msg)
-		c.readC <- message
+		if c.deliver(msg.Id, message) {
+			c.debug("read: sent message to the waiting request '%v'", msg.Id)
+			continue
+		}
 
-		c.debug("read: remove message from request list '%v'", msg)
-		c.requestsList.Remove(msg.Id)
+		c.debug("read: queue message for the read channel '%v'", msg)
+		c.queueMu.Lock()
+		c.queue = append(c.queue, queuedMessage{id: msg.Id, message: message})
+		c.queueMu.Unlock()
+		select {
+		case c.queueSignal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// readError send err to the read error channel, it is dropped when the previous error has
+// not been read, e.g. when the connection is only used with WriteSync which gets the errors
+// through failPending
+func (c *client) readError(err error) {
+	select {
+	case c.readErrChan <- err:
+	default:
+		c.debug("read: error channel full, dropping '%v'", err)
+	}
+}
+
+// forward send the queued messages into the read channel in order
+func (c *client) forward() {
+	for range c.queueSignal {
+		for {
+			c.queueMu.Lock()
+			if len(c.queue) == 0 {
+				c.queueMu.Unlock()
+				break
+			}
+			msg := c.queue[0]
+			c.queue[0] = queuedMessage{}
+			c.queue = c.queue[1:]
+			c.queueMu.Unlock()
+
+			c.debug("forward: sending message into read channel '%v'", msg.id)
+			c.readC <- msg.message
+
+			c.debug("forward: remove message from request list '%v'", msg.id)
+			c.requestsList.Remove(msg.id)
+		}
 	}
 }
 
@@ -108,6 +108,7 @@ func (s *clientTestSuite) TestReadWriteSync() {
 
 				err = client.Write(requestID, reqRaw)
 				s.Require().NoError(err)
+				otherRaw := reqRaw
 
 				req = testApiRequest{
 					Id:     requestID,
@@ -120,6 +121,14 @@ func (s *clientTestSuite) TestReadWriteSync() {
 				responseRaw, err := client.WriteSync(requestID, reqRaw, 5*time.Second)
 				s.Require().NoError(err)
 				s.Require().Equal(reqRaw, responseRaw)
+
+				// the response of the other request is not dropped by WriteSync
+				select {
+				case responseRaw := <-client.GetReadChannel():
+					s.Require().Equal(otherRaw, responseRaw)
+				case <-time.After(5 * time.Second):
+					s.T().Fatal("timeout waiting for the other response")
+				}
 			},
 		},
 		{
@@ -144,6 +153,44 @@ func (s *clientTestSuite) TestReadWriteSync() {
 				s.Require().ErrorIs(err, ErrorWsReadConnectionTimeout)
 			},
 		},
+		{
+			name: "WriteSync in parallel with a request waiting for its response",
+			testCallback: func() {
+				slowDone := make(chan error, 1)
+				go func() {
+					req := testApiRequest{
+						Id:     "slow-request-id",
+						Method: "some-method",
+						Params: map[string]any{
+							"timeout": "true",
+						},
+					}
+					reqRaw, _ := json.Marshal(req)
+					_, err := client.WriteSync(req.Id, reqRaw, time.Second)
+					slowDone <- err
+				}()
+
+				id, err := uuid.NewRandom()
+				s.Require().NoError(err)
+				requestID := id.String()
+
+				req := testApiRequest{
+					Id:     requestID,
+					Method: "some-method",
+					Params: map[string]any{},
+				}
+				reqRaw, err := json.Marshal(req)
+				s.Require().NoError(err)
+
+				start := time.Now()
+				responseRaw, err := client.WriteSync(requestID, reqRaw, 5*time.Second)
+				s.Require().NoError(err)
+				s.Require().Equal(reqRaw, responseRaw)
+				s.Require().Less(time.Since(start), time.Second)
+
+				s.Require().ErrorIs(<-slowDone, ErrorWsReadConnectionTimeout)
+			},
+		},
 		{
 			name: "WriteAsync success",
 			testCallback: func() {
@@ -0,0 +1,157 @@
+package websocket
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// MaxConnectionAge is slightly below the 24 hours after which the server closes stream connections
+const MaxConnectionAge = 23*time.Hour + 30*time.Minute
+
+// ServeFunc open one stream connection, doneC is closed when it ends and
+// errHandler receives the error which ended it
+type ServeFunc func(errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+
+// DisconnectedEvent is passed to the error handler of a resilient stream when
+// its connection ends, the stream is reconnected with backoff
+type DisconnectedEvent struct {
+	// Err is the error which ended the connection, nil if it ended without one
+	Err error
+}
+
+// Error return the description of the event
+func (e *DisconnectedEvent) Error() string {
+	if e.Err == nil {
+		return "ws stream: disconnected"
+	}
+	return fmt.Sprintf("ws stream: disconnected: %v", e.Err)
+}
+
+// Unwrap return the error which ended the connection
+func (e *DisconnectedEvent) Unwrap() error {
+	return e.Err
+}
+
+// ReconnectedEvent is passed to the error handler of a resilient stream when it
+// is connected again after a DisconnectedEvent. The messages sent while it was
+// disconnected are lost, consumers keeping a state from the stream should resync.
+type ReconnectedEvent struct {
+	// Attempts is the number of dials needed to reconnect
+	Attempts int
+	// Downtime is the time elapsed since the disconnection
+	Downtime time.Duration
+}
+
+// Error return the description of the event
+func (e *ReconnectedEvent) Error() string {
+	return fmt.Sprintf("ws stream: reconnected after %s and %d attempt(s), messages may have been missed", e.Downtime, e.Attempts)
+}
+
+// ServeResilient serve a stream which reconnects with backoff when its connection
+// ends, and rotates its connection every maxAge (if > 0) by opening the next one
+// before closing the current one, a few messages may be delivered twice then.
+// Disconnections and reconnections are reported to errHandler with a
+// DisconnectedEvent and a ReconnectedEvent. doneC is closed once stopC is closed.
+func ServeResilient(serve ServeFunc, errHandler func(err error), maxAge time.Duration) (doneC, stopC chan struct{}, err error) {
+	s := &resilientStream{serve: serve, errHandler: errHandler}
+	connDoneC, connStopC, err := serve(s.connErrHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go s.run(connDoneC, connStopC, doneC, stopC, maxAge)
+	return doneC, stopC, nil
+}
+
+type resilientStream struct {
+	serve      ServeFunc
+	errHandler func(err error)
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+// connErrHandler keep the error ending a connection for the DisconnectedEvent
+func (s *resilientStream) connErrHandler(err error) {
+	s.mu.Lock()
+	s.lastErr = err
+	s.mu.Unlock()
+}
+
+func (s *resilientStream) takeErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.lastErr
+	s.lastErr = nil
+	return err
+}
+
+func (s *resilientStream) run(connDoneC, connStopC, doneC, stopC chan struct{}, maxAge time.Duration) {
+	defer close(doneC)
+	b := &backoff.Backoff{
+		Min:    reconnectMinInterval,
+		Max:    reconnectMaxInterval,
+		Factor: 2,
+		Jitter: true,
+	}
+	for {
+		var rotateC <-chan time.Time
+		var timer *time.Timer
+		if maxAge > 0 {
+			timer = time.NewTimer(maxAge)
+			rotateC = timer.C
+		}
+		select {
+		case <-stopC:
+			if timer != nil {
+				timer.Stop()
+			}
+			close(connStopC)
+			<-connDoneC
+			return
+		case <-rotateC:
+			// open the next connection first so that no message is missed
+			nextDoneC, nextStopC, err := s.serve(s.connErrHandler)
+			if err != nil {
+				// keep the current connection, it will be reconnected when the server closes it
+				s.errHandler(err)
+				continue
+			}
+			close(connStopC)
+			<-connDoneC
+			s.takeErr()
+			connDoneC, connStopC = nextDoneC, nextStopC
+			continue
+		case <-connDoneC:
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+
+		disconnectedAt := time.Now()
+		s.errHandler(&DisconnectedEvent{Err: s.takeErr()})
+		b.Reset()
+		for {
+			select {
+			case <-stopC:
+				return
+			case <-time.After(b.Duration()):
+			}
+			nextDoneC, nextStopC, err := s.serve(s.connErrHandler)
+			if err != nil {
+				s.errHandler(err)
+				continue
+			}
+			connDoneC, connStopC = nextDoneC, nextStopC
+			break
+		}
+		s.errHandler(&ReconnectedEvent{
+			Attempts: int(b.Attempt()),
+			Downtime: time.Since(disconnectedAt),
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package websocket
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type resilientServeTestSuite struct {
+	suite.Suite
+
+	mu      sync.Mutex
+	conns   []*fakeStreamConn
+	dialErr error
+	events  chan error
+}
+
+// fakeStreamConn is a connection opened by the fake ServeFunc
+type fakeStreamConn struct {
+	doneC      chan struct{}
+	stopC      chan struct{}
+	errHandler func(err error)
+}
+
+// drop end the connection with err as the server would
+func (c *fakeStreamConn) drop(err error) {
+	c.errHandler(err)
+	close(c.doneC)
+}
+
+func TestResilientServe(t *testing.T) {
+	suite.Run(t, new(resilientServeTestSuite))
+}
+
+func (s *resilientServeTestSuite) SetupTest() {
+	s.conns = nil
+	s.dialErr = nil
+	s.events = make(chan error, 10)
+}
+
+func (s *resilientServeTestSuite) serve(errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dialErr != nil {
+		err := s.dialErr
+		s.dialErr = nil
+		return nil, nil, err
+	}
+	c := &fakeStreamConn{doneC: make(chan struct{}), stopC: make(chan struct{}), errHandler: errHandler}
+	go func() {
+		select {
+		case <-c.stopC:
+			close(c.doneC)
+		case <-c.doneC:
+		}
+	}()
+	s.conns = append(s.conns, c)
+	return c.doneC, c.stopC, nil
+}
+
+func (s *resilientServeTestSuite) conn(i int) *fakeStreamConn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns[i]
+}
+
+func (s *resilientServeTestSuite) connCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+func (s *resilientServeTestSuite) nextEvent() error {
+	select {
+	case err := <-s.events:
+		return err
+	case <-time.After(5 * time.Second):
+		s.FailNow("timeout waiting for stream event")
+		return nil
+	}
+}
+
+func (s *resilientServeTestSuite) TestReconnect() {
+	doneC, stopC, err := ServeResilient(s.serve, func(err error) { s.events <- err }, 0)
+	s.Require().NoError(err)
+
+	readErr := errors.New("read: connection reset by peer")
+	s.conn(0).drop(readErr)
+
+	var disconnected *DisconnectedEvent
+	s.Require().ErrorAs(s.nextEvent(), &disconnected)
+	s.ErrorIs(disconnected, readErr)
+
+	var reconnected *ReconnectedEvent
+	s.Require().ErrorAs(s.nextEvent(), &reconnected)
+	s.Equal(1, reconnected.Attempts)
+	s.Equal(2, s.connCount())
+
+	close(stopC)
+	<-doneC
+	select {
+	case <-s.conn(1).doneC:
+	default:
+		s.Fail("connection not closed")
+	}
+}
+
+func (s *resilientServeTestSuite) TestReconnectDialError() {
+	_, stopC, err := ServeResilient(s.serve, func(err error) { s.events <- err }, 0)
+	s.Require().NoError(err)
+	defer close(stopC)
+
+	dialErr := errors.New("dial: connection refused")
+	s.mu.Lock()
+	s.dialErr = dialErr
+	s.mu.Unlock()
+	s.conn(0).drop(nil)
+
+	var disconnected *DisconnectedEvent
+	s.Require().ErrorAs(s.nextEvent(), &disconnected)
+	s.NoError(disconnected.Err)
+	s.Equal(dialErr, s.nextEvent())
+	var reconnected *ReconnectedEvent
+	s.Require().ErrorAs(s.nextEvent(), &reconnected)
+	s.Equal(2, reconnected.Attempts)
+}
+
+func (s *resilientServeTestSuite) TestRotate() {
+	_, stopC, err := ServeResilient(s.serve, func(err error) { s.events <- err }, 50*time.Millisecond)
+	s.Require().NoError(err)
+	defer close(stopC)
+
+	s.Eventually(func() bool {
+		return s.connCount() >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	<-s.conn(0).doneC
+	select {
+	case err := <-s.events:
+		s.Failf("unexpected event", "%v", err)
+	default:
+	}
+}
+
+func (s *resilientServeTestSuite) TestFirstDialError() {
+	s.dialErr = errors.New("dial: connection refused")
+	_, _, err := ServeResilient(s.serve, func(err error) {}, 0)
+	s.Error(err)
+}
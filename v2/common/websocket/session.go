@@ -0,0 +1,223 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	// SessionLogonWsApiMethod define method for authenticating the websocket API connection
+	SessionLogonWsApiMethod WsApiMethodType = "session.logon"
+
+	// SessionStatusWsApiMethod define method for query the authentication status of the connection
+	SessionStatusWsApiMethod WsApiMethodType = "session.status"
+
+	// SessionLogoutWsApiMethod define method for forgetting the API key of the connection
+	SessionLogoutWsApiMethod WsApiMethodType = "session.logout"
+)
+
+var (
+	// ErrorSessionKeyType defines that session.logon is only supported with Ed25519 keys
+	ErrorSessionKeyType = errors.New("ws service: session logon requires an Ed25519 key")
+)
+
+// SessionStatus define the authentication status of a websocket API connection
+type SessionStatus struct {
+	ApiKey           *string `json:"apiKey"`
+	AuthorizedSince  *int64  `json:"authorizedSince"`
+	ConnectedSince   int64   `json:"connectedSince"`
+	ReturnRateLimits bool    `json:"returnRateLimits"`
+	ServerTime       int64   `json:"serverTime"`
+	UserDataStream   *bool   `json:"userDataStream,omitempty"`
+}
+
+// SessionStatusResponse define 'session.logon', 'session.status' and 'session.logout' websocket API response
+type SessionStatusResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result SessionStatus `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// sessionAuthenticator is implemented by the clients which authenticate their connection
+type sessionAuthenticator interface {
+	Authenticated() bool
+}
+
+// Session is a Client whose connection is authenticated with 'session.logon'.
+// Requests created with CreateClientRequest are then sent without apiKey and signature.
+// The Client restores its connection on its own, Session logs on again before sending
+// the next request once it happened.
+type Session struct {
+	Client
+
+	apiKey     string
+	secretKey  string
+	timeOffset func() int64
+
+	mu             sync.Mutex
+	loggedOn       bool
+	reconnectCount int64
+}
+
+// NewSession init Session on top of c, timeOffset may be nil. Call Logon to authenticate the connection.
+func NewSession(c Client, apiKey, secretKey, keyType string, timeOffset func() int64) (*Session, error) {
+	if apiKey == "" {
+		return nil, ErrorApiKeyIsNotSet
+	}
+	if secretKey == "" {
+		return nil, ErrorSecretKeyIsNotSet
+	}
+	if keyType != common.KeyTypeEd25519 {
+		return nil, ErrorSessionKeyType
+	}
+	if timeOffset == nil {
+		timeOffset = func() int64 { return 0 }
+	}
+	return &Session{
+		Client:     c,
+		apiKey:     apiKey,
+		secretKey:  secretKey,
+		timeOffset: timeOffset,
+	}, nil
+}
+
+// Logon authenticate the connection with 'session.logon'
+func (s *Session) Logon() (*SessionStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logon()
+}
+
+func (s *Session) logon() (*SessionStatusResponse, error) {
+	reconnectCount := s.Client.GetReconnectCount()
+	requestID := uuid.New().String()
+	rawData, err := CreateRequest(
+		NewRequestData(requestID, s.apiKey, s.secretKey, s.timeOffset(), common.KeyTypeEd25519),
+		SessionLogonWsApiMethod,
+		map[string]any{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.writeSync(requestID, rawData)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return response, response.Error
+	}
+	s.loggedOn = true
+	s.reconnectCount = reconnectCount
+	return response, nil
+}
+
+// Status query the authentication status of the connection with 'session.status'
+func (s *Session) Status() (*SessionStatusResponse, error) {
+	requestID := uuid.New().String()
+	rawData, err := CreateUnsignedRequest(requestID, SessionStatusWsApiMethod, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+	return s.writeSync(requestID, rawData)
+}
+
+// Logout forget the API key of the connection with 'session.logout', requests are signed again afterwards
+func (s *Session) Logout() (*SessionStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requestID := uuid.New().String()
+	rawData, err := CreateUnsignedRequest(requestID, SessionLogoutWsApiMethod, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.writeSync(requestID, rawData)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error == nil {
+		s.loggedOn = false
+	}
+	return response, nil
+}
+
+// Authenticated return true when the requests of the session do not need to be signed
+func (s *Session) Authenticated() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loggedOn
+}
+
+// Write log on again if the connection was restored and send data
+func (s *Session) Write(id string, data []byte) error {
+	if err := s.relogon(); err != nil {
+		return err
+	}
+	return s.Client.Write(id, data)
+}
+
+// WriteSync log on again if the connection was restored, send data and wait for the response
+func (s *Session) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
+	if err := s.relogon(); err != nil {
+		return nil, err
+	}
+	return s.Client.WriteSync(id, data, timeout)
+}
+
+// relogon authenticate the connection again when the Client reconnected since the last logon
+func (s *Session) relogon() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.loggedOn || s.Client.GetReconnectCount() == s.reconnectCount {
+		return nil
+	}
+	_, err := s.logon()
+	return err
+}
+
+func (s *Session) writeSync(requestID string, rawData []byte) (*SessionStatusResponse, error) {
+	response, err := s.Client.WriteSync(requestID, rawData, WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+	status := &SessionStatusResponse{}
+	if err := json.Unmarshal(response, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// CreateClientRequest creates ws request to be sent with c, it is signed unless the
+// connection of c is authenticated, see Session
+func CreateClientRequest(c Client, reqData RequestData, method WsApiMethodType, params map[string]any) ([]byte, error) {
+	if s, ok := c.(sessionAuthenticator); !ok || !s.Authenticated() {
+		return CreateRequest(reqData, method, params)
+	}
+
+	if reqData.requestID == "" {
+		return nil, ErrorRequestIDNotSet
+	}
+
+	params[timestampKey] = timestamp(reqData.timeOffset)
+
+	req := WsApiRequest{
+		Id:     reqData.requestID,
+		Method: method,
+		Params: params,
+	}
+
+	rawData, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawData, nil
+}
@@ -0,0 +1,160 @@
+package websocket
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// fakeWsApiClient answers the session requests and records the other ones
+type fakeWsApiClient struct {
+	mu             sync.Mutex
+	requests       []testApiRequest
+	reconnectCount int64
+	logonError     bool
+}
+
+func (c *fakeWsApiClient) Write(id string, data []byte) error {
+	c.record(data)
+	return nil
+}
+
+func (c *fakeWsApiClient) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
+	req := c.record(data)
+	switch {
+	case req.Method == string(SessionLogonWsApiMethod) && c.logonError:
+		return []byte(fmt.Sprintf(`{"id":%q,"status":401,"error":{"code":-1022,"msg":"Signature for this request is not valid."}}`, id)), nil
+	case req.Method == string(SessionLogoutWsApiMethod):
+		return []byte(fmt.Sprintf(`{"id":%q,"status":200,"result":{"apiKey":null,"authorizedSince":null,"connectedSince":1649729873021,"returnRateLimits":false,"serverTime":1649730611671}}`, id)), nil
+	default:
+		return []byte(fmt.Sprintf(`{"id":%q,"status":200,"result":{"apiKey":"dummyApiKey","authorizedSince":1649729878532,"connectedSince":1649729873021,"returnRateLimits":false,"serverTime":1649729878630}}`, id)), nil
+	}
+}
+
+func (c *fakeWsApiClient) record(data []byte) testApiRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	req := testApiRequest{}
+	_ = json.Unmarshal(data, &req)
+	c.requests = append(c.requests, req)
+	return req
+}
+
+func (c *fakeWsApiClient) methods() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	methods := make([]string, 0, len(c.requests))
+	for _, req := range c.requests {
+		methods = append(methods, req.Method)
+	}
+	return methods
+}
+
+func (c *fakeWsApiClient) GetReadChannel() <-chan []byte     { return nil }
+func (c *fakeWsApiClient) GetReadErrorChannel() <-chan error { return nil }
+func (c *fakeWsApiClient) GetReconnectCount() int64          { return c.reconnectCount }
+func (c *fakeWsApiClient) Wait(timeout time.Duration)        {}
+func (c *fakeWsApiClient) Close() error                      { return nil }
+
+type sessionTestSuite struct {
+	suite.Suite
+	client    *fakeWsApiClient
+	secretKey string
+}
+
+func TestSession(t *testing.T) {
+	suite.Run(t, new(sessionTestSuite))
+}
+
+func (s *sessionTestSuite) SetupTest() {
+	s.client = &fakeWsApiClient{}
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	s.Require().NoError(err)
+	s.secretKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func (s *sessionTestSuite) newSession() *Session {
+	session, err := NewSession(s.client, "dummyApiKey", s.secretKey, common.KeyTypeEd25519, nil)
+	s.Require().NoError(err)
+	return session
+}
+
+func (s *sessionTestSuite) createOrder(session *Session) testApiRequest {
+	rawData, err := CreateClientRequest(
+		session,
+		NewRequestData("order-1", "dummyApiKey", s.secretKey, 0, common.KeyTypeEd25519),
+		OrderPlaceSpotWsApiMethod,
+		map[string]any{"symbol": "BTCUSDT"},
+	)
+	s.Require().NoError(err)
+	req := testApiRequest{}
+	s.Require().NoError(json.Unmarshal(rawData, &req))
+	return req
+}
+
+func (s *sessionTestSuite) TestLogon() {
+	session := s.newSession()
+	s.Contains(s.createOrder(session).Params, signatureKey)
+
+	response, err := session.Logon()
+	s.Require().NoError(err)
+	s.Equal("dummyApiKey", *response.Result.ApiKey)
+	s.True(session.Authenticated())
+
+	logon := s.client.requests[0]
+	s.Equal(string(SessionLogonWsApiMethod), logon.Method)
+	s.Contains(logon.Params, signatureKey)
+
+	req := s.createOrder(session)
+	s.Contains(req.Params, timestampKey)
+	s.NotContains(req.Params, apiKey)
+	s.NotContains(req.Params, signatureKey)
+}
+
+func (s *sessionTestSuite) TestLogonError() {
+	s.client.logonError = true
+	session := s.newSession()
+	_, err := session.Logon()
+	s.True(common.IsAPIError(err))
+	s.False(session.Authenticated())
+}
+
+func (s *sessionTestSuite) TestRelogonAfterReconnect() {
+	session := s.newSession()
+	_, err := session.Logon()
+	s.Require().NoError(err)
+
+	s.Require().NoError(session.Write("order-1", []byte(`{"id":"order-1","method":"order.place"}`)))
+	s.client.reconnectCount++
+	s.Require().NoError(session.Write("order-2", []byte(`{"id":"order-2","method":"order.place"}`)))
+	s.Equal([]string{"session.logon", "order.place", "session.logon", "order.place"}, s.client.methods())
+}
+
+func (s *sessionTestSuite) TestLogout() {
+	session := s.newSession()
+	_, err := session.Logon()
+	s.Require().NoError(err)
+
+	response, err := session.Logout()
+	s.Require().NoError(err)
+	s.Nil(response.Result.ApiKey)
+	s.False(session.Authenticated())
+	s.Contains(s.createOrder(session).Params, signatureKey)
+}
+
+func (s *sessionTestSuite) TestKeyType() {
+	_, err := NewSession(s.client, "dummyApiKey", "dummySecretKey", common.KeyTypeHmac, nil)
+	s.ErrorIs(err, ErrorSessionKeyType)
+}
@@ -0,0 +1,556 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/gorilla/websocket"
+	"github.com/jpillora/backoff"
+)
+
+var (
+	// MaxStreamsPerConnection is the maximum number of streams subscribed on one connection
+	MaxStreamsPerConnection = 1024
+
+	// MaxStreamsPerURL is the maximum number of streams put in the URL of a new connection,
+	// the other ones are subscribed with SUBSCRIBE messages
+	MaxStreamsPerURL = 100
+
+	// MaxStreamsPerMessage is the maximum number of streams sent in one SUBSCRIBE or UNSUBSCRIBE message
+	MaxStreamsPerMessage = 100
+
+	// StreamControlInterval is the minimum delay between two control messages on a connection,
+	// the server accepts 5 incoming messages per second including pongs
+	StreamControlInterval = 250 * time.Millisecond
+
+	// StreamControlTimeout is the time to wait for the response of a control message
+	StreamControlTimeout = 10 * time.Second
+
+	// ErrStreamManagerClosed is returned when using a closed StreamManager
+	ErrStreamManagerClosed = errors.New("stream manager: closed")
+
+	// ErrStreamConnectionClosed is returned when the connection ends before a control message is answered
+	ErrStreamConnectionClosed = errors.New("stream manager: connection closed")
+)
+
+// StreamHandler handle the data of a stream
+type StreamHandler func(stream string, data []byte)
+
+// StreamDialer open a combined stream connection subscribed to streams. onConn must be
+// called with the connection before its messages are read, and doneC closed when it ends.
+type StreamDialer func(streams []string, handler func(message []byte), errHandler func(err error), onConn func(c *websocket.Conn)) (doneC, stopC chan struct{}, err error)
+
+// StreamManager multiplexes streams over combined stream connections. Streams are
+// subscribed and unsubscribed with the SUBSCRIBE/UNSUBSCRIBE control messages, spread
+// over several connections to respect the per connection stream limit, and subscribed
+// again when a connection is re-established.
+type StreamManager struct {
+	dial       StreamDialer
+	errHandler func(err error)
+
+	mu       sync.Mutex
+	conns    []*streamConn
+	handlers map[string]StreamHandler
+	owners   map[string]*streamConn
+	closed   bool
+
+	nextID int64
+}
+
+// NewStreamManager init a StreamManager opening connections with dial
+func NewStreamManager(dial StreamDialer, errHandler func(err error)) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	return &StreamManager{
+		dial:       dial,
+		errHandler: errHandler,
+		handlers:   make(map[string]StreamHandler),
+		owners:     make(map[string]*streamConn),
+	}
+}
+
+// Subscribe route streams to handler, subscribing the ones not subscribed yet.
+// Streams already subscribed only have their handler replaced.
+func (m *StreamManager) Subscribe(handler StreamHandler, streams ...string) error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrStreamManagerClosed
+	}
+	added := make(map[*streamConn][]string)
+	var order []*streamConn
+	for _, stream := range streams {
+		if _, ok := m.handlers[stream]; ok {
+			m.handlers[stream] = handler
+			continue
+		}
+		sc := m.connWithRoom()
+		if _, ok := added[sc]; !ok {
+			order = append(order, sc)
+		}
+		added[sc] = append(added[sc], stream)
+		sc.streams[stream] = true
+		m.owners[stream] = sc
+		m.handlers[stream] = handler
+	}
+	m.mu.Unlock()
+
+	var firstErr error
+	for _, sc := range order {
+		if err := sc.subscribe(added[sc]); err != nil {
+			m.forget(sc, added[sc])
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// connWithRoom return a connection with room for one more stream, must be called with mu held
+func (m *StreamManager) connWithRoom() *streamConn {
+	for _, sc := range m.conns {
+		if len(sc.streams) < MaxStreamsPerConnection {
+			return sc
+		}
+	}
+	sc := newStreamConn(m)
+	m.conns = append(m.conns, sc)
+	return sc
+}
+
+// forget drop streams from the local state
+func (m *StreamManager) forget(sc *streamConn, streams []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, stream := range streams {
+		if m.owners[stream] != sc {
+			continue
+		}
+		delete(m.owners, stream)
+		delete(m.handlers, stream)
+		delete(sc.streams, stream)
+	}
+	m.dropIfEmpty(sc)
+}
+
+// dropIfEmpty close a connection without streams, must be called with mu held
+func (m *StreamManager) dropIfEmpty(sc *streamConn) {
+	if len(sc.streams) > 0 {
+		return
+	}
+	for i, c := range m.conns {
+		if c == sc {
+			m.conns = append(m.conns[:i], m.conns[i+1:]...)
+			break
+		}
+	}
+	sc.close()
+}
+
+// Unsubscribe stop routing streams and unsubscribe them
+func (m *StreamManager) Unsubscribe(streams ...string) error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrStreamManagerClosed
+	}
+	removed := make(map[*streamConn][]string)
+	var order []*streamConn
+	for _, stream := range streams {
+		sc, ok := m.owners[stream]
+		if !ok {
+			continue
+		}
+		if _, ok := removed[sc]; !ok {
+			order = append(order, sc)
+		}
+		removed[sc] = append(removed[sc], stream)
+		delete(m.owners, stream)
+		delete(m.handlers, stream)
+		delete(sc.streams, stream)
+	}
+	var firstErr error
+	var remaining []*streamConn
+	for _, sc := range order {
+		if len(sc.streams) == 0 {
+			m.dropIfEmpty(sc)
+			continue
+		}
+		remaining = append(remaining, sc)
+	}
+	m.mu.Unlock()
+
+	for _, sc := range remaining {
+		if err := sc.control("UNSUBSCRIBE", removed[sc], nil); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Streams return the streams subscribed through the manager
+func (m *StreamManager) Streams() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	streams := make([]string, 0, len(m.handlers))
+	for stream := range m.handlers {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+	return streams
+}
+
+// ListSubscriptions ask every connection for its subscriptions with LIST_SUBSCRIPTIONS
+func (m *StreamManager) ListSubscriptions() ([]string, error) {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil, ErrStreamManagerClosed
+	}
+	conns := append([]*streamConn(nil), m.conns...)
+	m.mu.Unlock()
+
+	var streams []string
+	for _, sc := range conns {
+		var res []string
+		if err := sc.control("LIST_SUBSCRIPTIONS", nil, &res); err != nil {
+			return nil, err
+		}
+		streams = append(streams, res...)
+	}
+	sort.Strings(streams)
+	return streams, nil
+}
+
+// Connections return the number of open connections
+func (m *StreamManager) Connections() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.conns)
+}
+
+// Close unsubscribe every stream and close the connections
+func (m *StreamManager) Close() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	conns := m.conns
+	m.conns = nil
+	m.handlers = make(map[string]StreamHandler)
+	m.owners = make(map[string]*streamConn)
+	m.mu.Unlock()
+	for _, sc := range conns {
+		sc.close()
+	}
+}
+
+func (m *StreamManager) handler(stream string) StreamHandler {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.handlers[stream]
+}
+
+// streamMessage define a message received on a combined stream connection,
+// either stream data or the response of a control message
+type streamMessage struct {
+	Stream string           `json:"stream"`
+	Data   json.RawMessage  `json:"data"`
+	ID     *int64           `json:"id"`
+	Result json.RawMessage  `json:"result"`
+	Error  *common.APIError `json:"error"`
+}
+
+// streamControl define a SUBSCRIBE, UNSUBSCRIBE or LIST_SUBSCRIPTIONS message
+type streamControl struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	ID     int64    `json:"id"`
+}
+
+// streamConn is a connection of a StreamManager, it reconnects until closed
+type streamConn struct {
+	m       *StreamManager
+	streams map[string]bool // guarded by m.mu
+
+	// connMu serializes the subscriptions with the (re)connections so that
+	// a stream is either in the URL of a connection or subscribed on it
+	connMu sync.Mutex
+
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	ready   chan struct{}
+	doneC   chan struct{}
+	stopC   chan struct{}
+	pending map[int64]chan *streamMessage
+	started bool
+	closed  bool
+	closeC  chan struct{}
+
+	writeMu  sync.Mutex
+	lastSent time.Time
+}
+
+func newStreamConn(m *StreamManager) *streamConn {
+	return &streamConn{
+		m:       m,
+		streams: make(map[string]bool),
+		pending: make(map[int64]chan *streamMessage),
+		closeC:  make(chan struct{}),
+	}
+}
+
+// subscribe connect with streams, or subscribe them on the running connection
+func (sc *streamConn) subscribe(streams []string) error {
+	sc.connMu.Lock()
+	defer sc.connMu.Unlock()
+	sc.mu.Lock()
+	started := sc.started
+	sc.mu.Unlock()
+	if started {
+		return sc.control("SUBSCRIBE", streams, nil)
+	}
+	rest, err := sc.connect()
+	if err != nil {
+		return err
+	}
+	sc.mu.Lock()
+	sc.started = true
+	sc.mu.Unlock()
+	go sc.run()
+	return sc.control("SUBSCRIBE", rest, nil)
+}
+
+// connect dial a connection subscribed to the current streams and return
+// the streams which did not fit in the URL, must be called with connMu held
+func (sc *streamConn) connect() (rest []string, err error) {
+	sc.m.mu.Lock()
+	streams := make([]string, 0, len(sc.streams))
+	for stream := range sc.streams {
+		streams = append(streams, stream)
+	}
+	sc.m.mu.Unlock()
+	sort.Strings(streams)
+	if len(streams) > MaxStreamsPerURL {
+		streams, rest = streams[:MaxStreamsPerURL], streams[MaxStreamsPerURL:]
+	}
+
+	ready := make(chan struct{})
+	doneC, stopC, err := sc.m.dial(streams, sc.onMessage, sc.m.errHandler, func(c *websocket.Conn) {
+		sc.mu.Lock()
+		sc.conn = c
+		sc.mu.Unlock()
+		close(ready)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sc.mu.Lock()
+	sc.ready = ready
+	sc.doneC = doneC
+	sc.stopC = stopC
+	closed := sc.closed
+	sc.mu.Unlock()
+	if closed {
+		close(stopC)
+		return nil, ErrStreamConnectionClosed
+	}
+	return rest, nil
+}
+
+// run reconnect with the current streams each time the connection ends, until closed
+func (sc *streamConn) run() {
+	b := &backoff.Backoff{
+		Min:    reconnectMinInterval,
+		Max:    reconnectMaxInterval,
+		Factor: 2,
+		Jitter: true,
+	}
+	for {
+		sc.mu.Lock()
+		doneC := sc.doneC
+		sc.mu.Unlock()
+		select {
+		case <-sc.closeC:
+			return
+		case <-doneC:
+		}
+		sc.failPending()
+		for {
+			select {
+			case <-sc.closeC:
+				return
+			case <-time.After(b.Duration()):
+			}
+			if sc.reconnect() {
+				b.Reset()
+				break
+			}
+		}
+	}
+}
+
+// reconnect dial a new connection and subscribe the streams which did not fit
+// in its URL, it return false when the dial failed
+func (sc *streamConn) reconnect() bool {
+	sc.connMu.Lock()
+	defer sc.connMu.Unlock()
+	rest, err := sc.connect()
+	if err != nil {
+		if !errors.Is(err, ErrStreamConnectionClosed) {
+			sc.m.errHandler(err)
+			return false
+		}
+		return true
+	}
+	if err := sc.control("SUBSCRIBE", rest, nil); err != nil {
+		sc.m.errHandler(err)
+	}
+	return true
+}
+
+// failPending wake up the control messages waiting on a closed connection
+func (sc *streamConn) failPending() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for id, ch := range sc.pending {
+		close(ch)
+		delete(sc.pending, id)
+	}
+}
+
+func (sc *streamConn) onMessage(message []byte) {
+	msg := new(streamMessage)
+	if err := json.Unmarshal(message, msg); err != nil {
+		sc.m.errHandler(err)
+		return
+	}
+	if msg.Stream != "" {
+		if handler := sc.m.handler(msg.Stream); handler != nil {
+			handler(msg.Stream, msg.Data)
+		}
+		return
+	}
+	if msg.ID == nil {
+		return
+	}
+	sc.mu.Lock()
+	ch, ok := sc.pending[*msg.ID]
+	delete(sc.pending, *msg.ID)
+	sc.mu.Unlock()
+	if ok {
+		ch <- msg
+	}
+}
+
+// control send a control message in chunks and wait for the responses,
+// result receives the result of the last response when not nil
+func (sc *streamConn) control(method string, params []string, result any) error {
+	if method != "LIST_SUBSCRIPTIONS" && len(params) == 0 {
+		return nil
+	}
+	for len(params) > MaxStreamsPerMessage {
+		if err := sc.send(method, params[:MaxStreamsPerMessage], nil); err != nil {
+			return err
+		}
+		params = params[MaxStreamsPerMessage:]
+	}
+	return sc.send(method, params, result)
+}
+
+func (sc *streamConn) send(method string, params []string, result any) error {
+	sc.mu.Lock()
+	ready, doneC := sc.ready, sc.doneC
+	sc.mu.Unlock()
+	if ready == nil {
+		return ErrStreamConnectionClosed
+	}
+	select {
+	case <-ready:
+	case <-doneC:
+	case <-time.After(StreamControlTimeout):
+		return ErrorWsReadConnectionTimeout
+	}
+	select {
+	case <-doneC:
+		return sc.closedErr(method)
+	default:
+	}
+
+	id := atomic.AddInt64(&sc.m.nextID, 1)
+	ch := make(chan *streamMessage, 1)
+	sc.mu.Lock()
+	conn := sc.conn
+	sc.pending[id] = ch
+	sc.mu.Unlock()
+	data, err := json.Marshal(streamControl{Method: method, Params: params, ID: id})
+	if err != nil {
+		return err
+	}
+
+	sc.writeMu.Lock()
+	if wait := StreamControlInterval - time.Since(sc.lastSent); wait > 0 {
+		time.Sleep(wait)
+	}
+	err = conn.WriteMessage(websocket.TextMessage, data)
+	sc.lastSent = time.Now()
+	sc.writeMu.Unlock()
+	if err != nil {
+		sc.mu.Lock()
+		delete(sc.pending, id)
+		sc.mu.Unlock()
+		return err
+	}
+
+	timer := time.NewTimer(StreamControlTimeout)
+	defer timer.Stop()
+	select {
+	case msg, ok := <-ch:
+		if !ok {
+			return sc.closedErr(method)
+		}
+		if msg.Error != nil {
+			return msg.Error
+		}
+		if result != nil && len(msg.Result) > 0 {
+			return json.Unmarshal(msg.Result, result)
+		}
+		return nil
+	case <-timer.C:
+		sc.mu.Lock()
+		delete(sc.pending, id)
+		sc.mu.Unlock()
+		return ErrorWsReadConnectionTimeout
+	}
+}
+
+// closedErr return the error of a control message interrupted by the end of the connection.
+// Subscriptions are not lost: the next connection is opened with the streams of the manager.
+func (sc *streamConn) closedErr(method string) error {
+	if method == "LIST_SUBSCRIPTIONS" {
+		return ErrStreamConnectionClosed
+	}
+	return nil
+}
+
+func (sc *streamConn) close() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.closed {
+		return
+	}
+	sc.closed = true
+	close(sc.closeC)
+	if sc.stopC != nil {
+		close(sc.stopC)
+	}
+}
@@ -0,0 +1,286 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+// testStreamServer mimics the combined stream endpoint: it keeps the subscriptions
+// of each connection, answers the control messages and pushes data on demand
+type testStreamServer struct {
+	server *httptest.Server
+
+	mu    sync.Mutex
+	conns []*testStreamServerConn
+}
+
+type testStreamServerConn struct {
+	conn    *websocket.Conn
+	mu      sync.Mutex
+	streams map[string]bool
+}
+
+func newTestStreamServer() *testStreamServer {
+	ts := &testStreamServer{}
+	ts.server = httptest.NewServer(http.HandlerFunc(ts.serve))
+	return ts
+}
+
+func (ts *testStreamServer) serve(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	sc := &testStreamServerConn{conn: conn, streams: make(map[string]bool)}
+	if streams := r.URL.Query().Get("streams"); streams != "" {
+		for _, stream := range strings.Split(streams, "/") {
+			sc.streams[stream] = true
+		}
+	}
+	ts.mu.Lock()
+	ts.conns = append(ts.conns, sc)
+	ts.mu.Unlock()
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		req := streamControl{}
+		if err := json.Unmarshal(message, &req); err != nil {
+			continue
+		}
+		var result any
+		sc.mu.Lock()
+		switch req.Method {
+		case "SUBSCRIBE":
+			for _, stream := range req.Params {
+				sc.streams[stream] = true
+			}
+		case "UNSUBSCRIBE":
+			for _, stream := range req.Params {
+				delete(sc.streams, stream)
+			}
+		case "LIST_SUBSCRIPTIONS":
+			list := make([]string, 0, len(sc.streams))
+			for stream := range sc.streams {
+				list = append(list, stream)
+			}
+			result = list
+		}
+		err = conn.WriteJSON(map[string]any{"result": result, "id": req.ID})
+		sc.mu.Unlock()
+		if err != nil {
+			return
+		}
+	}
+}
+
+// push send data to the connections subscribed to stream
+func (ts *testStreamServer) push(stream string, data string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for _, sc := range ts.conns {
+		sc.mu.Lock()
+		if sc.streams[stream] {
+			sc.conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"stream":%q,"data":%s}`, stream, data)))
+		}
+		sc.mu.Unlock()
+	}
+}
+
+// dropAll close the open connections
+func (ts *testStreamServer) dropAll() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for _, sc := range ts.conns {
+		sc.conn.Close()
+	}
+	ts.conns = nil
+}
+
+func (ts *testStreamServer) connCount() int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return len(ts.conns)
+}
+
+func (ts *testStreamServer) dial(streams []string, handler func(message []byte), errHandler func(err error), onConn func(c *websocket.Conn)) (doneC, stopC chan struct{}, err error) {
+	endpoint := "ws" + strings.TrimPrefix(ts.server.URL, "http") + "/stream"
+	if len(streams) > 0 {
+		endpoint += "?streams=" + strings.Join(streams, "/")
+	}
+	c, _, err := websocket.DefaultDialer.Dial(endpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		onConn(c)
+		go func() {
+			select {
+			case <-stopC:
+			case <-doneC:
+			}
+			c.Close()
+		}()
+		for {
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			handler(message)
+		}
+	}()
+	return doneC, stopC, nil
+}
+
+type streamManagerTestSuite struct {
+	suite.Suite
+	server  *testStreamServer
+	manager *StreamManager
+}
+
+func TestStreamManager(t *testing.T) {
+	suite.Run(t, new(streamManagerTestSuite))
+}
+
+func (s *streamManagerTestSuite) SetupTest() {
+	StreamControlInterval = 0
+	s.server = newTestStreamServer()
+	s.manager = NewStreamManager(s.server.dial, nil)
+}
+
+func (s *streamManagerTestSuite) TearDownTest() {
+	s.manager.Close()
+	s.server.server.Close()
+	StreamControlInterval = 250 * time.Millisecond
+	MaxStreamsPerConnection = 1024
+	MaxStreamsPerURL = 100
+}
+
+// collector gather the data received by a StreamHandler
+type collector struct {
+	mu   sync.Mutex
+	data map[string][]string
+	c    chan struct{}
+}
+
+func newCollector() *collector {
+	return &collector{data: make(map[string][]string), c: make(chan struct{}, 100)}
+}
+
+func (c *collector) handle(stream string, data []byte) {
+	c.mu.Lock()
+	c.data[stream] = append(c.data[stream], string(data))
+	c.mu.Unlock()
+	c.c <- struct{}{}
+}
+
+func (c *collector) get(stream string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.data[stream]
+}
+
+func (s *streamManagerTestSuite) wait(c *collector, n int) {
+	for i := 0; i < n; i++ {
+		select {
+		case <-c.c:
+		case <-time.After(time.Second):
+			s.FailNow("timeout waiting for stream data")
+		}
+	}
+}
+
+func (s *streamManagerTestSuite) TestSubscribeRoute() {
+	trades, klines := newCollector(), newCollector()
+	s.Require().NoError(s.manager.Subscribe(trades.handle, "btcusdt@trade"))
+	s.Require().NoError(s.manager.Subscribe(klines.handle, "btcusdt@kline_1m", "ethusdt@kline_1m"))
+	s.Equal(1, s.manager.Connections())
+
+	s.server.push("btcusdt@trade", `{"p":"1"}`)
+	s.server.push("ethusdt@kline_1m", `{"k":{}}`)
+	s.wait(trades, 1)
+	s.wait(klines, 1)
+	s.Equal([]string{`{"p":"1"}`}, trades.get("btcusdt@trade"))
+	s.Equal([]string{`{"k":{}}`}, klines.get("ethusdt@kline_1m"))
+
+	list, err := s.manager.ListSubscriptions()
+	s.Require().NoError(err)
+	s.Equal([]string{"btcusdt@kline_1m", "btcusdt@trade", "ethusdt@kline_1m"}, list)
+	s.Equal(list, s.manager.Streams())
+}
+
+func (s *streamManagerTestSuite) TestUnsubscribe() {
+	c := newCollector()
+	s.Require().NoError(s.manager.Subscribe(c.handle, "btcusdt@trade", "ethusdt@trade"))
+	s.Require().NoError(s.manager.Unsubscribe("btcusdt@trade"))
+
+	list, err := s.manager.ListSubscriptions()
+	s.Require().NoError(err)
+	s.Equal([]string{"ethusdt@trade"}, list)
+
+	s.Require().NoError(s.manager.Unsubscribe("ethusdt@trade"))
+	s.Equal(0, s.manager.Connections())
+	s.Empty(s.manager.Streams())
+}
+
+func (s *streamManagerTestSuite) TestSharding() {
+	MaxStreamsPerConnection = 3
+	MaxStreamsPerURL = 2
+	c := newCollector()
+	streams := []string{"a@trade", "b@trade", "c@trade", "d@trade", "e@trade"}
+	s.Require().NoError(s.manager.Subscribe(c.handle, streams...))
+	s.Equal(2, s.manager.Connections())
+
+	list, err := s.manager.ListSubscriptions()
+	s.Require().NoError(err)
+	s.Equal(streams, list)
+
+	for _, stream := range streams {
+		s.server.push(stream, `{}`)
+	}
+	s.wait(c, len(streams))
+}
+
+func (s *streamManagerTestSuite) TestRestoreAfterReconnect() {
+	c := newCollector()
+	s.Require().NoError(s.manager.Subscribe(c.handle, "btcusdt@trade"))
+	s.Require().NoError(s.manager.Subscribe(c.handle, "ethusdt@trade"))
+	s.server.dropAll()
+
+	s.Eventually(func() bool {
+		return s.server.connCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	var list []string
+	s.Eventually(func() bool {
+		var err error
+		list, err = s.manager.ListSubscriptions()
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	sort.Strings(list)
+	s.Equal([]string{"btcusdt@trade", "ethusdt@trade"}, list)
+
+	s.server.push("ethusdt@trade", `{}`)
+	s.wait(c, 1)
+}
+
+func (s *streamManagerTestSuite) TestClosed() {
+	s.manager.Close()
+	s.ErrorIs(s.manager.Subscribe(func(string, []byte) {}, "btcusdt@trade"), ErrStreamManagerClosed)
+	s.ErrorIs(s.manager.Unsubscribe("btcusdt@trade"), ErrStreamManagerClosed)
+}
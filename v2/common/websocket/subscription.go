@@ -0,0 +1,210 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy define what a Subscription does with an event when its buffer is full
+type OverflowPolicy int
+
+const (
+	// OverflowBlock stop reading the connection until the consumer takes an event,
+	// the server closes the connection if it stays blocked for too long
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest drop the oldest buffered event to make room for the new one
+	OverflowDropOldest
+	// OverflowDropNewest drop the new event
+	OverflowDropNewest
+)
+
+var (
+	// DefaultSubscriptionBufferSize is the number of events buffered by a Subscription by default
+	DefaultSubscriptionBufferSize = 256
+
+	// ErrSubscriptionClosed is returned by Err when the stream ended without an error
+	ErrSubscriptionClosed = errors.New("ws subscription: stream closed")
+)
+
+// subscriptionErrorsSize is the number of non terminal errors buffered by a Subscription
+const subscriptionErrorsSize = 16
+
+// SubscriptionOption define an option of a Subscription
+type SubscriptionOption func(o *subscriptionOptions)
+
+type subscriptionOptions struct {
+	bufferSize int
+	overflow   OverflowPolicy
+}
+
+// WithBufferSize set the number of events buffered by the Subscription
+func WithBufferSize(size int) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.bufferSize = size
+	}
+}
+
+// WithOverflowPolicy set what the Subscription does with an event when its buffer is full
+func WithOverflowPolicy(policy OverflowPolicy) SubscriptionOption {
+	return func(o *subscriptionOptions) {
+		o.overflow = policy
+	}
+}
+
+// SubscriptionServeFunc start a callback based stream, e.g. a bound Ws*Serve method
+type SubscriptionServeFunc[T any] func(handler func(event T), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+
+// Subscription is a stream consumed through channels instead of callbacks.
+// Events are delivered on Events, which is closed once the stream has ended,
+// either because its context was cancelled, Close was called, or the connection
+// ended. Err then reports why.
+type Subscription[T any] struct {
+	events   chan T
+	errs     chan error
+	overflow OverflowPolicy
+	dropped  int64
+
+	cancel    context.CancelFunc
+	closingC  chan struct{}
+	doneC     chan struct{}
+	closeOnce sync.Once
+
+	mu      sync.Mutex
+	lastErr error
+	err     error
+}
+
+// NewSubscription start a stream with serve and deliver its events on channels until ctx is done
+func NewSubscription[T any](ctx context.Context, serve SubscriptionServeFunc[T], opts ...SubscriptionOption) (*Subscription[T], error) {
+	o := &subscriptionOptions{bufferSize: DefaultSubscriptionBufferSize}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.bufferSize < 0 {
+		o.bufferSize = 0
+	}
+	if o.overflow == OverflowDropOldest && o.bufferSize == 0 {
+		o.bufferSize = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Subscription[T]{
+		events:   make(chan T, o.bufferSize),
+		errs:     make(chan error, subscriptionErrorsSize),
+		overflow: o.overflow,
+		cancel:   cancel,
+		closingC: make(chan struct{}),
+		doneC:    make(chan struct{}),
+	}
+	streamDoneC, streamStopC, err := serve(s.push, s.pushErr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	go s.run(ctx, streamDoneC, streamStopC)
+	return s, nil
+}
+
+func (s *Subscription[T]) run(ctx context.Context, streamDoneC, streamStopC chan struct{}) {
+	defer close(s.doneC)
+	var err error
+	select {
+	case <-ctx.Done():
+		close(s.closingC)
+		close(streamStopC)
+		<-streamDoneC
+		err = ctx.Err()
+	case <-streamDoneC:
+		close(s.closingC)
+		s.mu.Lock()
+		err = s.lastErr
+		s.mu.Unlock()
+		if err == nil {
+			err = ErrSubscriptionClosed
+		}
+	}
+	s.mu.Lock()
+	s.err = err
+	s.mu.Unlock()
+	// the stream does not call the handlers once streamDoneC is closed
+	close(s.events)
+	close(s.errs)
+}
+
+// push deliver event according to the overflow policy
+func (s *Subscription[T]) push(event T) {
+	switch s.overflow {
+	case OverflowDropNewest:
+		select {
+		case s.events <- event:
+		default:
+			atomic.AddInt64(&s.dropped, 1)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.events <- event:
+				return
+			default:
+			}
+			select {
+			case <-s.events:
+				atomic.AddInt64(&s.dropped, 1)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.events <- event:
+		case <-s.closingC:
+		}
+	}
+}
+
+// pushErr keep err as the possible terminal error and deliver it on Errors if there is room
+func (s *Subscription[T]) pushErr(err error) {
+	s.mu.Lock()
+	s.lastErr = err
+	s.mu.Unlock()
+	select {
+	case s.errs <- err:
+	default:
+	}
+}
+
+// Events return the channel of the events, closed when the stream has ended
+func (s *Subscription[T]) Events() <-chan T {
+	return s.events
+}
+
+// Errors return the channel of the errors reported by the stream, e.g. a message
+// which could not be decoded. Errors are dropped when nobody reads them.
+func (s *Subscription[T]) Errors() <-chan error {
+	return s.errs
+}
+
+// Done return a channel closed when the stream has ended
+func (s *Subscription[T]) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// Err return why the stream ended, nil while it is running: the context error
+// when it was cancelled or closed, the error which ended the connection, or
+// ErrSubscriptionClosed
+func (s *Subscription[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Dropped return the number of events dropped by the overflow policy
+func (s *Subscription[T]) Dropped() int64 {
+	return atomic.LoadInt64(&s.dropped)
+}
+
+// Close stop the stream and wait for it to end, it is safe to call Close more than once
+func (s *Subscription[T]) Close() {
+	s.closeOnce.Do(s.cancel)
+	<-s.doneC
+}
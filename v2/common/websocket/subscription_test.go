@@ -0,0 +1,135 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type subscriptionTestSuite struct {
+	suite.Suite
+	handler    func(int)
+	errHandler func(error)
+	doneC      chan struct{}
+	stopC      chan struct{}
+}
+
+func TestSubscription(t *testing.T) {
+	suite.Run(t, new(subscriptionTestSuite))
+}
+
+// serve mimics a Ws*Serve function: doneC is closed when stopC is closed or the connection ends
+func (s *subscriptionTestSuite) serve(handler func(int), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+	s.handler = handler
+	s.errHandler = errHandler
+	s.doneC = make(chan struct{})
+	s.stopC = make(chan struct{})
+	go func(doneC, stopC chan struct{}) {
+		<-stopC
+		close(doneC)
+	}(s.doneC, s.stopC)
+	return s.doneC, s.stopC, nil
+}
+
+func (s *subscriptionTestSuite) collect(sub *Subscription[int]) []int {
+	var events []int
+	for event := range sub.Events() {
+		events = append(events, event)
+	}
+	return events
+}
+
+func (s *subscriptionTestSuite) TestCancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := NewSubscription(ctx, s.serve)
+	s.Require().NoError(err)
+	s.handler(1)
+	s.handler(2)
+	s.Nil(sub.Err())
+
+	cancel()
+	<-sub.Done()
+	s.Equal([]int{1, 2}, s.collect(sub))
+	s.ErrorIs(sub.Err(), context.Canceled)
+	s.NotPanics(func() {
+		sub.Close()
+		sub.Close()
+	})
+}
+
+func (s *subscriptionTestSuite) TestConnectionError() {
+	sub, err := NewSubscription(context.Background(), s.serve)
+	s.Require().NoError(err)
+
+	decodeErr := errors.New("invalid character")
+	s.handler(1)
+	s.errHandler(decodeErr)
+	s.Equal(decodeErr, <-sub.Errors())
+
+	readErr := errors.New("read: connection reset by peer")
+	s.errHandler(readErr)
+	close(s.doneC)
+	<-sub.Done()
+	s.Equal([]int{1}, s.collect(sub))
+	s.Equal(readErr, sub.Err())
+}
+
+func (s *subscriptionTestSuite) TestClosedWithoutError() {
+	sub, err := NewSubscription(context.Background(), s.serve)
+	s.Require().NoError(err)
+	close(s.doneC)
+	<-sub.Done()
+	s.ErrorIs(sub.Err(), ErrSubscriptionClosed)
+}
+
+func (s *subscriptionTestSuite) TestDropNewest() {
+	sub, err := NewSubscription(context.Background(), s.serve, WithBufferSize(2), WithOverflowPolicy(OverflowDropNewest))
+	s.Require().NoError(err)
+	for i := 1; i <= 5; i++ {
+		s.handler(i)
+	}
+	s.Equal(int64(3), sub.Dropped())
+	sub.Close()
+	s.Equal([]int{1, 2}, s.collect(sub))
+}
+
+func (s *subscriptionTestSuite) TestDropOldest() {
+	sub, err := NewSubscription(context.Background(), s.serve, WithBufferSize(2), WithOverflowPolicy(OverflowDropOldest))
+	s.Require().NoError(err)
+	for i := 1; i <= 5; i++ {
+		s.handler(i)
+	}
+	s.Equal(int64(3), sub.Dropped())
+	sub.Close()
+	s.Equal([]int{4, 5}, s.collect(sub))
+}
+
+func (s *subscriptionTestSuite) TestBlockUnblockedByClose() {
+	var handler func(int)
+	doneC := make(chan struct{})
+	stopC := make(chan struct{})
+	sub, err := NewSubscription(context.Background(), func(h func(int), errHandler func(error)) (chan struct{}, chan struct{}, error) {
+		handler = h
+		return doneC, stopC, nil
+	}, WithBufferSize(1))
+	s.Require().NoError(err)
+	handler(1)
+	// the connection ends once the blocked handler returns, as the read loop of wsServe does
+	go func() {
+		handler(2)
+		close(doneC)
+	}()
+	sub.Close()
+	s.Equal([]int{1}, s.collect(sub))
+}
+
+func (s *subscriptionTestSuite) TestServeError() {
+	serveErr := errors.New("dial: connection refused")
+	sub, err := NewSubscription(context.Background(), func(handler func(int), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return nil, nil, serveErr
+	})
+	s.Nil(sub)
+	s.Equal(serveErr, err)
+}
@@ -64,6 +64,48 @@ const (
 	// SorOrderTestSpotWsApiMethod define method for SOR order testing via websocket API
 	SorOrderTestSpotWsApiMethod WsApiMethodType = "sor.order.test"
 
+	// OrderCancelSpotWsApiMethod define method for canceling order via websocket API
+	OrderCancelSpotWsApiMethod WsApiMethodType = "order.cancel"
+
+	// OrderStatusSpotWsApiMethod define method for query order via websocket API
+	OrderStatusSpotWsApiMethod WsApiMethodType = "order.status"
+
+	// OrderCancelReplaceSpotWsApiMethod define method for canceling an order and placing a new one via websocket API
+	OrderCancelReplaceSpotWsApiMethod WsApiMethodType = "order.cancelReplace"
+
+	// OrderAmendKeepPrioritySpotWsApiMethod define method for reducing the quantity of an order via websocket API
+	OrderAmendKeepPrioritySpotWsApiMethod WsApiMethodType = "order.amend.keepPriority"
+
+	// OpenOrdersStatusSpotWsApiMethod define method for query open orders via websocket API
+	OpenOrdersStatusSpotWsApiMethod WsApiMethodType = "openOrders.status"
+
+	// OpenOrdersCancelAllSpotWsApiMethod define method for canceling all open orders of a symbol via websocket API
+	OpenOrdersCancelAllSpotWsApiMethod WsApiMethodType = "openOrders.cancelAll"
+
+	// AllOrdersSpotWsApiMethod define method for query order history via websocket API
+	AllOrdersSpotWsApiMethod WsApiMethodType = "allOrders"
+
+	// MyTradesSpotWsApiMethod define method for query trade history via websocket API
+	MyTradesSpotWsApiMethod WsApiMethodType = "myTrades"
+
+	// AccountStatusSpotWsApiMethod define method for query account information via websocket API
+	AccountStatusSpotWsApiMethod WsApiMethodType = "account.status"
+
+	// AccountCommissionSpotWsApiMethod define method for query commission rates via websocket API
+	AccountCommissionSpotWsApiMethod WsApiMethodType = "account.commission"
+
+	// AccountRateLimitsOrdersSpotWsApiMethod define method for query unfilled order count via websocket API
+	AccountRateLimitsOrdersSpotWsApiMethod WsApiMethodType = "account.rateLimits.orders"
+
+	// DepthSpotWsApiMethod define method for query order book via websocket API
+	DepthSpotWsApiMethod WsApiMethodType = "depth"
+
+	// TickerBookSpotWsApiMethod define method for query best price and quantity on the order book via websocket API
+	TickerBookSpotWsApiMethod WsApiMethodType = "ticker.book"
+
+	// KlinesSpotWsApiMethod define method for query klines via websocket API
+	KlinesSpotWsApiMethod WsApiMethodType = "klines"
+
 	// FUTURES
 
 	// OrderPlaceFuturesWsApiMethod define method for creation order via websocket API
@@ -74,6 +116,50 @@ const (
 
 	// OrderStatusFuturesWsApiMethod define method for query order via websocket API
 	OrderStatusFuturesWsApiMethod WsApiMethodType = "order.status"
+
+	// OrderModifyFuturesWsApiMethod define method for modifying order via websocket API
+	OrderModifyFuturesWsApiMethod WsApiMethodType = "order.modify"
+
+	// DepthFuturesWsApiMethod define method for query order book via websocket API
+	DepthFuturesWsApiMethod WsApiMethodType = "depth"
+
+	// TickerBookFuturesWsApiMethod define method for query best price and quantity on the order book via websocket API
+	TickerBookFuturesWsApiMethod WsApiMethodType = "ticker.book"
+
+	// TickerPriceFuturesWsApiMethod define method for query latest price via websocket API
+	TickerPriceFuturesWsApiMethod WsApiMethodType = "ticker.price"
+
+	// UserDataStreamStartFuturesWsApiMethod define method for starting user data stream via websocket API
+	UserDataStreamStartFuturesWsApiMethod WsApiMethodType = "userDataStream.start"
+
+	// UserDataStreamPingFuturesWsApiMethod define method for keeping user data stream alive via websocket API
+	UserDataStreamPingFuturesWsApiMethod WsApiMethodType = "userDataStream.ping"
+
+	// UserDataStreamStopFuturesWsApiMethod define method for closing user data stream via websocket API
+	UserDataStreamStopFuturesWsApiMethod WsApiMethodType = "userDataStream.stop"
+
+	// DELIVERY
+
+	// OrderPlaceDeliveryWsApiMethod define method for creation order via coin-M websocket API
+	OrderPlaceDeliveryWsApiMethod WsApiMethodType = "order.place"
+
+	// OrderModifyDeliveryWsApiMethod define method for modifying order via coin-M websocket API
+	OrderModifyDeliveryWsApiMethod WsApiMethodType = "order.modify"
+
+	// OrderCancelDeliveryWsApiMethod define method for cancel order via coin-M websocket API
+	OrderCancelDeliveryWsApiMethod WsApiMethodType = "order.cancel"
+
+	// OrderStatusDeliveryWsApiMethod define method for query order via coin-M websocket API
+	OrderStatusDeliveryWsApiMethod WsApiMethodType = "order.status"
+
+	// AccountPositionDeliveryWsApiMethod define method for query positions via coin-M websocket API
+	AccountPositionDeliveryWsApiMethod WsApiMethodType = "account.position"
+
+	// AccountBalanceDeliveryWsApiMethod define method for query balances via coin-M websocket API
+	AccountBalanceDeliveryWsApiMethod WsApiMethodType = "account.balance"
+
+	// AccountStatusDeliveryWsApiMethod define method for query account information via coin-M websocket API
+	AccountStatusDeliveryWsApiMethod WsApiMethodType = "account.status"
 )
 
 var (
@@ -152,6 +238,26 @@ func CreateRequest(reqData RequestData, method WsApiMethodType, params map[strin
 	return rawData, nil
 }
 
+// CreateUnsignedRequest creates ws request for methods which do not require authentication, e.g. market data
+func CreateUnsignedRequest(requestID string, method WsApiMethodType, params map[string]any) ([]byte, error) {
+	if requestID == "" {
+		return nil, ErrorRequestIDNotSet
+	}
+
+	req := WsApiRequest{
+		Id:     requestID,
+		Method: method,
+		Params: params,
+	}
+
+	rawData, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawData, nil
+}
+
 // encode encodes the parameters to a URL encoded string
 func encodeParams(p map[string]any) string {
 	queryValues := url.Values{}
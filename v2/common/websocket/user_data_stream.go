@@ -0,0 +1,313 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	// DefaultListenKeyKeepaliveInterval is the interval of the keepalive requests, a listen key
+	// expires 60 minutes after it was created or kept alive
+	DefaultListenKeyKeepaliveInterval = 30 * time.Minute
+
+	listenKeyCloseTimeout = 10 * time.Second
+)
+
+// ListenKeyService define the REST requests managing the listen key of a user data stream
+type ListenKeyService struct {
+	Start     func(ctx context.Context) (listenKey string, err error)
+	Keepalive func(ctx context.Context, listenKey string) error
+	Close     func(ctx context.Context, listenKey string) error
+}
+
+// ListenKeyServeFunc open one user data stream connection for listenKey, doneC is closed
+// when it ends and errHandler receives the error which ended it
+type ListenKeyServeFunc func(listenKey string, errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+
+// ListenKeyRenewedEvent is passed to the resync handler of a UserDataStream when its listen
+// key was replaced, the events sent in between are lost
+type ListenKeyRenewedEvent struct {
+	// Err is the reason of the renewal: ErrListenKeyExpired or the error of the keepalive request
+	Err error
+}
+
+// Error return the description of the event
+func (e *ListenKeyRenewedEvent) Error() string {
+	return fmt.Sprintf("ws stream: listen key renewed: %v, events may have been missed", e.Err)
+}
+
+// Unwrap return the reason of the renewal
+func (e *ListenKeyRenewedEvent) Unwrap() error {
+	return e.Err
+}
+
+// ErrListenKeyExpired is the reason of the renewal when the stream sent a 'listenKeyExpired' event
+var ErrListenKeyExpired = errors.New("ws stream: listen key expired")
+
+// UserDataStreamOption define option of UserDataStream
+type UserDataStreamOption func(s *UserDataStream)
+
+// WithKeepaliveInterval set the interval of the keepalive requests, DefaultListenKeyKeepaliveInterval by default
+func WithKeepaliveInterval(interval time.Duration) UserDataStreamOption {
+	return func(s *UserDataStream) {
+		s.keepaliveInterval = interval
+	}
+}
+
+// WithResyncHandler set the handler called once the stream is delivering events again after
+// some of them may have been missed: reason is a ReconnectedEvent or a ListenKeyRenewedEvent.
+// The consumers keeping a state from the events should reload it from the REST API then.
+func WithResyncHandler(handler func(reason error)) UserDataStreamOption {
+	return func(s *UserDataStream) {
+		s.resyncHandler = handler
+	}
+}
+
+// UserDataStream serve a user data stream and manage its listen key: the key is created on Start,
+// kept alive, replaced when it expired and closed on Stop. The connection is reconnected with
+// backoff when it ends, the error handler then receives a DisconnectedEvent and a ReconnectedEvent.
+type UserDataStream struct {
+	listenKeys        ListenKeyService
+	serve             ListenKeyServeFunc
+	errHandler        func(err error)
+	resyncHandler     func(reason error)
+	keepaliveInterval time.Duration
+
+	mu        sync.Mutex
+	listenKey string
+	lastErr   error
+	started   bool
+	expiredC  chan struct{}
+	stopC     chan struct{}
+	doneC     chan struct{}
+	stopOnce  sync.Once
+}
+
+// NewUserDataStream init UserDataStream, the per product clients provide listenKeys and serve
+func NewUserDataStream(listenKeys ListenKeyService, serve ListenKeyServeFunc, errHandler func(err error), opts ...UserDataStreamOption) *UserDataStream {
+	s := &UserDataStream{
+		listenKeys:        listenKeys,
+		serve:             serve,
+		errHandler:        errHandler,
+		keepaliveInterval: DefaultListenKeyKeepaliveInterval,
+		expiredC:          make(chan struct{}, 1),
+		stopC:             make(chan struct{}),
+		doneC:             make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.errHandler == nil {
+		s.errHandler = func(err error) {}
+	}
+	return s
+}
+
+// Start create the listen key and connect the stream, ctx is used by the REST requests and
+// the stream is stopped when it is done
+func (s *UserDataStream) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.started {
+		s.mu.Unlock()
+		return errors.New("ws stream: user data stream already started")
+	}
+	s.started = true
+	s.mu.Unlock()
+
+	listenKey, err := s.listenKeys.Start(ctx)
+	if err != nil {
+		close(s.doneC)
+		return err
+	}
+	connDoneC, connStopC, err := s.serve(listenKey, s.connErrHandler)
+	if err != nil {
+		_ = s.closeListenKey(listenKey)
+		close(s.doneC)
+		return err
+	}
+	s.setListenKey(listenKey)
+	go s.run(ctx, connDoneC, connStopC)
+	return nil
+}
+
+// Stop disconnect the stream and close its listen key, it returns once the stream is stopped
+func (s *UserDataStream) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopC)
+	})
+	s.mu.Lock()
+	started := s.started
+	s.mu.Unlock()
+	if started {
+		<-s.doneC
+	}
+}
+
+// Done return a channel closed once the stream is stopped
+func (s *UserDataStream) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// ListenKey return the current listen key
+func (s *UserDataStream) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listenKey
+}
+
+// Expired tell the stream that it received a 'listenKeyExpired' event, the listen key is replaced
+func (s *UserDataStream) Expired() {
+	select {
+	case s.expiredC <- struct{}{}:
+	default:
+	}
+}
+
+func (s *UserDataStream) setListenKey(listenKey string) {
+	s.mu.Lock()
+	s.listenKey = listenKey
+	s.mu.Unlock()
+}
+
+// connErrHandler forward the errors of the connection and keep the one ending it
+func (s *UserDataStream) connErrHandler(err error) {
+	s.mu.Lock()
+	s.lastErr = err
+	s.mu.Unlock()
+	s.errHandler(err)
+}
+
+func (s *UserDataStream) takeErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.lastErr
+	s.lastErr = nil
+	return err
+}
+
+func (s *UserDataStream) resync(reason error) {
+	if s.resyncHandler != nil {
+		s.resyncHandler(reason)
+	}
+}
+
+func (s *UserDataStream) closeListenKey(listenKey string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), listenKeyCloseTimeout)
+	defer cancel()
+	return s.listenKeys.Close(ctx, listenKey)
+}
+
+func (s *UserDataStream) run(ctx context.Context, connDoneC, connStopC chan struct{}) {
+	defer close(s.doneC)
+	ticker := time.NewTicker(s.keepaliveInterval)
+	defer ticker.Stop()
+	b := &backoff.Backoff{
+		Min:    reconnectMinInterval,
+		Max:    reconnectMaxInterval,
+		Factor: 2,
+		Jitter: true,
+	}
+	stop := func() {
+		if connStopC != nil {
+			close(connStopC)
+			<-connDoneC
+		}
+		if err := s.closeListenKey(s.ListenKey()); err != nil {
+			s.errHandler(err)
+		}
+	}
+
+	for {
+		var reason error
+		select {
+		case <-s.stopC:
+			stop()
+			return
+		case <-ctx.Done():
+			stop()
+			return
+		case <-ticker.C:
+			err := s.listenKeys.Keepalive(ctx, s.ListenKey())
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, common.ErrInvalidListenKey) {
+				s.errHandler(err)
+				continue
+			}
+			reason = err
+		case <-s.expiredC:
+			reason = ErrListenKeyExpired
+		case <-connDoneC:
+			connDoneC, connStopC = nil, nil
+			disconnectedAt := time.Now()
+			s.errHandler(&DisconnectedEvent{Err: s.takeErr()})
+			b.Reset()
+			for connDoneC == nil {
+				select {
+				case <-s.stopC:
+					stop()
+					return
+				case <-ctx.Done():
+					stop()
+					return
+				case <-time.After(b.Duration()):
+				}
+				nextDoneC, nextStopC, err := s.serve(s.ListenKey(), s.connErrHandler)
+				if err != nil {
+					s.errHandler(err)
+					continue
+				}
+				connDoneC, connStopC = nextDoneC, nextStopC
+			}
+			event := &ReconnectedEvent{
+				Attempts: int(b.Attempt()),
+				Downtime: time.Since(disconnectedAt),
+			}
+			s.errHandler(event)
+			s.resync(event)
+			continue
+		}
+
+		// replace the listen key, the connection of the old one does not deliver events anymore
+		close(connStopC)
+		<-connDoneC
+		s.takeErr()
+		connDoneC, connStopC = nil, nil
+		b.Reset()
+		for connDoneC == nil {
+			listenKey, err := s.listenKeys.Start(ctx)
+			if err == nil {
+				s.setListenKey(listenKey)
+				connDoneC, connStopC, err = s.serve(listenKey, s.connErrHandler)
+			}
+			if err == nil {
+				break
+			}
+			s.errHandler(err)
+			select {
+			case <-s.stopC:
+				stop()
+				return
+			case <-ctx.Done():
+				stop()
+				return
+			case <-time.After(b.Duration()):
+			}
+		}
+		// drop the expirations reported by the old connection
+		select {
+		case <-s.expiredC:
+		default:
+		}
+		ticker.Reset(s.keepaliveInterval)
+		s.resync(&ListenKeyRenewedEvent{Err: reason})
+	}
+}
@@ -0,0 +1,185 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type userDataStreamTestSuite struct {
+	suite.Suite
+
+	mu           sync.Mutex
+	conns        []*fakeStreamConn
+	connKeys     []string
+	created      int
+	closed       []string
+	keepaliveErr error
+	events       chan error
+	resyncs      chan error
+}
+
+func TestUserDataStream(t *testing.T) {
+	suite.Run(t, new(userDataStreamTestSuite))
+}
+
+func (s *userDataStreamTestSuite) SetupTest() {
+	s.conns = nil
+	s.connKeys = nil
+	s.created = 0
+	s.closed = nil
+	s.keepaliveErr = nil
+	s.events = make(chan error, 10)
+	s.resyncs = make(chan error, 10)
+}
+
+func (s *userDataStreamTestSuite) listenKeys() ListenKeyService {
+	return ListenKeyService{
+		Start: func(ctx context.Context) (string, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.created++
+			return fmt.Sprintf("listenKey%d", s.created), nil
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			err := s.keepaliveErr
+			s.keepaliveErr = nil
+			return err
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.closed = append(s.closed, listenKey)
+			return nil
+		},
+	}
+}
+
+func (s *userDataStreamTestSuite) serve(listenKey string, errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := &fakeStreamConn{doneC: make(chan struct{}), stopC: make(chan struct{}), errHandler: errHandler}
+	go func() {
+		select {
+		case <-c.stopC:
+			close(c.doneC)
+		case <-c.doneC:
+		}
+	}()
+	s.conns = append(s.conns, c)
+	s.connKeys = append(s.connKeys, listenKey)
+	return c.doneC, c.stopC, nil
+}
+
+func (s *userDataStreamTestSuite) conn(i int) *fakeStreamConn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns[i]
+}
+
+func (s *userDataStreamTestSuite) newStream(opts ...UserDataStreamOption) *UserDataStream {
+	opts = append(opts, WithResyncHandler(func(reason error) { s.resyncs <- reason }))
+	stream := NewUserDataStream(s.listenKeys(), s.serve, func(err error) { s.events <- err }, opts...)
+	s.Require().NoError(stream.Start(context.Background()))
+	return stream
+}
+
+func (s *userDataStreamTestSuite) next(c chan error) error {
+	select {
+	case err := <-c:
+		return err
+	case <-time.After(5 * time.Second):
+		s.FailNow("timeout waiting for stream event")
+		return nil
+	}
+}
+
+func (s *userDataStreamTestSuite) TestStartStop() {
+	stream := s.newStream()
+	s.Equal("listenKey1", stream.ListenKey())
+
+	stream.Stop()
+	<-s.conn(0).doneC
+	s.Equal([]string{"listenKey1"}, s.closed)
+}
+
+func (s *userDataStreamTestSuite) TestReconnect() {
+	stream := s.newStream()
+	defer stream.Stop()
+
+	readErr := errors.New("read: connection reset by peer")
+	s.conn(0).drop(readErr)
+
+	s.Equal(readErr, s.next(s.events))
+	var disconnected *DisconnectedEvent
+	s.Require().ErrorAs(s.next(s.events), &disconnected)
+	s.ErrorIs(disconnected, readErr)
+	var reconnected *ReconnectedEvent
+	s.Require().ErrorAs(s.next(s.events), &reconnected)
+	s.Require().ErrorAs(s.next(s.resyncs), &reconnected)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Equal([]string{"listenKey1", "listenKey1"}, s.connKeys)
+}
+
+func (s *userDataStreamTestSuite) TestExpired() {
+	stream := s.newStream()
+	defer stream.Stop()
+
+	stream.Expired()
+	var renewed *ListenKeyRenewedEvent
+	s.Require().ErrorAs(s.next(s.resyncs), &renewed)
+	s.ErrorIs(renewed, ErrListenKeyExpired)
+	s.Equal("listenKey2", stream.ListenKey())
+	<-s.conn(0).doneC
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Equal([]string{"listenKey1", "listenKey2"}, s.connKeys)
+	s.Empty(s.events)
+}
+
+func (s *userDataStreamTestSuite) TestKeepaliveInvalidListenKey() {
+	s.keepaliveErr = &common.APIError{Code: common.ErrCodeInvalidListenKey, Message: "This listenKey does not exist."}
+	stream := s.newStream(WithKeepaliveInterval(20 * time.Millisecond))
+	defer stream.Stop()
+
+	var renewed *ListenKeyRenewedEvent
+	s.Require().ErrorAs(s.next(s.resyncs), &renewed)
+	s.ErrorIs(renewed, common.ErrInvalidListenKey)
+	s.Equal("listenKey2", stream.ListenKey())
+}
+
+func (s *userDataStreamTestSuite) TestKeepaliveError() {
+	keepaliveErr := errors.New("dial tcp: i/o timeout")
+	s.keepaliveErr = keepaliveErr
+	stream := s.newStream(WithKeepaliveInterval(20 * time.Millisecond))
+	defer stream.Stop()
+
+	s.Equal(keepaliveErr, s.next(s.events))
+	s.Equal("listenKey1", stream.ListenKey())
+}
+
+func (s *userDataStreamTestSuite) TestStopContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := NewUserDataStream(s.listenKeys(), s.serve, nil)
+	s.Require().NoError(stream.Start(ctx))
+	cancel()
+
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not stopped")
+	}
+	s.Equal([]string{"listenKey1"}, s.closed)
+}
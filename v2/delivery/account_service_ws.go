@@ -0,0 +1,251 @@
+package delivery
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/google/uuid"
+)
+
+// WsAccountService query account information, balances and positions
+type WsAccountService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+	RecvWindow int64
+
+	timeSync *common.TimeSync
+}
+
+// NewWsAccountService init WsAccountService, recvWindow defaults to 5000
+func (c *Client) NewWsAccountService(recvWindow ...int64) (*WsAccountService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	window := int64(5000)
+	if len(recvWindow) > 0 {
+		window = recvWindow[0]
+	}
+
+	return &WsAccountService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		RecvWindow: window,
+		TimeOffset: c.TimeOffset,
+		timeSync:   c.TimeSync,
+	}, nil
+}
+
+// WsAccountInfoResponse define 'account.status' websocket API response
+type WsAccountInfoResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    Account            `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// WsAccountBalanceResponse define 'account.balance' websocket API response
+type WsAccountBalanceResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    []*Balance         `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// WsAccountPositionResponse define 'account.position' websocket API response
+type WsAccountPositionResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    []*PositionRisk    `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// AccountRateLimit define the rate limit usage returned with websocket API responses
+type AccountRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int    `json:"intervalNum"`
+	Limit         int    `json:"limit"`
+	Count         int    `json:"count"`
+}
+
+// GetAccountStatus sends 'account.status' request
+func (s *WsAccountService) GetAccountStatus(requestID string) error {
+	rawData, err := s.buildRequest(requestID, websocket.AccountStatusDeliveryWsApiMethod)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetAccountStatus sends 'account.status' request and receives response
+func (s *WsAccountService) SyncGetAccountStatus(requestID string) (*WsAccountInfoResponse, error) {
+	rawData, err := s.buildRequest(requestID, websocket.AccountStatusDeliveryWsApiMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &WsAccountInfoResponse{}
+	if err := json.Unmarshal(response, info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// GetAccountBalance sends 'account.balance' request
+func (s *WsAccountService) GetAccountBalance(requestID string) error {
+	rawData, err := s.buildRequest(requestID, websocket.AccountBalanceDeliveryWsApiMethod)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetAccountBalance sends 'account.balance' request and receives response
+func (s *WsAccountService) SyncGetAccountBalance(requestID string) (*WsAccountBalanceResponse, error) {
+	rawData, err := s.buildRequest(requestID, websocket.AccountBalanceDeliveryWsApiMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := &WsAccountBalanceResponse{}
+	if err := json.Unmarshal(response, balance); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+// GetPositions sends 'account.position' request, the positions of all the pairs are
+// requested when pair is empty
+func (s *WsAccountService) GetPositions(requestID string, pair string) error {
+	rawData, err := s.buildRequest(requestID, websocket.AccountPositionDeliveryWsApiMethod, pair)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetPositions sends 'account.position' request and receives response
+func (s *WsAccountService) SyncGetPositions(requestID string, pair string) (*WsAccountPositionResponse, error) {
+	rawData, err := s.buildRequest(requestID, websocket.AccountPositionDeliveryWsApiMethod, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := &WsAccountPositionResponse{}
+	if err := json.Unmarshal(response, positions); err != nil {
+		return nil, err
+	}
+
+	return positions, nil
+}
+
+func (s *WsAccountService) buildRequest(requestID string, method websocket.WsApiMethodType, pair ...string) ([]byte, error) {
+	params := map[string]any{
+		"recvWindow": s.RecvWindow,
+	}
+	if len(pair) > 0 && pair[0] != "" {
+		params["pair"] = pair[0]
+	}
+	return websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.OffsetOr(s.TimeOffset),
+			s.KeyType,
+		),
+		method,
+		params,
+	)
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *WsAccountService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *WsAccountService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *WsAccountService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *WsAccountService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// GetAccountInfoWs Get account info by websocket like RESTful
+func (c *Client) GetAccountInfoWs(recvWindow ...int64) (*WsAccountInfoResponse, error) {
+	service, err := c.NewWsAccountService(recvWindow...)
+	if err != nil {
+		return nil, err
+	}
+	if c.WsApiClient == nil {
+		defer service.c.Close()
+	}
+
+	return service.SyncGetAccountStatus(uuid.New().String())
+}
+
+// GetAccountBalanceWs Get account balances by websocket like RESTful
+func (c *Client) GetAccountBalanceWs(recvWindow ...int64) (*WsAccountBalanceResponse, error) {
+	service, err := c.NewWsAccountService(recvWindow...)
+	if err != nil {
+		return nil, err
+	}
+	if c.WsApiClient == nil {
+		defer service.c.Close()
+	}
+
+	return service.SyncGetAccountBalance(uuid.New().String())
+}
@@ -0,0 +1,218 @@
+package delivery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountWsServiceTestSuite struct {
+	suite.Suite
+	mockClient *mock.MockClient
+	mockCtrl   *gomock.Controller
+
+	requestID string
+	service   *WsAccountService
+}
+
+func TestAccountWsService(t *testing.T) {
+	suite.Run(t, new(accountWsServiceTestSuite))
+}
+
+func (s *accountWsServiceTestSuite) SetupTest() {
+	s.requestID = "605a6d20-6588-4cb9-afa0-b0ab087507ba"
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockClient = mock.NewMockClient(s.mockCtrl)
+	s.service = &WsAccountService{
+		c:          s.mockClient,
+		ApiKey:     "dummyAPIKey",
+		SecretKey:  "dummySecretKey",
+		KeyType:    common.KeyTypeHmac,
+		RecvWindow: 5000,
+	}
+}
+
+func (s *accountWsServiceTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *accountWsServiceTestSuite) TestGetAccountStatus() {
+	data := []byte(`{
+  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+  "status": 200,
+  "result": {
+    "feeTier": 0,
+    "canTrade": true,
+    "canDeposit": true,
+    "canWithdraw": true,
+    "updateTime": 0,
+    "assets": [
+      {
+        "asset": "BTC",
+        "walletBalance": "0.00241969",
+        "unrealizedProfit": "0.00000000",
+        "marginBalance": "0.00241969",
+        "maintMargin": "0.00000000",
+        "initialMargin": "0.00000000",
+        "positionInitialMargin": "0.00000000",
+        "openOrderInitialMargin": "0.00000000",
+        "maxWithdrawAmount": "0.00241969",
+        "crossWalletBalance": "0.00241969",
+        "crossUnPnl": "0.00000000",
+        "availableBalance": "0.00241969"
+      }
+    ],
+    "positions": [
+      {
+        "symbol": "BTCUSD_201225",
+        "positionAmt": "0",
+        "initialMargin": "0",
+        "maintMargin": "0",
+        "unrealizedProfit": "0.00000000",
+        "positionInitialMargin": "0",
+        "openOrderInitialMargin": "0",
+        "leverage": "125",
+        "isolated": false,
+        "positionSide": "BOTH",
+        "entryPrice": "0.0",
+        "maxQty": "50"
+      }
+    ]
+  },
+  "rateLimits": [
+    {
+      "rateLimitType": "REQUEST_WEIGHT",
+      "interval": "MINUTE",
+      "intervalNum": 1,
+      "limit": 2400,
+      "count": 10
+    }
+  ]
+}`)
+	s.mockClient.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(id string, req []byte, _ time.Duration) ([]byte, error) {
+			s.Contains(string(req), `"method":"account.status"`)
+			s.Contains(string(req), `"recvWindow":5000`)
+			return data, nil
+		}).Times(1)
+
+	response, err := s.service.SyncGetAccountStatus(s.requestID)
+	s.Require().NoError(err)
+	s.Equal(200, response.Status)
+	s.Nil(response.Error)
+	s.True(response.Result.CanTrade)
+	s.Require().Len(response.Result.Assets, 1)
+	s.Equal("BTC", response.Result.Assets[0].Asset)
+	s.Equal("0.00241969", response.Result.Assets[0].WalletBalance)
+	s.Require().Len(response.Result.Positions, 1)
+	s.Equal("BTCUSD_201225", response.Result.Positions[0].Symbol)
+	s.Equal("125", response.Result.Positions[0].Leverage)
+	s.Require().Len(response.RateLimit, 1)
+	s.Equal(10, response.RateLimit[0].Count)
+}
+
+func (s *accountWsServiceTestSuite) TestGetAccountBalance() {
+	data := []byte(`{
+  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+  "status": 200,
+  "result": [
+    {
+      "accountAlias": "SgsR",
+      "asset": "BTC",
+      "balance": "0.00250000",
+      "withdrawAvailable": "0.00250000",
+      "crossWalletBalance": "0.00241969",
+      "crossUnPnl": "0.00000000",
+      "availableBalance": "0.00241969",
+      "updateTime": 1592468353979
+    }
+  ]
+}`)
+	s.mockClient.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(id string, req []byte, _ time.Duration) ([]byte, error) {
+			s.Contains(string(req), `"method":"account.balance"`)
+			return data, nil
+		}).Times(1)
+
+	response, err := s.service.SyncGetAccountBalance(s.requestID)
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal(&Balance{
+		AccountAlias:       "SgsR",
+		Asset:              "BTC",
+		Balance:            "0.00250000",
+		WithdrawAvailable:  "0.00250000",
+		CrossWalletBalance: "0.00241969",
+		CrossUnPnl:         "0.00000000",
+		AvailableBalance:   "0.00241969",
+		UpdateTime:         1592468353979,
+	}, response.Result[0])
+}
+
+func (s *accountWsServiceTestSuite) TestGetPositions() {
+	data := []byte(`{
+  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+  "status": 200,
+  "result": [
+    {
+      "symbol": "BTCUSD_201225",
+      "positionAmt": "1",
+      "entryPrice": "11707.70000003",
+      "markPrice": "11788.66626667",
+      "unRealizedProfit": "0.00005866",
+      "liquidationPrice": "11667.63509587",
+      "leverage": "125",
+      "maxQty": "50",
+      "marginType": "cross",
+      "isolatedMargin": "0.00000000",
+      "isAutoAddMargin": "false",
+      "positionSide": "BOTH"
+    }
+  ]
+}`)
+	s.mockClient.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(id string, req []byte, _ time.Duration) ([]byte, error) {
+			s.Contains(string(req), `"method":"account.position"`)
+			s.Contains(string(req), `"pair":"BTCUSD"`)
+			return data, nil
+		}).Times(1)
+
+	response, err := s.service.SyncGetPositions(s.requestID, "BTCUSD")
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("BTCUSD_201225", response.Result[0].Symbol)
+	s.Equal("1", response.Result[0].PositionAmt)
+	s.Equal("cross", response.Result[0].MarginType)
+}
+
+func (s *accountWsServiceTestSuite) TestGetPositions_AllPairs() {
+	s.mockClient.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, req []byte) error {
+		s.Contains(string(req), `"method":"account.position"`)
+		s.NotContains(string(req), `"pair"`)
+		return nil
+	}).Times(1)
+
+	s.NoError(s.service.GetPositions(s.requestID, ""))
+}
+
+func (s *accountWsServiceTestSuite) TestGetAccountStatus_Error() {
+	data := []byte(`{
+  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+  "status": 401,
+  "error": {
+    "code": -2015,
+    "msg": "Invalid API-key, IP, or permissions for action."
+  }
+}`)
+	s.mockClient.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(data, nil).Times(1)
+
+	response, err := s.service.SyncGetAccountStatus(s.requestID)
+	s.Require().NoError(err)
+	s.Equal(401, response.Status)
+	s.Require().NotNil(response.Error)
+	s.Equal(int64(-2015), response.Error.Code)
+}
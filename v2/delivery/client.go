@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
 
 	"github.com/bitly/go-simplejson"
 )
@@ -63,6 +64,12 @@ type UserDataEventType string
 // UserDataEventReasonType define reason type for user data event
 type UserDataEventReasonType string
 
+// PriceMatchType define priceMatch type
+type PriceMatchType string
+
+// ForceOrderCloseType define reason type for force order
+type ForceOrderCloseType string
+
 // Endpoints
 var (
 	BaseApiMainUrl    = "https://dapi.binance.com"
@@ -138,6 +145,19 @@ const (
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
 
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+
+	ForceOrderCloseTypeLiquidation ForceOrderCloseType = "LIQUIDATION"
+	ForceOrderCloseTypeADL         ForceOrderCloseType = "ADL"
+
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
 	UserDataEventTypeAccountUpdate       UserDataEventType = "ACCOUNT_UPDATE"
@@ -229,11 +249,21 @@ type Client struct {
 	// UseDemo switch all the API endpoints from production to the demo
 	UseDemo  bool
 	ProxyUrl string
+	// Endpoints overrides the endpoints selected by UseTestnet and UseDemo when set, see SetEndpoints
+	Endpoints *Endpoints
 
 	do doFunc
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+	// RateLimiter enables client-side rate limiting when set, see EnableRateLimiter
+	RateLimiter *common.RateLimiter
+	// TimeSync overrides TimeOffset with a synced offset when set, see EnableTimeSync
+	TimeSync *common.TimeSync
+	// RetryPolicy retries failed requests when set, it can be overridden with WithRetryPolicy
+	RetryPolicy *common.RetryPolicy
+	// WsApiClient is the connection shared by the websocket API services when set, see EnableWsApiConnection
+	WsApiClient websocket.Client
 }
 
 func (c *Client) SetUseTestnet() {
@@ -246,6 +276,9 @@ func (c *Client) SetUseDemo() {
 
 // getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.API != "" {
+		return c.Endpoints.API
+	}
 	if c.UseTestnet {
 		return BaseApiTestnetUrl
 	}
@@ -257,6 +290,9 @@ func (c *Client) getApiEndpoint() string {
 
 // getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getWsEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.Stream != "" {
+		return c.Endpoints.Stream
+	}
 	if c.UseTestnet {
 		return BaseWsTestnetUrl
 	}
@@ -266,6 +302,31 @@ func (c *Client) getWsEndpoint() string {
 	return BaseWsMainUrl
 }
 
+// getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
+func (c *Client) getCombinedEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.CombinedStream != "" {
+		return c.Endpoints.CombinedStream
+	}
+	if c.UseTestnet {
+		return BaseCombinedTestnetURL
+	}
+	if c.UseDemo {
+		return BaseCombinedDemoURL
+	}
+	return BaseCombinedMainURL
+}
+
+// getWsApiEndpoint return the base endpoint of the websocket API according the UseTestnet flag
+func (c *Client) getWsApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.WsAPI != "" {
+		return c.Endpoints.WsAPI
+	}
+	if c.UseTestnet || c.UseDemo {
+		return BaseWsApiTestnetURL
+	}
+	return BaseWsApiMainURL
+}
+
 func (c *Client) getProxyUrl() *string {
 	if c.ProxyUrl == "" {
 		return nil
@@ -294,7 +355,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -344,6 +405,55 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	// options are applied once so that retries send the same request
+	for _, opt := range opts {
+		opt(r)
+	}
+	policy := c.retryPolicy(r)
+	if policy != nil {
+		setClientOrderID(r)
+	}
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		data, err = c.doAPI(ctx, r)
+		if err == nil {
+			return data, nil
+		}
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			attempt--
+			continue
+		}
+		if ctx.Err() != nil || !policy.ShouldRetry(attempt, err) {
+			return data, err
+		}
+		switch {
+		case !common.IsUnknownStatusError(err):
+		case isOrderRequest(r):
+			// the order may have been placed, look it up before sending it again
+			orderData, found, qerr := c.queryPlacedOrder(ctx, r)
+			if qerr != nil {
+				return data, err
+			}
+			if found {
+				return orderData, nil
+			}
+		case r.method != http.MethodGet:
+			// e.g. a withdrawal or a transfer may have been executed, only the requests
+			// which never reached the server are resent
+			return data, err
+		}
+		if policy.Wait(ctx, attempt) != nil {
+			return data, err
+		}
+	}
+}
+
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	// wait before signing the request, so its timestamp is not stale once sent
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, err
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -365,6 +475,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	c.updateRateLimit(r, res)
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
 		return []byte{}, err
@@ -390,6 +501,8 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
 		return nil, apiErr
 	}
 	return data, nil
@@ -410,6 +523,11 @@ func (c *Client) NewSetServerTimeService() *SetServerTimeService {
 	return &SetServerTimeService{c: c}
 }
 
+// NewDepthService init depth service
+func (c *Client) NewDepthService() *DepthService {
+	return &DepthService{c: c}
+}
+
 // NewKlinesService init klines service
 func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
@@ -534,3 +652,163 @@ func (c *Client) NewGetFundingInfoService() *GetFundingInfoService {
 func (c *Client) NewFundingRateService() *FundingRateService {
 	return &FundingRateService{c: c}
 }
+
+// NewRecentTradesService init recent trades service
+func (c *Client) NewRecentTradesService() *RecentTradesService {
+	return &RecentTradesService{c: c}
+}
+
+// NewHistoricalTradesService init listing trades service
+func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
+	return &HistoricalTradesService{c: c}
+}
+
+// NewAggTradesService init aggregate trades service
+func (c *Client) NewAggTradesService() *AggTradesService {
+	return &AggTradesService{c: c}
+}
+
+// NewContinuousKlinesService init continuous klines service
+func (c *Client) NewContinuousKlinesService() *ContinuousKlinesService {
+	return &ContinuousKlinesService{c: c}
+}
+
+// NewIndexPriceKlinesService init index price klines service
+func (c *Client) NewIndexPriceKlinesService() *IndexPriceKlinesService {
+	return &IndexPriceKlinesService{c: c}
+}
+
+// NewMarkPriceKlinesService init mark price klines service
+func (c *Client) NewMarkPriceKlinesService() *MarkPriceKlinesService {
+	return &MarkPriceKlinesService{c: c}
+}
+
+// NewPremiumIndexKlinesService init premium index klines service
+func (c *Client) NewPremiumIndexKlinesService() *PremiumIndexKlinesService {
+	return &PremiumIndexKlinesService{c: c}
+}
+
+// NewPremiumIndexService init premium index service
+func (c *Client) NewPremiumIndexService() *PremiumIndexService {
+	return &PremiumIndexService{c: c}
+}
+
+// NewGetOpenInterestService init open interest service
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsService {
+	return &OpenInterestStatisticsService{c: c}
+}
+
+// NewLongShortRatioService init long short ratio service
+func (c *Client) NewLongShortRatioService() *LongShortRatioService {
+	return &LongShortRatioService{c: c}
+}
+
+// NewTopLongShortAccountRatioService init top long short account ratio service
+func (c *Client) NewTopLongShortAccountRatioService() *TopLongShortAccountRatioService {
+	return &TopLongShortAccountRatioService{c: c}
+}
+
+// NewTopLongShortPositionRatioService init top long short position ratio service
+func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRatioService {
+	return &TopLongShortPositionRatioService{c: c}
+}
+
+// NewTakerBuySellVolumeService init taker buy sell volume service
+func (c *Client) NewTakerBuySellVolumeService() *TakerBuySellVolumeService {
+	return &TakerBuySellVolumeService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
+
+// NewCreateBatchOrdersService init creating batch order service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modify batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
+// NewCancelMultiplesOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultiplesOrdersService() *CancelMultiplesOrdersService {
+	return &CancelMultiplesOrdersService{c: c}
+}
+
+// NewGetOrderModifyHistoryService init order modify history service
+func (c *Client) NewGetOrderModifyHistoryService() *GetOrderModifyHistoryService {
+	return &GetOrderModifyHistoryService{c: c}
+}
+
+// NewListUserLiquidationOrdersService init list user's liquidation orders service
+func (c *Client) NewListUserLiquidationOrdersService() *ListUserLiquidationOrdersService {
+	return &ListUserLiquidationOrdersService{c: c}
+}
+
+// NewListAccountTradeService init account trade list service
+func (c *Client) NewListAccountTradeService() *ListAccountTradeService {
+	return &ListAccountTradeService{c: c}
+}
+
+// NewGetIncomeHistoryService init getting income history service
+func (c *Client) NewGetIncomeHistoryService() *GetIncomeHistoryService {
+	return &GetIncomeHistoryService{c: c}
+}
+
+// NewGetLeverageBracketService init leverage bracket service
+func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
+	return &GetLeverageBracketService{c: c}
+}
+
+// NewCommissionRateService init commission rate service
+func (c *Client) NewCommissionRateService() *CommissionRateService {
+	return &CommissionRateService{c: c}
+}
+
+// NewGetADLQuantileService init ADL quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
+// NewGetIncomeDownloadIDService init income history download id service
+func (c *Client) NewGetIncomeDownloadIDService() *GetIncomeDownloadIDService {
+	return &GetIncomeDownloadIDService{c: c}
+}
+
+// NewGetIncomeDownloadLinkService init income history download link service
+func (c *Client) NewGetIncomeDownloadLinkService() *GetIncomeDownloadLinkService {
+	return &GetIncomeDownloadLinkService{c: c}
+}
+
+// NewGetOrderDownloadIDService init order history download id service
+func (c *Client) NewGetOrderDownloadIDService() *GetOrderDownloadIDService {
+	return &GetOrderDownloadIDService{c: c}
+}
+
+// NewGetOrderDownloadLinkService init order history download link service
+func (c *Client) NewGetOrderDownloadLinkService() *GetOrderDownloadLinkService {
+	return &GetOrderDownloadLinkService{c: c}
+}
+
+// NewGetTradeDownloadIDService init trade history download id service
+func (c *Client) NewGetTradeDownloadIDService() *GetTradeDownloadIDService {
+	return &GetTradeDownloadIDService{c: c}
+}
+
+// NewGetTradeDownloadLinkService init trade history download link service
+func (c *Client) NewGetTradeDownloadLinkService() *GetTradeDownloadLinkService {
+	return &GetTradeDownloadLinkService{c: c}
+}
@@ -0,0 +1,40 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type commissionRateServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCommissionRateService(t *testing.T) {
+	suite.Run(t, new(commissionRateServiceTestSuite))
+}
+
+func (s *commissionRateServiceTestSuite) TestCommissionRate() {
+	data := []byte(`{
+		"symbol": "BTCUSD_PERP",
+		"makerCommissionRate": "0.00015",
+		"takerCommissionRate": "0.00040"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", symbol)
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCommissionRateService().Symbol(symbol).Do(newContext())
+	s.r().NoError(err)
+	e := &CommissionRate{
+		Symbol:              symbol,
+		MakerCommissionRate: "0.00015",
+		TakerCommissionRate: "0.00040",
+	}
+	s.r().Equal(e, res)
+}
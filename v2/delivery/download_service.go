@@ -0,0 +1,217 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetIncomeDownloadIDService get the download id of the transaction history
+type GetIncomeDownloadIDService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *GetIncomeDownloadIDService) StartTime(startTime int64) *GetIncomeDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime, the time between startTime and endTime can not be longer than 1 year
+func (s *GetIncomeDownloadIDService) EndTime(endTime int64) *GetIncomeDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetIncomeDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/income/asyn",
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"startTime": s.startTime,
+		"endTime":   s.endTime,
+	})
+	return getDownloadID(ctx, s.c, r, opts...)
+}
+
+// GetIncomeDownloadLinkService get the download link of the transaction history by download id
+type GetIncomeDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadId
+func (s *GetIncomeDownloadLinkService) DownloadID(downloadID string) *GetIncomeDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetIncomeDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/income/asyn/id",
+		secType:  secTypeSigned,
+	}
+	r.setParam("downloadId", s.downloadID)
+	return getDownloadLink(ctx, s.c, r, opts...)
+}
+
+// GetOrderDownloadIDService get the download id of the order history
+type GetOrderDownloadIDService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *GetOrderDownloadIDService) StartTime(startTime int64) *GetOrderDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime, the time between startTime and endTime can not be longer than 1 year
+func (s *GetOrderDownloadIDService) EndTime(endTime int64) *GetOrderDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetOrderDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/order/asyn",
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"startTime": s.startTime,
+		"endTime":   s.endTime,
+	})
+	return getDownloadID(ctx, s.c, r, opts...)
+}
+
+// GetOrderDownloadLinkService get the download link of the order history by download id
+type GetOrderDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadId
+func (s *GetOrderDownloadLinkService) DownloadID(downloadID string) *GetOrderDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetOrderDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/order/asyn/id",
+		secType:  secTypeSigned,
+	}
+	r.setParam("downloadId", s.downloadID)
+	return getDownloadLink(ctx, s.c, r, opts...)
+}
+
+// GetTradeDownloadIDService get the download id of the trade history
+type GetTradeDownloadIDService struct {
+	c         *Client
+	startTime int64
+	endTime   int64
+}
+
+// StartTime set startTime
+func (s *GetTradeDownloadIDService) StartTime(startTime int64) *GetTradeDownloadIDService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set endTime, the time between startTime and endTime can not be longer than 1 year
+func (s *GetTradeDownloadIDService) EndTime(endTime int64) *GetTradeDownloadIDService {
+	s.endTime = endTime
+	return s
+}
+
+// Do send request
+func (s *GetTradeDownloadIDService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadID, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/trade/asyn",
+		secType:  secTypeSigned,
+	}
+	r.setParams(params{
+		"startTime": s.startTime,
+		"endTime":   s.endTime,
+	})
+	return getDownloadID(ctx, s.c, r, opts...)
+}
+
+// GetTradeDownloadLinkService get the download link of the trade history by download id
+type GetTradeDownloadLinkService struct {
+	c          *Client
+	downloadID string
+}
+
+// DownloadID set downloadId
+func (s *GetTradeDownloadLinkService) DownloadID(downloadID string) *GetTradeDownloadLinkService {
+	s.downloadID = downloadID
+	return s
+}
+
+// Do send request
+func (s *GetTradeDownloadLinkService) Do(ctx context.Context, opts ...RequestOption) (res *DownloadLink, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/trade/asyn/id",
+		secType:  secTypeSigned,
+	}
+	r.setParam("downloadId", s.downloadID)
+	return getDownloadLink(ctx, s.c, r, opts...)
+}
+
+// DownloadID define the download id of an history export
+type DownloadID struct {
+	AvgCostTimestampOfLast30d int64  `json:"avgCostTimestampOfLast30d"`
+	DownloadID                string `json:"downloadId"`
+}
+
+// DownloadLink define the download link of an history export
+type DownloadLink struct {
+	DownloadID          string `json:"downloadId"`
+	Status              string `json:"status"` // completed or processing
+	URL                 string `json:"url"`    // empty while processing
+	Notified            bool   `json:"notified"`
+	ExpirationTimestamp int64  `json:"expirationTimestamp"` // the link expires after this timestamp
+	IsExpired           *bool  `json:"isExpired"`
+}
+
+func getDownloadID(ctx context.Context, c *Client, r *request, opts ...RequestOption) (res *DownloadID, err error) {
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadID)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func getDownloadLink(ctx context.Context, c *Client, r *request, opts ...RequestOption) (res *DownloadLink, err error) {
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(DownloadLink)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type downloadServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDownloadService(t *testing.T) {
+	suite.Run(t, new(downloadServiceTestSuite))
+}
+
+func (s *downloadServiceTestSuite) TestGetDownloadID() {
+	data := []byte(`{
+		"avgCostTimestampOfLast30d": 7241837,
+		"downloadId": "546975389218332672"
+	}`)
+	startTime := int64(1576566020000)
+	endTime := int64(1676566020000)
+	e := &DownloadID{
+		AvgCostTimestampOfLast30d: 7241837,
+		DownloadID:                "546975389218332672",
+	}
+	for _, do := range []func() (*DownloadID, error){
+		func() (*DownloadID, error) {
+			return s.client.NewGetIncomeDownloadIDService().StartTime(startTime).EndTime(endTime).Do(newContext())
+		},
+		func() (*DownloadID, error) {
+			return s.client.NewGetOrderDownloadIDService().StartTime(startTime).EndTime(endTime).Do(newContext())
+		},
+		func() (*DownloadID, error) {
+			return s.client.NewGetTradeDownloadIDService().StartTime(startTime).EndTime(endTime).Do(newContext())
+		},
+	} {
+		s.SetupTest()
+		s.mockDo(data, nil)
+		s.assertReq(func(r *request) {
+			e := newSignedRequest().setParams(params{
+				"startTime": startTime,
+				"endTime":   endTime,
+			})
+			s.assertRequestEqual(e, r)
+		})
+		res, err := do()
+		s.r().NoError(err)
+		s.r().Equal(e, res)
+		s.assertDo()
+	}
+}
+
+func (s *downloadServiceTestSuite) TestGetDownloadLink() {
+	data := []byte(`{
+		"downloadId": "545923594199212032",
+		"status": "completed",
+		"url": "www.binance.com",
+		"notified": true,
+		"expirationTimestamp": 1645009771000,
+		"isExpired": null
+	}`)
+	downloadID := "545923594199212032"
+	e := &DownloadLink{
+		DownloadID:          downloadID,
+		Status:              "completed",
+		URL:                 "www.binance.com",
+		Notified:            true,
+		ExpirationTimestamp: 1645009771000,
+	}
+	for _, do := range []func() (*DownloadLink, error){
+		func() (*DownloadLink, error) {
+			return s.client.NewGetIncomeDownloadLinkService().DownloadID(downloadID).Do(newContext())
+		},
+		func() (*DownloadLink, error) {
+			return s.client.NewGetOrderDownloadLinkService().DownloadID(downloadID).Do(newContext())
+		},
+		func() (*DownloadLink, error) {
+			return s.client.NewGetTradeDownloadLinkService().DownloadID(downloadID).Do(newContext())
+		},
+	} {
+		s.SetupTest()
+		s.mockDo(data, nil)
+		s.assertReq(func(r *request) {
+			e := newSignedRequest().setParam("downloadId", downloadID)
+			s.assertRequestEqual(e, r)
+		})
+		res, err := do()
+		s.r().NoError(err)
+		s.r().Equal(e, res)
+		s.assertDo()
+	}
+}
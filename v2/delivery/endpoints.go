@@ -0,0 +1,53 @@
+package delivery
+
+// Endpoints define the base URLs used by a client, set it with Client.SetEndpoints to run clients
+// against different environments in one process. An empty URL falls back to the endpoint selected
+// by the UseTestnet and UseDemo flags.
+type Endpoints struct {
+	// API is the base URL of the REST API, e.g. https://dapi.binance.com
+	API string
+	// Stream is the base URL of the raw streams, e.g. wss://dstream.binance.com/ws
+	Stream string
+	// CombinedStream is the base URL of the combined streams, ending with "?streams="
+	CombinedStream string
+	// WsAPI is the URL of the WebSocket API
+	WsAPI string
+}
+
+// Endpoint profiles
+var (
+	// MainnetEndpoints is the production environment
+	MainnetEndpoints = Endpoints{
+		API:            "https://dapi.binance.com",
+		Stream:         "wss://dstream.binance.com/ws",
+		CombinedStream: "wss://dstream.binance.com/stream?streams=",
+		WsAPI:          "wss://ws-dapi.binance.com/ws-dapi/v1",
+	}
+	// TestnetEndpoints is the futures test network
+	TestnetEndpoints = Endpoints{
+		API:            "https://testnet.binancefuture.com",
+		Stream:         "wss://dstream.binancefuture.com/ws",
+		CombinedStream: "wss://dstream.binancefuture.com/stream?streams=",
+		WsAPI:          "wss://testnet.binancefuture.com/ws-dapi/v1",
+	}
+	// DemoEndpoints is the demo trading environment
+	DemoEndpoints = Endpoints{
+		API:            "https://demo-dapi.binance.com",
+		Stream:         "wss://dstream.binancefuture.com/ws",
+		CombinedStream: "wss://dstream.binancefuture.com/stream?streams=",
+		WsAPI:          "wss://testnet.binancefuture.com/ws-dapi/v1",
+	}
+)
+
+// WithAPI return a copy of the endpoints with the REST API base URL replaced, e.g. for a regional
+// proxy
+func (e Endpoints) WithAPI(url string) Endpoints {
+	e.API = url
+	return e
+}
+
+// SetEndpoints make the client use the endpoints instead of the ones selected by the UseTestnet
+// and UseDemo flags
+func (c *Client) SetEndpoints(endpoints Endpoints) {
+	c.Endpoints = &endpoints
+}
@@ -0,0 +1,66 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type incomeHistoryServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIncomeHistoryService(t *testing.T) {
+	suite.Run(t, new(incomeHistoryServiceTestSuite))
+}
+
+func (s *incomeHistoryServiceTestSuite) TestGetIncomeHistory() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200925",
+			"incomeType": "COMMISSION",
+			"income": "-0.01000000",
+			"asset": "BTC",
+			"info": "",
+			"time": 1570636800000,
+			"tranId": 9689322392,
+			"tradeId": "2059192"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	incomeType := "COMMISSION"
+	startTime := int64(1570608000000)
+	endTime := int64(1570665600000)
+	page := 2
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":     symbol,
+			"incomeType": incomeType,
+			"startTime":  startTime,
+			"endTime":    endTime,
+			"page":       page,
+			"limit":      limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetIncomeHistoryService().Symbol(symbol).IncomeType(incomeType).
+		StartTime(startTime).EndTime(endTime).Page(page).Limit(limit).Do(newContext())
+	s.r().NoError(err)
+	e := []*IncomeHistory{
+		{
+			Symbol:     symbol,
+			IncomeType: incomeType,
+			Income:     "-0.01000000",
+			Asset:      "BTC",
+			Time:       1570636800000,
+			TranID:     9689322392,
+			TradeID:    "2059192",
+		},
+	}
+	s.r().Equal(e, res)
+}
@@ -0,0 +1,58 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type longShortRatioServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestLongShortRatioService(t *testing.T) {
+	suite.Run(t, new(longShortRatioServiceTestSuite))
+}
+
+func (s *longShortRatioServiceTestSuite) TestLongShortRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "0.1960",
+			"longAccount": "0.6622",
+			"shortAccount": "0.3378",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "5m"
+	limit := 1
+	startTime := int64(1583139000000)
+	endTime := int64(1583140000000)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":      pair,
+			"period":    period,
+			"limit":     limit,
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewLongShortRatioService().Pair(pair).Period(period).
+		Limit(limit).StartTime(startTime).EndTime(endTime).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	e := &LongShortRatio{
+		Pair:           pair,
+		LongShortRatio: "0.1960",
+		LongAccount:    "0.6622",
+		ShortAccount:   "0.3378",
+		Timestamp:      1583139600000,
+	}
+	s.r().Equal(e, res[0])
+}
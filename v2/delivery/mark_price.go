@@ -0,0 +1,115 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// PremiumIndexService get premium index
+type PremiumIndexService struct {
+	c      *Client
+	symbol *string
+	pair   *string
+}
+
+// Symbol set symbol
+func (s *PremiumIndexService) Symbol(symbol string) *PremiumIndexService {
+	s.symbol = &symbol
+	return s
+}
+
+// Pair set pair
+func (s *PremiumIndexService) Pair(pair string) *PremiumIndexService {
+	s.pair = &pair
+	return s
+}
+
+// Do send request
+func (s *PremiumIndexService) Do(ctx context.Context, opts ...RequestOption) (res []*PremiumIndex, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/premiumIndex",
+		secType:  secTypeNone,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.pair != nil {
+		r.setParam("pair", *s.pair)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*PremiumIndex{}, err
+	}
+	res = make([]*PremiumIndex, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PremiumIndex{}, err
+	}
+	return res, nil
+}
+
+// PremiumIndex define premium index of mark price
+type PremiumIndex struct {
+	Symbol               string `json:"symbol"`
+	Pair                 string `json:"pair"`
+	MarkPrice            string `json:"markPrice"`
+	IndexPrice           string `json:"indexPrice"`
+	EstimatedSettlePrice string `json:"estimatedSettlePrice"`
+	LastFundingRate      string `json:"lastFundingRate"`
+	InterestRate         string `json:"interestRate"`
+	NextFundingTime      int64  `json:"nextFundingTime"`
+	Time                 int64  `json:"time"`
+}
+
+// GetLeverageBracketService get the notional and leverage brackets of symbols
+type GetLeverageBracketService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetLeverageBracketService) Symbol(symbol string) *GetLeverageBracketService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetLeverageBracketService) Do(ctx context.Context, opts ...RequestOption) (res []*LeverageBracket, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v2/leverageBracket",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LeverageBracket{}, err
+	}
+	res = make([]*LeverageBracket, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LeverageBracket{}, err
+	}
+	return res, nil
+}
+
+// LeverageBracket define the leverage bracket
+type LeverageBracket struct {
+	Symbol       string    `json:"symbol"`
+	NotionalCoef float64   `json:"notionalCoef"`
+	Brackets     []Bracket `json:"brackets"`
+}
+
+// Bracket define the bracket, quantities are in base asset
+type Bracket struct {
+	Bracket          int     `json:"bracket"`
+	InitialLeverage  int     `json:"initialLeverage"`
+	QtyCap           float64 `json:"qtyCap"`
+	QtyFloor         float64 `json:"qtyFloor"`
+	MaintMarginRatio float64 `json:"maintMarginRatio"`
+	Cum              float64 `json:"cum"`
+}
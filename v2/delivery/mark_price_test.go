@@ -0,0 +1,110 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type premiumIndexServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPremiumIndexService(t *testing.T) {
+	suite.Run(t, new(premiumIndexServiceTestSuite))
+}
+
+func (s *premiumIndexServiceTestSuite) TestGetPremiumIndex() {
+	data := []byte(`[{
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"markPrice": "11029.69574559",
+		"indexPrice": "10979.14437500",
+		"estimatedSettlePrice": "10981.74168236",
+		"lastFundingRate": "0.00071003",
+		"interestRate": "0.00010000",
+		"nextFundingTime": 1596096000000,
+		"time": 1596094042000
+	}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair": pair,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewPremiumIndexService().Pair(pair).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	e := &PremiumIndex{
+		Symbol:               "BTCUSD_PERP",
+		Pair:                 pair,
+		MarkPrice:            "11029.69574559",
+		IndexPrice:           "10979.14437500",
+		EstimatedSettlePrice: "10981.74168236",
+		LastFundingRate:      "0.00071003",
+		InterestRate:         "0.00010000",
+		NextFundingTime:      1596096000000,
+		Time:                 1596094042000,
+	}
+	s.r().Equal(e, res[0])
+}
+
+type getLeverageBracketServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestGetLeverageBracketService(t *testing.T) {
+	suite.Run(t, new(getLeverageBracketServiceTestSuite))
+}
+
+func (s *getLeverageBracketServiceTestSuite) TestGetLeverageBracket() {
+	data := []byte(`[{
+		"symbol": "BTCUSD_PERP",
+		"notionalCoef": 1.50,
+		"brackets": [
+			{
+				"bracket": 1,
+				"initialLeverage": 125,
+				"qtyCap": 50,
+				"qtyFloor": 0,
+				"maintMarginRatio": 0.004,
+				"cum": 0.0
+			}
+		]
+	}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol": symbol,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetLeverageBracketService().Symbol(symbol).Do(newContext())
+	s.r().NoError(err)
+	e := []*LeverageBracket{
+		{
+			Symbol:       symbol,
+			NotionalCoef: 1.5,
+			Brackets: []Bracket{
+				{
+					Bracket:          1,
+					InitialLeverage:  125,
+					QtyCap:           50,
+					QtyFloor:         0,
+					MaintMarginRatio: 0.004,
+					Cum:              0,
+				},
+			},
+		},
+	}
+	s.r().Equal(e, res)
+}
@@ -0,0 +1,235 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+var (
+	// OrderBookSnapshotRetryInterval is the delay before fetching a depth snapshot again after a failure
+	OrderBookSnapshotRetryInterval = time.Second
+	// OrderBookMaxBufferedEvents is the maximum number of depth events buffered while waiting for a snapshot
+	OrderBookMaxBufferedEvents = 10000
+
+	// ErrOrderBookGap is reported when a depth event does not follow the previous one and the book is resynchronised
+	ErrOrderBookGap = common.ErrOrderBookGap
+	// ErrOrderBookSnapshotStale is reported when a depth snapshot is older than the buffered depth events
+	ErrOrderBookSnapshotStale = common.ErrSnapshotStale
+)
+
+// OrderBookUpdate define a change applied to the local order book
+type OrderBookUpdate struct {
+	Symbol       string
+	LastUpdateID int64
+	// Snapshot is true when the book has been (re)loaded from a depth snapshot,
+	// Bids and Asks then hold the full book instead of a diff
+	Snapshot bool
+	Bids     []Bid
+	Asks     []Ask
+}
+
+// OrderBookHandler handle order book changes
+type OrderBookHandler func(update *OrderBookUpdate)
+
+// OrderBook maintains a local order book of a symbol from the diff depth stream and depth snapshots.
+// See https://developers.binance.com/docs/derivatives/coin-margined-futures/websocket-market-streams/How-to-manage-a-local-order-book-correctly
+type OrderBook struct {
+	c          *Client
+	symbol     string
+	limit      int
+	rate       *time.Duration
+	handler    OrderBookHandler
+	errHandler ErrHandler
+
+	bookSync *common.OrderBookSync
+	book     *common.OrderBook
+
+	stopOnce sync.Once
+	cancel   context.CancelFunc
+	doneC    chan struct{}
+	stopC    chan struct{}
+}
+
+// NewOrderBook init a local order book of symbol, call Start to begin syncing
+func (c *Client) NewOrderBook(symbol string) *OrderBook {
+	b := &OrderBook{
+		c:      c,
+		symbol: symbol,
+		limit:  1000,
+	}
+	b.bookSync = common.NewOrderBookSync(b.snapshot, common.FuturesDepthContinuity)
+	b.bookSync.OnUpdate = b.onUpdate
+	b.bookSync.OnError = b.onError
+	b.book = b.bookSync.Book()
+	return b
+}
+
+// Limit set the depth of the REST snapshot, default 1000
+func (b *OrderBook) Limit(limit int) *OrderBook {
+	b.limit = limit
+	return b
+}
+
+// Rate set the update speed of the depth stream, 100ms, 250ms (default) or 500ms
+func (b *OrderBook) Rate(rate time.Duration) *OrderBook {
+	b.rate = &rate
+	return b
+}
+
+// OnUpdate set the handler called after every applied diff or snapshot
+func (b *OrderBook) OnUpdate(handler OrderBookHandler) *OrderBook {
+	b.handler = handler
+	return b
+}
+
+// OnError set the handler for stream, snapshot and sequence errors
+func (b *OrderBook) OnError(errHandler ErrHandler) *OrderBook {
+	b.errHandler = errHandler
+	return b
+}
+
+// Start open the depth stream and load the first snapshot
+func (b *OrderBook) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	doneC, stopC, err := b.c.WsDiffDepthServeWithRate(b.symbol, b.rate, b.onEvent, b.onStreamError)
+	if err != nil {
+		cancel()
+		return err
+	}
+	b.cancel = cancel
+	b.doneC = doneC
+	b.stopC = stopC
+	b.bookSync.RetryInterval = OrderBookSnapshotRetryInterval
+	b.bookSync.MaxBufferedEvents = OrderBookMaxBufferedEvents
+	b.bookSync.Resync()
+	go b.bookSync.Run(ctx)
+	go func() {
+		<-doneC
+		cancel()
+	}()
+	return nil
+}
+
+// Stop close the depth stream, it is safe to call Stop more than once
+func (b *OrderBook) Stop() {
+	b.stopOnce.Do(func() {
+		if b.cancel != nil {
+			b.cancel()
+		}
+		if b.stopC != nil {
+			close(b.stopC)
+		}
+	})
+}
+
+// Done return a channel closed when the depth stream has terminated
+func (b *OrderBook) Done() <-chan struct{} {
+	return b.doneC
+}
+
+// Symbol return the symbol of the book
+func (b *OrderBook) Symbol() string {
+	return b.symbol
+}
+
+// IsSynced return true when the book has been loaded from a snapshot and is not resyncing
+func (b *OrderBook) IsSynced() bool {
+	return b.bookSync.IsSynced()
+}
+
+// LastUpdateID return the last applied update id
+func (b *OrderBook) LastUpdateID() int64 {
+	return b.book.LastUpdateID()
+}
+
+// BestBid return the highest bid, ok is false if the book is empty or resyncing
+func (b *OrderBook) BestBid() (Bid, bool) {
+	return b.book.BestBid()
+}
+
+// BestAsk return the lowest ask, ok is false if the book is empty or resyncing
+func (b *OrderBook) BestAsk() (Ask, bool) {
+	return b.book.BestAsk()
+}
+
+// Bids return the top n bids, all bids if n <= 0
+func (b *OrderBook) Bids(n int) []Bid {
+	return b.book.Bids(n)
+}
+
+// Asks return the top n asks, all asks if n <= 0
+func (b *OrderBook) Asks(n int) []Ask {
+	return b.book.Asks(n)
+}
+
+// BidQuantity return the bid quantity resting at price
+func (b *OrderBook) BidQuantity(price string) (string, error) {
+	return b.book.BidQuantity(price)
+}
+
+// AskQuantity return the ask quantity resting at price
+func (b *OrderBook) AskQuantity(price string) (string, error) {
+	return b.book.AskQuantity(price)
+}
+
+// BidDepth return the cumulative bid quantity at or above price
+func (b *OrderBook) BidDepth(price string) (string, error) {
+	return b.book.BidDepth(price)
+}
+
+// AskDepth return the cumulative ask quantity at or below price
+func (b *OrderBook) AskDepth(price string) (string, error) {
+	return b.book.AskDepth(price)
+}
+
+// onStreamError resync the book when the depth stream reconnects, the events sent
+// while it was disconnected are lost
+func (b *OrderBook) onStreamError(err error) {
+	var reconnected *websocket.ReconnectedEvent
+	if errors.As(err, &reconnected) {
+		b.bookSync.Resync()
+	}
+	b.onError(err)
+}
+
+func (b *OrderBook) onError(err error) {
+	if b.errHandler != nil {
+		b.errHandler(err)
+	}
+}
+
+func (b *OrderBook) onUpdate(lastUpdateID int64, bids, asks []common.PriceLevel, snapshot bool) {
+	if b.handler != nil {
+		b.handler(&OrderBookUpdate{
+			Symbol:       b.symbol,
+			LastUpdateID: lastUpdateID,
+			Snapshot:     snapshot,
+			Bids:         bids,
+			Asks:         asks,
+		})
+	}
+}
+
+func (b *OrderBook) onEvent(event *WsDepthEvent) {
+	b.bookSync.Apply(&common.DepthDiff{
+		FirstUpdateID:    event.FirstUpdateID,
+		LastUpdateID:     event.LastUpdateID,
+		PrevLastUpdateID: event.PrevLastUpdateID,
+		Bids:             event.Bids,
+		Asks:             event.Asks,
+	})
+}
+
+// snapshot fetch a depth snapshot of the symbol
+func (b *OrderBook) snapshot(ctx context.Context) (*common.DepthSnapshot, error) {
+	res, err := b.c.NewDepthService().Symbol(b.symbol).Limit(b.limit).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &common.DepthSnapshot{LastUpdateID: res.LastUpdateID, Bids: res.Bids, Asks: res.Asks}, nil
+}
@@ -0,0 +1,90 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) mockSnapshot() {
+	data := []byte(`{
+		"lastUpdateId": 10,
+		"E": 1589436922972,
+		"T": 1589436922959,
+		"bids": [["100.0", "1.0"], ["99.0", "2.0"]],
+		"asks": [["101.0", "1.5"], ["102.0", "3.0"]]
+	}`)
+	s.mockDo(data, nil)
+}
+
+func (s *orderBookTestSuite) TestSyncAndApply() {
+	s.mockSnapshot()
+	defer s.assertDo()
+
+	var updates []*OrderBookUpdate
+	var errs []error
+	b := s.client.NewOrderBook("BTCUSD_PERP").
+		OnUpdate(func(update *OrderBookUpdate) {
+			updates = append(updates, update)
+		}).
+		OnError(func(err error) {
+			errs = append(errs, err)
+		})
+	b.bookSync.Resync()
+	s.r().False(b.IsSynced())
+
+	b.onEvent(&WsDepthEvent{FirstUpdateID: 5, LastUpdateID: 8, PrevLastUpdateID: 4, Bids: []Bid{{Price: "100.0", Quantity: "9"}}})
+	b.onEvent(&WsDepthEvent{FirstUpdateID: 9, LastUpdateID: 12, PrevLastUpdateID: 8, Bids: []Bid{{Price: "100.0", Quantity: "0"}}})
+
+	err := b.bookSync.LoadSnapshot(newContext())
+	s.r().NoError(err)
+	s.r().True(b.IsSynced())
+	s.r().Len(updates, 2)
+	s.r().True(updates[0].Snapshot)
+	s.r().Equal(int64(12), b.LastUpdateID())
+	bid, ok := b.BestBid()
+	s.r().True(ok)
+	s.r().Equal(Bid{Price: "99.0", Quantity: "2.0"}, bid)
+
+	b.onEvent(&WsDepthEvent{FirstUpdateID: 15, LastUpdateID: 18, PrevLastUpdateID: 12, Asks: []Ask{{Price: "100.5", Quantity: "1"}}})
+	s.r().Equal(int64(18), b.LastUpdateID())
+	ask, ok := b.BestAsk()
+	s.r().True(ok)
+	s.r().Equal(Ask{Price: "100.5", Quantity: "1"}, ask)
+	s.r().Empty(errs)
+
+	b.onEvent(&WsDepthEvent{FirstUpdateID: 20, LastUpdateID: 22, PrevLastUpdateID: 19})
+	s.r().False(b.IsSynced())
+	s.r().Equal([]error{ErrOrderBookGap}, errs)
+	_, ok = b.BestBid()
+	s.r().False(ok)
+}
+
+func (s *orderBookTestSuite) TestStaleSnapshot() {
+	s.mockSnapshot()
+	defer s.assertDo()
+
+	b := s.client.NewOrderBook("BTCUSD_PERP")
+	b.bookSync.Resync()
+	b.onEvent(&WsDepthEvent{FirstUpdateID: 20, LastUpdateID: 22, PrevLastUpdateID: 19})
+
+	err := b.bookSync.LoadSnapshot(newContext())
+	s.r().Equal(ErrOrderBookSnapshotStale, err)
+	s.r().False(b.IsSynced())
+}
+
+func (s *orderBookTestSuite) TestStop() {
+	b := s.client.NewOrderBook("BTCUSD_PERP")
+	s.r().NotPanics(func() {
+		b.Stop()
+		b.Stop()
+	})
+}
@@ -0,0 +1,113 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderModifyServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+
+	orderModify        *OrderModifyWsService
+	orderModifyRequest *OrderModifyWsRequest
+}
+
+func TestOrderModifyServiceWs(t *testing.T) {
+	suite.Run(t, new(orderModifyServiceWsTestSuite))
+}
+
+func (s *orderModifyServiceWsTestSuite) SetupTest() {
+	s.requestID = "c8c271ba-de70-479e-870c-e64951c753d9"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.orderModify = &OrderModifyWsService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.orderModifyRequest = NewOrderModifyWsRequest().
+		Symbol("BTCUSD_PERP").
+		OrderID(328971409).
+		Side(SideTypeSell).
+		Quantity("1").
+		Price("45000")
+}
+
+func (s *orderModifyServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"order.modify"`)
+		s.Contains(string(data), `"orderId":328971409`)
+		s.Contains(string(data), `"price":"45000"`)
+		s.NotContains(string(data), `"priceMatch"`)
+		return nil
+	}).Times(1)
+
+	err := s.orderModify.Do(s.requestID, s.orderModifyRequest)
+	s.NoError(err)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModify_EmptyApiKey() {
+	s.orderModify.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.orderModify.Do(s.requestID, s.orderModifyRequest)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderModifyServiceWsTestSuite) TestOrderModifySync() {
+	data := []byte(`{
+  "id": "c8c271ba-de70-479e-870c-e64951c753d9",
+  "status": 200,
+  "result": {
+    "orderId": 328971409,
+    "symbol": "BTCUSD_PERP",
+    "status": "NEW",
+    "clientOrderId": "xGHfltUMExx0TbQstQQfRX",
+    "price": "45000",
+    "avgPrice": "0.00",
+    "origQty": "1",
+    "executedQty": "0",
+    "cumQty": "0",
+    "cumBase": "0",
+    "timeInForce": "GTC",
+    "type": "LIMIT",
+    "reduceOnly": false,
+    "closePosition": false,
+    "side": "SELL",
+    "positionSide": "SHORT",
+    "stopPrice": "0.00",
+    "workingType": "CONTRACT_PRICE",
+    "priceProtect": false,
+    "origType": "LIMIT",
+    "priceMatch": "NONE",
+    "selfTradePreventionMode": "NONE",
+    "goodTillDate": 0,
+    "updateTime": 1703426756190
+  }
+}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(data, nil).Times(1)
+
+	response, err := s.orderModify.SyncDo(s.requestID, s.orderModifyRequest)
+	s.Require().NoError(err)
+	s.Equal(200, response.Status)
+	s.Equal(int64(328971409), response.Result.OrderID)
+	s.Equal("45000", response.Result.Price)
+	s.Equal(OrderStatusTypeNew, response.Result.Status)
+	s.Equal(int64(1703426756190), response.Result.UpdateTime)
+}
@@ -599,3 +599,348 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.Side, a.Side, "Side")
 	r.Equal(e.Time, a.Time, "Time")
 }
+
+func (s *orderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"priceMatch": "NONE",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	orderID := int64(20072994037)
+	side := SideTypeBuy
+	quantity := "1"
+	price := "30005"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   symbol,
+			"orderId":  orderID,
+			"side":     side,
+			"quantity": quantity,
+			"price":    price,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewModifyOrderService().Symbol(symbol).OrderID(orderID).
+		Side(side).Quantity(quantity).Price(price).Do(newContext())
+	s.r().NoError(err)
+	e := &ModifyOrderResponse{
+		OrderID:          orderID,
+		Symbol:           symbol,
+		Pair:             "BTCUSD",
+		Status:           OrderStatusTypeNew,
+		ClientOrderID:    "LJ9R4QZDihCaS8UAOOLpgW",
+		Price:            price,
+		AvgPrice:         "0.0",
+		OrigQuantity:     quantity,
+		ExecutedQuantity: "0",
+		CumQuantity:      "0",
+		CumBase:          "0",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		Side:             side,
+		PositionSide:     PositionSideTypeLong,
+		StopPrice:        "0",
+		WorkingType:      WorkingTypeContractPrice,
+		OrigType:         OrderTypeLimit,
+		PriceMatch:       PriceMatchTypeNone,
+		UpdateTime:       1629182711600,
+	}
+	s.r().Equal(e, res)
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "testOrder1",
+			"cumQty": "0",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 22542179,
+			"avgPrice": "0.0",
+			"origQty": "10",
+			"price": "9000",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"status": "NEW",
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"origType": "LIMIT",
+			"updateTime": 1566818724722
+		},
+		{
+			"code": -2022,
+			"msg": "ReduceOnly Order is rejected."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"newClientOrderId":"testOrder1","newOrderRespType":"","price":"9000","quantity":"10","side":"BUY","symbol":"BTCUSD_200925","timeInForce":"GTC","type":"LIMIT"},` +
+				`{"newClientOrderId":"testOrder2","newOrderRespType":"","quantity":"10","reduceOnly":"true","side":"SELL","symbol":"BTCUSD_200925","type":"MARKET"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	orders := []*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSD_200925").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("10").
+			Price("9000").NewClientOrderID("testOrder1"),
+		s.client.NewCreateOrderService().Symbol("BTCUSD_200925").Side(SideTypeSell).
+			Type(OrderTypeMarket).Quantity("10").ReduceOnly(true).NewClientOrderID("testOrder2"),
+	}
+	res, err := s.client.NewCreateBatchOrdersService().OrderList(orders).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(2, res.N)
+	s.r().Len(res.Orders, 1)
+	s.r().Equal(int64(22542179), res.Orders[0].OrderID)
+	s.r().Nil(res.Errors[0])
+	s.r().EqualError(res.Errors[1], "<APIError> code=-2022, msg=ReduceOnly Order is rejected.")
+}
+
+func (s *orderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 20072994037,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"status": "NEW",
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"price": "30005",
+			"origQty": "1",
+			"side": "BUY",
+			"type": "LIMIT",
+			"updateTime": 1629182711600
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"orderId":"20072994037","price":"30005","quantity":"1","side":"BUY","symbol":"BTCUSD_PERP"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	orders := []*ModifyOrder{
+		new(ModifyOrder).Symbol("BTCUSD_PERP").OrderID(20072994037).Side(SideTypeBuy).
+			Quantity("1").Price("30005"),
+	}
+	res, err := s.client.NewModifyBatchOrdersService().OrderList(orders).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(1, res.N)
+	s.r().Len(res.Orders, 1)
+	s.r().Equal("30005", res.Orders[0].Price)
+	s.r().Nil(res.Errors[0])
+}
+
+func (s *orderServiceTestSuite) TestCancelMultipleOrders() {
+	data := []byte(`[
+		{
+			"avgPrice": "0.0",
+			"clientOrderId": "myOrder1",
+			"cumQty": "0",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 283194212,
+			"origQty": "11",
+			"origType": "TRAILING_STOP_MARKET",
+			"price": "0",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "SHORT",
+			"status": "CANCELED",
+			"stopPrice": "9300",
+			"closePosition": false,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "TRAILING_STOP_MARKET",
+			"activatePrice": "9020",
+			"priceRate": "0.3",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"updateTime": 1571110484038
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                symbol,
+			"orderIdList":           "[283194212,283194213]",
+			"origClientOrderIdList": `["myOrder1","myOrder2"]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelMultiplesOrdersService().Symbol(symbol).
+		OrderIDList([]int64{283194212, 283194213}).
+		OrigClientOrderIDList([]string{"myOrder1", "myOrder2"}).
+		Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal(int64(283194212), res[0].OrderID)
+	s.r().Equal(OrderStatusTypeCanceled, res[0].Status)
+}
+
+func (s *orderServiceTestSuite) TestGetOrderModifyHistory() {
+	data := []byte(`[
+		{
+			"amendmentId": 5363,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"orderId": 20072994037,
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"time": 1629184560899,
+			"amendment": {
+				"price": {
+					"before": "30004",
+					"after": "30003.2"
+				},
+				"origQty": {
+					"before": "1",
+					"after": "1"
+				},
+				"count": 3
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	orderID := int64(20072994037)
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":  symbol,
+			"orderId": orderID,
+			"limit":   limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOrderModifyHistoryService().Symbol(symbol).OrderID(orderID).
+		Limit(limit).Do(newContext())
+	s.r().NoError(err)
+	e := []*OrderAmendment{
+		{
+			AmendmentID:   5363,
+			Symbol:        symbol,
+			Pair:          "BTCUSD",
+			OrderID:       orderID,
+			ClientOrderID: "LJ9R4QZDihCaS8UAOOLpgW",
+			Time:          1629184560899,
+			Amendment: Amendment{
+				Price:        AmendmentChange{Before: "30004", After: "30003.2"},
+				OrigQuantity: AmendmentChange{Before: "1", After: "1"},
+				Count:        3,
+			},
+		},
+	}
+	s.r().Equal(e, res)
+}
+
+func (s *orderServiceTestSuite) TestListUserLiquidationOrders() {
+	data := []byte(`[
+		{
+			"orderId": 165123080,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"status": "FILLED",
+			"clientOrderId": "autoclose-1596542005017000006",
+			"price": "11326.9",
+			"avgPrice": "11326.9",
+			"origQty": "1",
+			"executedQty": "1",
+			"cumBase": "0.00882854",
+			"timeInForce": "IOC",
+			"type": "LIMIT",
+			"reduceOnly": false,
+			"closePosition": false,
+			"side": "SELL",
+			"positionSide": "BOTH",
+			"stopPrice": "0",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"origType": "LIMIT",
+			"time": 1596542005019,
+			"updateTime": 1596542005050
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	autoCloseType := ForceOrderCloseTypeLiquidation
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":        symbol,
+			"autoCloseType": autoCloseType,
+			"limit":         limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListUserLiquidationOrdersService().Symbol(symbol).
+		AutoCloseType(autoCloseType).Limit(limit).Do(newContext())
+	s.r().NoError(err)
+	e := []*UserLiquidationOrder{
+		{
+			OrderID:          165123080,
+			Symbol:           symbol,
+			Pair:             "BTCUSD",
+			Status:           OrderStatusTypeFilled,
+			ClientOrderID:    "autoclose-1596542005017000006",
+			Price:            "11326.9",
+			AvgPrice:         "11326.9",
+			OrigQuantity:     "1",
+			ExecutedQuantity: "1",
+			CumBase:          "0.00882854",
+			TimeInForce:      TimeInForceTypeIOC,
+			Type:             OrderTypeLimit,
+			Side:             SideTypeSell,
+			PositionSide:     PositionSideTypeBoth,
+			StopPrice:        "0",
+			WorkingType:      WorkingTypeContractPrice,
+			OrigType:         OrderTypeLimit,
+			Time:             1596542005019,
+			UpdateTime:       1596542005050,
+		},
+	}
+	s.r().Equal(e, res)
+}
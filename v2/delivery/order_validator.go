@@ -0,0 +1,226 @@
+package delivery
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderParams define the parameters of an order checked by OrderValidator, an empty value is unset
+type OrderParams struct {
+	Symbol          string
+	Side            SideType
+	Type            OrderType
+	Quantity        string
+	Price           string
+	StopPrice       string
+	ActivationPrice string
+	ClosePosition   bool
+}
+
+// OrderValidator check orders against the filters of the symbols of the exchange info before they
+// are sent: PRICE_FILTER, LOT_SIZE, MARKET_LOT_SIZE and, once the mark price of the symbol is set,
+// PERCENT_PRICE. The quantities are numbers of contracts. The prices and quantities are rounded to
+// the tick and step sizes first, with PriceRounding and QuantityRounding.
+type OrderValidator struct {
+	// PriceRounding is the rounding of the prices, RoundingModeNearest by default
+	PriceRounding common.RoundingMode
+	// QuantityRounding is the rounding of the quantities, RoundingModeDown by default
+	QuantityRounding common.RoundingMode
+
+	symbols    map[string]*Symbol
+	mu         sync.RWMutex
+	markPrices map[string]decimal.Decimal
+}
+
+// NewOrderValidator init OrderValidator with the symbols of the exchange info
+func NewOrderValidator(info *ExchangeInfo) *OrderValidator {
+	v := &OrderValidator{
+		PriceRounding:    common.RoundingModeNearest,
+		QuantityRounding: common.RoundingModeDown,
+		symbols:          make(map[string]*Symbol, len(info.Symbols)),
+		markPrices:       make(map[string]decimal.Decimal),
+	}
+	for i := range info.Symbols {
+		v.symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+	}
+	return v
+}
+
+// SetMarkPrice set the mark price of symbol, used by the PERCENT_PRICE filter
+func (v *OrderValidator) SetMarkPrice(symbol, price string) error {
+	p, err := decimal.NewFromString(price)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.markPrices[symbol] = p
+	return nil
+}
+
+// HandleMarkPrice set the mark price of the event, it can be used as WsMarkPriceHandler
+func (v *OrderValidator) HandleMarkPrice(event *WsMarkPriceEvent) {
+	_ = v.SetMarkPrice(event.Symbol, event.MarkPrice)
+}
+
+func (v *OrderValidator) markPrice(symbol string) (decimal.Decimal, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	p, ok := v.markPrices[symbol]
+	return p, ok
+}
+
+// Validate round the prices and quantities of o and check them against the filters of its symbol.
+// o is updated only if it doesn't violate any filter, otherwise the violations are returned
+// as common.FilterViolations.
+func (v *OrderValidator) Validate(o *OrderParams) error {
+	symbol, ok := v.symbols[o.Symbol]
+	if !ok {
+		return fmt.Errorf("unknown symbol: %s", o.Symbol)
+	}
+	res := *o
+	var violations common.FilterViolations
+	var parseErr error
+	parse := func(field, value string) decimal.Decimal {
+		d, err := decimal.NewFromString(value)
+		if err != nil && parseErr == nil {
+			parseErr = fmt.Errorf("invalid %s %q: %w", field, value, err)
+		}
+		return d
+	}
+	apply := func(f common.StepFilter, value *string, mode common.RoundingMode) decimal.Decimal {
+		d := parse(f.Field, *value)
+		rounded, vs := f.Apply(d, mode)
+		violations = append(violations, vs...)
+		if !rounded.Equal(d) {
+			*value = rounded.String()
+		}
+		return rounded
+	}
+	isMarket := strings.HasSuffix(string(res.Type), string(OrderTypeMarket))
+
+	var price decimal.Decimal
+	if f := symbol.PriceFilter(); f != nil {
+		filter := common.StepFilter{Filter: string(SymbolFilterTypePrice), Min: f.MinPrice, Max: f.MaxPrice, Step: f.TickSize}
+		for _, p := range []struct {
+			field string
+			value *string
+		}{{"price", &res.Price}, {"stopPrice", &res.StopPrice}, {"activationPrice", &res.ActivationPrice}} {
+			if *p.value == "" {
+				continue
+			}
+			filter.Field = p.field
+			d := apply(filter, p.value, v.PriceRounding)
+			if p.field == "price" {
+				price = d
+			}
+		}
+	} else if res.Price != "" {
+		price = parse("price", res.Price)
+	}
+
+	if res.Quantity != "" && !res.ClosePosition {
+		parse("quantity", res.Quantity)
+		if f := symbol.LotSizeFilter(); f != nil && !isMarket {
+			filter := common.StepFilter{Filter: string(SymbolFilterTypeLotSize), Field: "quantity", Min: f.MinQuantity, Max: f.MaxQuantity, Step: f.StepSize}
+			apply(filter, &res.Quantity, v.QuantityRounding)
+		}
+		if f := symbol.MarketLotSizeFilter(); f != nil && isMarket {
+			filter := common.StepFilter{Filter: string(SymbolFilterTypeMarketLotSize), Field: "quantity", Min: f.MinQuantity, Max: f.MaxQuantity, Step: f.StepSize}
+			apply(filter, &res.Quantity, v.QuantityRounding)
+		}
+	}
+
+	markPrice, hasMarkPrice := v.markPrice(res.Symbol)
+	if f := symbol.PercentPriceFilter(); f != nil && hasMarkPrice && res.Price != "" {
+		// a buy price is bounded above by the mark price and a sell price below
+		if res.Side == SideTypeSell {
+			violations = append(violations, common.CheckRange(string(SymbolFilterTypePercentPrice), "price",
+				price, markPrice.Mul(decimalOrZero(f.MultiplierDown)), decimal.Zero)...)
+		} else {
+			violations = append(violations, common.CheckRange(string(SymbolFilterTypePercentPrice), "price",
+				price, decimal.Zero, markPrice.Mul(decimalOrZero(f.MultiplierUp)))...)
+		}
+	}
+
+	if parseErr != nil {
+		return parseErr
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+	*o = res
+	return nil
+}
+
+// ValidateCreateOrder validate the order of s with Validate and set its rounded prices and quantities
+func (v *OrderValidator) ValidateCreateOrder(s *CreateOrderService) error {
+	o := &OrderParams{
+		Symbol:          s.symbol,
+		Side:            s.side,
+		Type:            s.orderType,
+		Quantity:        s.quantity,
+		Price:           stringOrEmpty(s.price),
+		StopPrice:       stringOrEmpty(s.stopPrice),
+		ActivationPrice: stringOrEmpty(s.activationPrice),
+		ClosePosition:   s.closePosition != nil && *s.closePosition == "true",
+	}
+	if err := v.Validate(o); err != nil {
+		return err
+	}
+	s.quantity = o.Quantity
+	setIfPresent(&s.price, o.Price)
+	setIfPresent(&s.stopPrice, o.StopPrice)
+	setIfPresent(&s.activationPrice, o.ActivationPrice)
+	return nil
+}
+
+// ValidateOrderPlaceWsRequest validate the order of r with Validate and set its rounded prices
+// and quantities
+func (v *OrderValidator) ValidateOrderPlaceWsRequest(r *OrderPlaceWsRequest) error {
+	o := &OrderParams{
+		Symbol:          r.symbol,
+		Side:            r.side,
+		Type:            r.orderType,
+		Quantity:        r.quantity,
+		Price:           stringOrEmpty(r.price),
+		StopPrice:       stringOrEmpty(r.stopPrice),
+		ActivationPrice: stringOrEmpty(r.activationPrice),
+		ClosePosition:   r.closePosition != nil && *r.closePosition,
+	}
+	if err := v.Validate(o); err != nil {
+		return err
+	}
+	r.quantity = o.Quantity
+	setIfPresent(&r.price, o.Price)
+	setIfPresent(&r.stopPrice, o.StopPrice)
+	setIfPresent(&r.activationPrice, o.ActivationPrice)
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// setIfPresent replace the value of the optional parameter p if it is set
+func setIfPresent(p **string, value string) {
+	if *p != nil {
+		*p = &value
+	}
+}
+
+func decimalOrZero(v string) decimal.Decimal {
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
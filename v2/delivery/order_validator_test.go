@@ -0,0 +1,52 @@
+package delivery
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func TestOrderValidator(t *testing.T) {
+	data := []byte(`{
+		"symbols": [{
+			"symbol": "BTCUSD_PERP",
+			"contractSize": 100,
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "1000", "maxPrice": "4520958", "tickSize": "0.1"},
+				{"filterType": "LOT_SIZE", "minQty": "1", "maxQty": "1000000", "stepSize": "1"},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "1", "maxQty": "60000", "stepSize": "1"},
+				{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+			]
+		}]
+	}`)
+	info := new(ExchangeInfo)
+	require.NoError(t, json.Unmarshal(data, info))
+	v := NewOrderValidator(info)
+
+	o := &OrderParams{Symbol: "BTCUSD_PERP", Side: SideTypeBuy, Type: OrderTypeLimit, Quantity: "2.7", Price: "60000.06"}
+	assert.NoError(t, v.Validate(o))
+	assert.Equal(t, "2", o.Quantity)
+	assert.Equal(t, "60000.1", o.Price)
+
+	v.QuantityRounding = common.RoundingModeNone
+	o = &OrderParams{Symbol: "BTCUSD_PERP", Side: SideTypeBuy, Type: OrderTypeMarket, Quantity: "70000.5"}
+	err := v.Validate(o)
+	violations := err.(common.FilterViolations)
+	assert.Len(t, violations, 2)
+	assert.True(t, violations.Has(string(SymbolFilterTypeMarketLotSize)))
+
+	v.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "BTCUSD_PERP", MarkPrice: "60000"})
+	s := (&CreateOrderService{}).Symbol("BTCUSD_PERP").Side(SideTypeSell).Type(OrderTypeLimit).
+		Quantity("1").Price("56000")
+	assert.EqualError(t, v.ValidateCreateOrder(s), "order violates symbol filters: PERCENT_PRICE: price 56000 is below minimum 57000")
+
+	v.PriceRounding = common.RoundingModeUp
+	r := NewOrderPlaceWsRequest().Symbol("BTCUSD_PERP").Side(SideTypeSell).Type(OrderTypeLimit).
+		Quantity("1").Price("60000.01")
+	assert.NoError(t, v.ValidateOrderPlaceWsRequest(r))
+	assert.Equal(t, "60000.1", *r.price)
+}
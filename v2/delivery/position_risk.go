@@ -65,3 +65,52 @@ type PositionRisk struct {
 	IsAutoAddMargin  string `json:"isAutoAddMargin"`
 	PositionSide     string `json:"positionSide"`
 }
+
+// GetADLQuantileService get the auto-deleveraging quantile of the positions
+type GetADLQuantileService struct {
+	c      *Client
+	symbol *string
+}
+
+// Symbol set symbol
+func (s *GetADLQuantileService) Symbol(symbol string) *GetADLQuantileService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do send request
+func (s *GetADLQuantileService) Do(ctx context.Context, opts ...RequestOption) (res []*SymbolADLQuantile, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/adlQuantile",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*SymbolADLQuantile{}, err
+	}
+	res = make([]*SymbolADLQuantile, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*SymbolADLQuantile{}, err
+	}
+	return res, nil
+}
+
+// SymbolADLQuantile define the auto-deleveraging quantile of the positions of a symbol
+type SymbolADLQuantile struct {
+	Symbol      string      `json:"symbol"`
+	ADLQuantile ADLQuantile `json:"adlQuantile"`
+}
+
+// ADLQuantile define the auto-deleveraging quantile from 0 to 4, HEDGE is only
+// returned in hedge mode and BOTH in one-way mode
+type ADLQuantile struct {
+	Long  int  `json:"LONG"`
+	Short int  `json:"SHORT"`
+	Hedge *int `json:"HEDGE,omitempty"`
+	Both  *int `json:"BOTH,omitempty"`
+}
@@ -131,3 +131,40 @@ func (s *positionRiskServiceTestSuite) assertPositionRiskEqual(e, a *PositionRis
 	r.Equal(e.UnRealizedProfit, a.UnRealizedProfit, "UnRealizedProfit")
 	r.Equal(e.PositionSide, a.PositionSide, "PositionSide")
 }
+
+func (s *positionRiskServiceTestSuite) TestGetADLQuantile() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200925",
+			"adlQuantile": {
+				"LONG": 3,
+				"SHORT": 3,
+				"HEDGE": 0
+			}
+		},
+		{
+			"symbol": "BTCUSD_201225",
+			"adlQuantile": {
+				"LONG": 1,
+				"SHORT": 2,
+				"BOTH": 0
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetADLQuantileService().Do(newContext())
+	s.r().NoError(err)
+	zero := 0
+	e := []*SymbolADLQuantile{
+		{Symbol: "BTCUSD_200925", ADLQuantile: ADLQuantile{Long: 3, Short: 3, Hedge: &zero}},
+		{Symbol: "BTCUSD_201225", ADLQuantile: ADLQuantile{Long: 1, Short: 2, Both: &zero}},
+	}
+	s.r().Equal(e, res)
+}
@@ -0,0 +1,89 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// errNoOrderQuery is returned when a placed order cannot be looked up by its client order id
+var errNoOrderQuery = errors.New("order placement cannot be looked up")
+
+// orderQuery define how to look up an order placed through an order endpoint
+type orderQuery struct {
+	endpoint   string
+	idParam    string
+	queryParam string
+	newID      func() string
+}
+
+// orderQueries define the order placements which can be looked up by client order id
+// when their outcome is unknown
+var orderQueries = map[string]orderQuery{
+	"POST /dapi/v1/order": {endpoint: "/dapi/v1/order", idParam: "newClientOrderId", queryParam: "origClientOrderId", newID: common.GenerateSwapId},
+}
+
+// WithRetryPolicy set the retry policy of the request, overriding the one of the client.
+// A policy with MaxRetries 0 disables retries.
+func WithRetryPolicy(policy *common.RetryPolicy) RequestOption {
+	return func(r *request) {
+		r.retryPolicy = policy
+	}
+}
+
+// retryPolicy return the retry policy of r, falling back to the one of the client
+func (c *Client) retryPolicy(r *request) *common.RetryPolicy {
+	if r.retryPolicy != nil {
+		return r.retryPolicy
+	}
+	return c.RetryPolicy
+}
+
+// isOrderRequest check if r places or modifies orders
+func isOrderRequest(r *request) bool {
+	key := r.method + " " + r.endpoint
+	return orderEndpoints[key] > 0
+}
+
+// setClientOrderID generate the client order id of an order placement without one,
+// so that the order can be looked up when its outcome is unknown
+func setClientOrderID(r *request) {
+	q, ok := orderQueries[r.method+" "+r.endpoint]
+	if !ok || r.param(q.idParam) != "" {
+		return
+	}
+	if r.form.Get("symbol") != "" {
+		r.setFormParam(q.idParam, q.newID())
+		return
+	}
+	r.setParam(q.idParam, q.newID())
+}
+
+// queryPlacedOrder look up the order placed by r by its client order id,
+// found is false if the order does not exist. The query response has the fields of
+// the placement response.
+func (c *Client) queryPlacedOrder(ctx context.Context, r *request) (data []byte, found bool, err error) {
+	q, ok := orderQueries[r.method+" "+r.endpoint]
+	id := r.param(q.idParam)
+	if !ok || id == "" {
+		return nil, false, errNoOrderQuery
+	}
+	qr := &request{
+		method:     http.MethodGet,
+		endpoint:   q.endpoint,
+		secType:    secTypeSigned,
+		recvWindow: r.recvWindow,
+	}
+	qr.setParam("symbol", r.param("symbol"))
+	qr.setParam(q.queryParam, id)
+	data, err = c.doAPI(ctx, qr)
+	if err == nil {
+		return data, true, nil
+	}
+	if common.IsNoSuchOrderError(err) {
+		return nil, false, nil
+	}
+	return nil, false, err
+}
@@ -0,0 +1,117 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	gorilla "github.com/gorilla/websocket"
+)
+
+// StreamManager multiplexes market streams over combined stream connections.
+// Streams are added and removed without reconnecting, spread over several
+// connections past 1024 streams, and subscribed again after a reconnect.
+type StreamManager struct {
+	*websocket.StreamManager
+	errHandler ErrHandler
+}
+
+// NewStreamManager init a StreamManager on the combined stream endpoint
+func (c *Client) NewStreamManager(errHandler ErrHandler) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	dial := func(streams []string, handler func(message []byte), errHandler func(err error), onConn func(conn *gorilla.Conn)) (doneC, stopC chan struct{}, err error) {
+		endpoint := c.getCombinedEndpoint() + strings.Join(streams, "/")
+		if len(streams) == 0 {
+			endpoint = strings.TrimSuffix(endpoint, "?streams=")
+		}
+		cfg := newWsConfig(endpoint, c.getProxyUrl())
+		return wsServeWithConnHandler(cfg, handler, errHandler, func(ctx context.Context, conn *gorilla.Conn) {
+			onConn(conn)
+		})
+	}
+	return &StreamManager{
+		StreamManager: websocket.NewStreamManager(dial, errHandler),
+		errHandler:    errHandler,
+	}
+}
+
+// subscribeStreams decode the data of streams into a new T and pass it to handler
+func subscribeStreams[T any](m *StreamManager, streams []string, handler func(event *T)) ([]string, error) {
+	err := m.Subscribe(func(stream string, data []byte) {
+		event := new(T)
+		if err := json.Unmarshal(data, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	}, streams...)
+	return streams, err
+}
+
+func symbolStreams(symbols []string, format string) []string {
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		streams[i] = fmt.Sprintf(format, strings.ToLower(symbol))
+	}
+	return streams
+}
+
+// SubscribeAggTrade subscribe the aggregate trade streams of symbols and return their names
+func (m *StreamManager) SubscribeAggTrade(symbols []string, handler WsAggTradeHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@aggTrade"), handler)
+}
+
+// SubscribeIndexPrice subscribe the index price streams of pairs and return their names
+func (m *StreamManager) SubscribeIndexPrice(pairs []string, handler WsIndexPriceHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(pairs, "%s@indexPrice"), handler)
+}
+
+// SubscribeMarkPrice subscribe the mark price streams of symbols and return their names
+func (m *StreamManager) SubscribeMarkPrice(symbols []string, handler WsMarkPriceHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@markPrice"), handler)
+}
+
+// SubscribeKline subscribe the kline streams of symbols at interval and return their names
+func (m *StreamManager) SubscribeKline(symbols []string, interval string, handler WsKlineHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@kline_"+interval), handler)
+}
+
+// SubscribeMarketTicker subscribe the 24hr ticker streams of symbols and return their names
+func (m *StreamManager) SubscribeMarketTicker(symbols []string, handler WsMarketTickerHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@ticker"), handler)
+}
+
+// SubscribeBookTicker subscribe the best bid and ask streams of symbols and return their names
+func (m *StreamManager) SubscribeBookTicker(symbols []string, handler WsBookTickerHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@bookTicker"), handler)
+}
+
+// SubscribeDiffDepth subscribe the diff depth streams of symbols and return their names
+func (m *StreamManager) SubscribeDiffDepth(symbols []string, handler WsDepthHandler) ([]string, error) {
+	return m.subscribeDepth(symbolStreams(symbols, "%s@depth"), handler)
+}
+
+// SubscribePartialDepth subscribe the partial depth streams of symbols and return their names, levels is 5, 10 or 20
+func (m *StreamManager) SubscribePartialDepth(symbols []string, levels int, handler WsDepthHandler) ([]string, error) {
+	if levels != 5 && levels != 10 && levels != 20 {
+		return nil, errors.New("Invalid levels")
+	}
+	return m.subscribeDepth(symbolStreams(symbols, fmt.Sprintf("%%s@depth%d", levels)), handler)
+}
+
+func (m *StreamManager) subscribeDepth(streams []string, handler WsDepthHandler) ([]string, error) {
+	err := m.Subscribe(func(stream string, data []byte) {
+		event, err := parseWsDepthEvent(data)
+		if err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	}, streams...)
+	return streams, err
+}
@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// StreamKlines subscribe to the kline stream of symbol, see websocket.Subscription
+func (c *Client) StreamKlines(ctx context.Context, symbol string, interval string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsKlineEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsKlineEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// StreamAggTrades subscribe to the aggregate trade stream of symbol
+func (c *Client) StreamAggTrades(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsAggTradeEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsAggTradeEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsAggTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamMarkPrice subscribe to the mark price stream of symbol
+func (c *Client) StreamMarkPrice(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsMarkPriceEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsMarkPriceEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsMarkPriceServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamIndexPrice subscribe to the index price stream of pair
+func (c *Client) StreamIndexPrice(ctx context.Context, pair string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsIndexPriceEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsIndexPriceEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsIndexPriceServe(pair, handler, errHandler)
+	}, opts...)
+}
+
+// StreamDiffDepth subscribe to the diff depth stream of symbol
+func (c *Client) StreamDiffDepth(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsDepthEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsDepthEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsDiffDepthServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamPartialDepth subscribe to the partial depth stream of symbol, levels is 5, 10 or 20
+func (c *Client) StreamPartialDepth(ctx context.Context, symbol string, levels int, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsDepthEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsDepthEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, opts...)
+}
+
+// StreamBookTicker subscribe to the best bid and ask stream of symbol
+func (c *Client) StreamBookTicker(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsBookTickerEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsBookTickerEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsBookTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamMarketTicker subscribe to the 24hr ticker stream of symbol
+func (c *Client) StreamMarketTicker(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsMarketTickerEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsMarketTickerEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsMarketTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamUserData subscribe to the user data stream of listenKey
+func (c *Client) StreamUserData(ctx context.Context, listenKey string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsUserDataEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsUserDataEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
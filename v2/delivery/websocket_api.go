@@ -0,0 +1,36 @@
+package delivery
+
+import (
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// EnableWsApiConnection open a websocket API connection shared by the websocket API
+// services created from the client afterwards, so that all the requests are sent over
+// one connection. The responses of SyncDo are routed to their request by id, so services can
+// call it in parallel, and the responses of Do are read from the read channel of the returned
+// client. Close the returned client to close the connection.
+func (c *Client) EnableWsApiConnection() (websocket.Client, error) {
+	client, err := c.newWsApiConn()
+	if err != nil {
+		return nil, err
+	}
+	c.WsApiClient = client
+	return client, nil
+}
+
+// newWsApiClient return the shared websocket API connection if any, or open a new one
+func (c *Client) newWsApiClient() (websocket.Client, error) {
+	if c.WsApiClient != nil {
+		return c.WsApiClient, nil
+	}
+	return c.newWsApiConn()
+}
+
+func (c *Client) newWsApiConn() (websocket.Client, error) {
+	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	return websocket.NewClient(conn)
+}
@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/gorilla/websocket"
 )
 
 // Endpoints
@@ -14,6 +16,13 @@ var (
 	BaseWsMainUrl    = "wss://dstream.binance.com/ws"
 	BaseWsTestnetUrl = "wss://dstream.binancefuture.com/ws"
 	BaseWsDemoURL    = "wss://dstream.binancefuture.com/ws"
+
+	BaseCombinedMainURL    = "wss://dstream.binance.com/stream?streams="
+	BaseCombinedTestnetURL = "wss://dstream.binancefuture.com/stream?streams="
+	BaseCombinedDemoURL    = "wss://dstream.binancefuture.com/stream?streams="
+
+	BaseWsApiMainURL    = "wss://ws-dapi.binance.com/ws-dapi/v1"
+	BaseWsApiTestnetURL = "wss://testnet.binancefuture.com/ws-dapi/v1"
 )
 
 var (
@@ -23,6 +32,9 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
+	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
+	// using for websocket API (read/write)
+	WebsocketTimeoutReadWriteConnection = time.Second * 10
 )
 
 // WsAggTradeEvent define websocket aggTrde event.
@@ -604,43 +616,52 @@ func (c *Client) wsDepthServe(symbol string, levels string, rate *time.Duration,
 	cfg := newWsConfig(endpoint, c.getProxyUrl())
 
 	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
+		event, err := parseWsDepthEvent(message)
 		if err != nil {
 			errHandler(err)
 			return
 		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.TransactionTime = j.Get("T").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.Pair = j.Get("ps").MustString()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.PrevLastUpdateID = j.Get("pu").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
 		handler(event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// parseWsDepthEvent parse the message of a depth stream
+func parseWsDepthEvent(message []byte) (*WsDepthEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.TransactionTime = j.Get("T").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.Pair = j.Get("ps").MustString()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.PrevLastUpdateID = j.Get("pu").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return event, nil
+}
+
 // WsUserDataEvent define user data event
 type WsUserDataEvent struct {
 	Event               UserDataEventType  `json:"e"`
@@ -779,3 +800,14 @@ func (c *Client) WsUserDataServe(listenKey string, handler WsUserDataHandler, er
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
+
+// WsApiInitReadWriteConn create and serve connection
+func (c *Client) WsApiInitReadWriteConn() (*websocket.Conn, error) {
+	cfg := newWsConfig(c.getWsApiEndpoint(), c.getProxyUrl())
+	conn, err := WsGetReadWriteConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn, err
+}
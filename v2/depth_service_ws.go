@@ -0,0 +1,170 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// DepthWsApiService queries order book
+type DepthWsApiService struct {
+	c websocket.Client
+}
+
+// NewDepthWsApiService init DepthWsApiService
+func (c *Client) NewDepthWsApiService() (*DepthWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DepthWsApiService{
+		c: client,
+	}, nil
+}
+
+// DepthWsRequest parameters for 'depth' websocket API
+type DepthWsRequest struct {
+	symbol string
+	limit  *int
+}
+
+// NewDepthWsRequest init DepthWsRequest
+func NewDepthWsRequest() *DepthWsRequest {
+	return &DepthWsRequest{}
+}
+
+func (s *DepthWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *DepthWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'depth' request
+func (s *DepthWsApiService) Do(requestID string, request *DepthWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(
+		requestID,
+		websocket.DepthSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'depth' request and receives response
+func (s *DepthWsApiService) SyncDo(requestID string, request *DepthWsRequest) (*DepthWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(
+		requestID,
+		websocket.DepthSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	depthWsResponse := &DepthWsResponse{}
+	if err := json.Unmarshal(response, depthWsResponse); err != nil {
+		return nil, err
+	}
+
+	return depthWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *DepthWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *DepthWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *DepthWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *DepthWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *DepthWsRequest) Symbol(symbol string) *DepthWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *DepthWsRequest) Limit(limit int) *DepthWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// DepthWsResponse define 'depth' websocket API response
+type DepthWsResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result DepthResponse `json:"-"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the price levels of the result
+func (r *DepthWsResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Id     string `json:"id"`
+		Status int    `json:"status"`
+		Result *struct {
+			LastUpdateID int64       `json:"lastUpdateId"`
+			Bids         [][2]string `json:"bids"`
+			Asks         [][2]string `json:"asks"`
+		} `json:"result"`
+		Error *common.APIError `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id = raw.Id
+	r.Status = raw.Status
+	r.Error = raw.Error
+	r.Result = DepthResponse{}
+	if raw.Result == nil {
+		return nil
+	}
+	r.Result.LastUpdateID = raw.Result.LastUpdateID
+	r.Result.Bids = make([]Bid, len(raw.Result.Bids))
+	for i, level := range raw.Result.Bids {
+		r.Result.Bids[i] = Bid{Price: level[0], Quantity: level[1]}
+	}
+	r.Result.Asks = make([]Ask, len(raw.Result.Asks))
+	for i, level := range raw.Result.Asks {
+		r.Result.Asks[i] = Ask{Price: level[0], Quantity: level[1]}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type depthServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *DepthWsApiService
+	request   *DepthWsRequest
+}
+
+func TestDepthServiceWs(t *testing.T) {
+	suite.Run(t, new(depthServiceWsTestSuite))
+}
+
+func (s *depthServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &DepthWsApiService{
+		c: s.client,
+	}
+
+	s.request = NewDepthWsRequest().
+		Symbol("BNBBTC").
+		Limit(5)
+}
+
+func (s *depthServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *depthServiceWsTestSuite) TestDepth() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"depth"`)
+		s.Contains(string(data), `"limit":5`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *depthServiceWsTestSuite) TestDepth_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *depthServiceWsTestSuite) TestDepthSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {
+			"lastUpdateId": 2731179239,
+			"bids": [
+				["0.01379900", "3.43200000"],
+				["0.01379800", "3.24300000"]
+			],
+			"asks": [
+				["0.01380000", "5.91700000"]
+			]
+		}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Equal(int64(2731179239), response.Result.LastUpdateID)
+	s.Equal([]Bid{
+		{Price: "0.01379900", Quantity: "3.43200000"},
+		{Price: "0.01379800", Quantity: "3.24300000"},
+	}, response.Result.Bids)
+	s.Equal([]Ask{{Price: "0.01380000", Quantity: "5.91700000"}}, response.Result.Asks)
+}
+
+func (s *depthServiceWsTestSuite) TestDepthUnsigned() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.NotContains(string(data), `"signature"`)
+		s.NotContains(string(data), `"apiKey"`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
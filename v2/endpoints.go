@@ -0,0 +1,86 @@
+package binance
+
+import "strings"
+
+// Endpoints define the base URLs used by a client, set it with Client.SetEndpoints to run clients
+// against different environments in one process. An empty URL falls back to the endpoint selected
+// by the UseTestnet and UseDemo flags.
+type Endpoints struct {
+	// API is the base URL of the REST API, e.g. https://api.binance.com
+	API string
+	// Stream is the base URL of the raw streams, e.g. wss://stream.binance.com:9443/ws
+	Stream string
+	// CombinedStream is the base URL of the combined streams, ending with "?streams="
+	CombinedStream string
+	// WsAPI is the URL of the WebSocket API
+	WsAPI string
+	// Announcement is the URL of the announcement stream
+	Announcement string
+}
+
+// Endpoint profiles
+var (
+	// MainnetEndpoints is the production environment
+	MainnetEndpoints = Endpoints{
+		API:            "https://api.binance.com",
+		Stream:         "wss://stream.binance.com:9443/ws",
+		CombinedStream: "wss://stream.binance.com:9443/stream?streams=",
+		WsAPI:          "wss://ws-api.binance.com:443/ws-api/v3",
+		Announcement:   "wss://api.binance.com/sapi/wss",
+	}
+	// TestnetEndpoints is the spot test network
+	TestnetEndpoints = Endpoints{
+		API:            "https://testnet.binance.vision",
+		Stream:         "wss://stream.testnet.binance.vision/ws",
+		CombinedStream: "wss://stream.testnet.binance.vision/stream?streams=",
+		WsAPI:          "wss://ws-api.testnet.binance.vision/ws-api/v3",
+	}
+	// DemoEndpoints is the demo trading environment
+	DemoEndpoints = Endpoints{
+		API:            "https://demo-api.binance.com",
+		Stream:         "wss://demo-stream.binance.com/ws",
+		CombinedStream: "wss://demo-stream.binance.com/stream?streams=",
+		WsAPI:          "wss://demo-ws-api.binance.com/ws-api/v3",
+	}
+	// API1Endpoints to API4Endpoints and GCPEndpoints are the production environment with an
+	// alternate REST host, which may perform better but is less stable than api.binance.com
+	API1Endpoints = MainnetEndpoints.WithAPI("https://api1.binance.com")
+	API2Endpoints = MainnetEndpoints.WithAPI("https://api2.binance.com")
+	API3Endpoints = MainnetEndpoints.WithAPI("https://api3.binance.com")
+	API4Endpoints = MainnetEndpoints.WithAPI("https://api4.binance.com")
+	GCPEndpoints  = MainnetEndpoints.WithAPI("https://api-gcp.binance.com")
+	// MarketDataEndpoints is the production environment serving the public market data only, the
+	// signed endpoints and the user data streams are not available
+	MarketDataEndpoints = Endpoints{
+		API:            "https://data-api.binance.vision",
+		Stream:         "wss://data-stream.binance.vision/ws",
+		CombinedStream: "wss://data-stream.binance.vision/stream?streams=",
+	}
+)
+
+// WithAPI return a copy of the endpoints with the REST API base URL replaced, e.g. for a regional
+// proxy
+func (e Endpoints) WithAPI(url string) Endpoints {
+	e.API = url
+	return e
+}
+
+// NewLocalEndpoints return the endpoints of a server serving the REST API, the streams and the
+// WebSocket API under the paths of the production hosts, e.g. a simulator on
+// http://127.0.0.1:8080
+func NewLocalEndpoints(baseURL string) Endpoints {
+	ws := "ws" + strings.TrimPrefix(baseURL, "http")
+	return Endpoints{
+		API:            baseURL,
+		Stream:         ws + "/ws",
+		CombinedStream: ws + "/stream?streams=",
+		WsAPI:          ws + "/ws-api/v3",
+		Announcement:   ws + "/sapi/wss",
+	}
+}
+
+// SetEndpoints make the client use the endpoints instead of the ones selected by the UseTestnet
+// and UseDemo flags
+func (c *Client) SetEndpoints(endpoints Endpoints) {
+	c.Endpoints = &endpoints
+}
@@ -0,0 +1,38 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoints(t *testing.T) {
+	client := NewClient("key", "secret")
+	assert.Equal(t, BaseAPIMainURL, client.getAPIEndpoint())
+	assert.Equal(t, BaseWsMainURL, client.getWsEndpoint())
+
+	testnet := NewClient("key", "secret")
+	testnet.SetUseTestnet()
+	assert.Equal(t, BaseAPITestnetURL, testnet.getAPIEndpoint())
+
+	// the endpoints of a client don't change the endpoints of the others
+	local := NewClient("key", "secret")
+	local.SetEndpoints(NewLocalEndpoints("http://127.0.0.1:8080"))
+	assert.Equal(t, "http://127.0.0.1:8080", local.getAPIEndpoint())
+	assert.Equal(t, "ws://127.0.0.1:8080/ws", local.getWsEndpoint())
+	assert.Equal(t, "ws://127.0.0.1:8080/stream?streams=", local.getCombinedEndpoint())
+	assert.Equal(t, "ws://127.0.0.1:8080/ws-api/v3", local.getWsApiEndpoint())
+	assert.Equal(t, BaseAPIMainURL, client.getAPIEndpoint())
+
+	// the empty URLs fall back to the flags
+	data := NewClient("key", "secret")
+	data.SetEndpoints(MarketDataEndpoints)
+	assert.Equal(t, "https://data-api.binance.vision", data.getAPIEndpoint())
+	assert.Equal(t, "wss://data-stream.binance.vision/ws", data.getWsEndpoint())
+	assert.Equal(t, BaseWsApiMainURL, data.getWsApiEndpoint())
+
+	assert.Equal(t, "https://api-gcp.binance.com", GCPEndpoints.API)
+	assert.Equal(t, MainnetEndpoints.Stream, API3Endpoints.Stream)
+	assert.Equal(t, "https://eu.example.com", MainnetEndpoints.WithAPI("https://eu.example.com").API)
+	assert.Equal(t, "https://api.binance.com", MainnetEndpoints.API)
+}
@@ -16,15 +16,12 @@ type WsAccountService struct {
 	KeyType    string
 	TimeOffset int64
 	RecvWindow int64
+
+	timeSync wsTimeSync
 }
 
 func (c *Client) NewWsAccountService(recvWindow ...int64) (*WsAccountService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +37,8 @@ func (c *Client) NewWsAccountService(recvWindow ...int64) (*WsAccountService, er
 		SecretKey:  c.SecretKey,
 		KeyType:    common.KeyTypeHmac,
 		RecvWindow: window,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -59,6 +58,33 @@ type WsAccountV2BalanceResponse struct {
 	Error     *common.APIError   `json:"error,omitempty"`
 }
 
+// WsAccountInfoResponse define 'account.status' websocket API response
+type WsAccountInfoResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    Account            `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// WsAccountPositionResponse define 'account.position' websocket API response
+type WsAccountPositionResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    []*PositionRisk    `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// WsAccountV2PositionResponse define 'v2/account.position' websocket API response
+type WsAccountV2PositionResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    []*PositionRiskV3  `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
 type AccountRateLimit struct {
 	RateLimitType string `json:"rateLimitType"`
 	Interval      string `json:"interval"`
@@ -68,8 +94,11 @@ type AccountRateLimit struct {
 }
 
 const (
-	AccountV2InfoMethod    websocket.WsApiMethodType = "v2/account.status"
-	AccountV2BalanceMethod websocket.WsApiMethodType = "v2/account.balance"
+	AccountV2InfoMethod     websocket.WsApiMethodType = "v2/account.status"
+	AccountV2BalanceMethod  websocket.WsApiMethodType = "v2/account.balance"
+	AccountInfoMethod       websocket.WsApiMethodType = "account.status"
+	AccountPositionMethod   websocket.WsApiMethodType = "account.position"
+	AccountV2PositionMethod websocket.WsApiMethodType = "v2/account.position"
 )
 
 func (s *WsAccountService) GetAccountInfo(requestID string) error {
@@ -86,12 +115,9 @@ func (s *WsAccountService) GetAccountInfo(requestID string) error {
 }
 
 func (s *WsAccountService) SyncGetAccountInfo(requestID string) (*WsAccountV2InfoResponse, error) {
-	rawData, err := s.buildRequest(requestID, AccountV2InfoMethod)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return s.buildRequest(requestID, AccountV2InfoMethod)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -118,37 +144,134 @@ func (s *WsAccountService) GetAccountBalance(requestID string) error {
 }
 
 func (s *WsAccountService) SyncGetAccountBalance(requestID string) (*WsAccountV2BalanceResponse, error) {
-	rawData, err := s.buildRequest(requestID, AccountV2BalanceMethod)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return s.buildRequest(requestID, AccountV2BalanceMethod)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	balance := &WsAccountV2BalanceResponse{}
+	if err := json.Unmarshal(response, balance); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+// GetAccountStatus sends 'account.status' request
+func (s *WsAccountService) GetAccountStatus(requestID string) error {
+	rawData, err := s.buildRequest(requestID, AccountInfoMethod)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetAccountStatus sends 'account.status' request and receives response
+func (s *WsAccountService) SyncGetAccountStatus(requestID string) (*WsAccountInfoResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return s.buildRequest(requestID, AccountInfoMethod)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	balance := &WsAccountV2BalanceResponse{}
-	if err := json.Unmarshal(response, balance); err != nil {
+	info := &WsAccountInfoResponse{}
+	if err := json.Unmarshal(response, info); err != nil {
 		return nil, err
 	}
 
-	return balance, nil
+	return info, nil
+}
+
+// GetPositions sends 'account.position' request, the positions of all the symbols are
+// requested when symbol is empty
+func (s *WsAccountService) GetPositions(requestID string, symbol string) error {
+	rawData, err := s.buildRequest(requestID, AccountPositionMethod, symbol)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetPositions sends 'account.position' request and receives response
+func (s *WsAccountService) SyncGetPositions(requestID string, symbol string) (*WsAccountPositionResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return s.buildRequest(requestID, AccountPositionMethod, symbol)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	positions := &WsAccountPositionResponse{}
+	if err := json.Unmarshal(response, positions); err != nil {
+		return nil, err
+	}
+
+	return positions, nil
 }
 
-func (s *WsAccountService) buildRequest(requestID string, method websocket.WsApiMethodType) ([]byte, error) {
-	return websocket.CreateRequest(
+// GetPositionsV2 sends 'v2/account.position' request, which only returns the symbols
+// with a position or an open order
+func (s *WsAccountService) GetPositionsV2(requestID string, symbol string) error {
+	rawData, err := s.buildRequest(requestID, AccountV2PositionMethod, symbol)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetPositionsV2 sends 'v2/account.position' request and receives response
+func (s *WsAccountService) SyncGetPositionsV2(requestID string, symbol string) (*WsAccountV2PositionResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return s.buildRequest(requestID, AccountV2PositionMethod, symbol)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	positions := &WsAccountV2PositionResponse{}
+	if err := json.Unmarshal(response, positions); err != nil {
+		return nil, err
+	}
+
+	return positions, nil
+}
+
+func (s *WsAccountService) buildRequest(requestID string, method websocket.WsApiMethodType, symbol ...string) ([]byte, error) {
+	params := map[string]any{
+		"recvWindow": s.RecvWindow,
+	}
+	if len(symbol) > 0 && symbol[0] != "" {
+		params["symbol"] = symbol[0]
+	}
+	return websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		method,
-		map[string]any{
-			"recvWindow": s.RecvWindow,
-		},
+		params,
 	)
 }
 
@@ -178,7 +301,9 @@ func (c *Client) GetAccountInfoWs(recvWindow ...int64) (*WsAccountV2InfoResponse
 	if err != nil {
 		return nil, err
 	}
-	defer service.c.Close()
+	if c.WsApiClient == nil {
+		defer service.c.Close()
+	}
 
 	response, err := service.SyncGetAccountInfo(uuid.New().String())
 	if err != nil {
@@ -193,7 +318,9 @@ func (c *Client) GetAccountBalanceWs(recvWindow ...int64) (*WsAccountV2BalanceRe
 	if err != nil {
 		return nil, err
 	}
-	defer service.c.Close()
+	if c.WsApiClient == nil {
+		defer service.c.Close()
+	}
 
 	response, err := service.SyncGetAccountBalance(uuid.New().String())
 	if err != nil {
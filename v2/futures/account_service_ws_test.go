@@ -204,3 +204,147 @@ func (s *accountWsServiceTestSuite) TestGetAccountBalance() {
 	s.Equal("192.32494207", response.Result[0].Balance)
 	s.Equal("185.54784206", response.Result[0].AvailableBalance)
 }
+
+func (s *accountWsServiceTestSuite) TestGetAccountStatus() {
+	data := []byte(`{
+  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+  "status": 200,
+  "result": {
+    "feeTier": 0,
+    "canTrade": true,
+    "canDeposit": true,
+    "canWithdraw": true,
+    "updateTime": 0,
+    "multiAssetsMargin": false,
+    "totalWalletBalance": "103.12345678",
+    "availableBalance": "103.12345678",
+    "assets": [
+      {"asset": "USDT", "walletBalance": "103.12345678", "marginAvailable": true, "updateTime": 1625474304765}
+    ],
+    "positions": [
+      {"symbol": "BTCUSDT", "leverage": "100", "isolated": true, "positionSide": "BOTH", "positionAmt": "1.000", "updateTime": 0}
+    ]
+  }
+}`)
+
+	requestID := "605a6d20-6588-4cb9-afa0-b0ab087507ba"
+	s.mockClient.EXPECT().WriteSync(requestID, gomock.Any(), gomock.Any()).DoAndReturn(func(id string, req []byte, _ any) ([]byte, error) {
+		s.Contains(string(req), `"method":"account.status"`)
+		return data, nil
+	})
+
+	service := &WsAccountService{
+		c:          s.mockClient,
+		ApiKey:     s.apiKey,
+		SecretKey:  s.secretKey,
+		KeyType:    common.KeyTypeHmac,
+		RecvWindow: 5000,
+	}
+
+	response, err := service.SyncGetAccountStatus(requestID)
+	s.Require().NoError(err)
+	s.Equal(200, response.Status)
+	s.True(response.Result.CanTrade)
+	s.Equal("103.12345678", response.Result.TotalWalletBalance)
+	s.Require().Len(response.Result.Positions, 1)
+	s.Equal("100", response.Result.Positions[0].Leverage)
+}
+
+func (s *accountWsServiceTestSuite) TestGetPositions() {
+	data := []byte(`{
+  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+  "status": 200,
+  "result": [
+    {
+      "entryPrice": "68625.7",
+      "breakEvenPrice": "68652.97",
+      "marginType": "cross",
+      "isAutoAddMargin": "false",
+      "isolatedMargin": "0.00000000",
+      "leverage": "20",
+      "liquidationPrice": "0",
+      "markPrice": "68563.4",
+      "maxNotionalValue": "2000000",
+      "positionAmt": "-0.002",
+      "symbol": "BTCUSDT",
+      "unRealizedProfit": "0.12460000",
+      "positionSide": "BOTH",
+      "notional": "-137.1268",
+      "isolatedWallet": "0"
+    }
+  ]
+}`)
+
+	requestID := "605a6d20-6588-4cb9-afa0-b0ab087507ba"
+	s.mockClient.EXPECT().WriteSync(requestID, gomock.Any(), gomock.Any()).DoAndReturn(func(id string, req []byte, _ any) ([]byte, error) {
+		s.Contains(string(req), `"method":"account.position"`)
+		s.Contains(string(req), `"symbol":"BTCUSDT"`)
+		return data, nil
+	})
+
+	service := &WsAccountService{
+		c:          s.mockClient,
+		ApiKey:     s.apiKey,
+		SecretKey:  s.secretKey,
+		KeyType:    common.KeyTypeHmac,
+		RecvWindow: 5000,
+	}
+
+	response, err := service.SyncGetPositions(requestID, "BTCUSDT")
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("-0.002", response.Result[0].PositionAmt)
+	s.Equal("cross", response.Result[0].MarginType)
+}
+
+func (s *accountWsServiceTestSuite) TestGetPositionsV2() {
+	data := []byte(`{
+  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+  "status": 200,
+  "result": [
+    {
+      "symbol": "BTCUSDT",
+      "positionSide": "BOTH",
+      "positionAmt": "1.000",
+      "entryPrice": "0.00000",
+      "breakEvenPrice": "0.0",
+      "markPrice": "6679.50671178",
+      "unRealizedProfit": "0.00000000",
+      "liquidationPrice": "0",
+      "isolatedMargin": "0.00000000",
+      "notional": "0",
+      "marginAsset": "USDT",
+      "isolatedWallet": "0",
+      "initialMargin": "0",
+      "maintMargin": "0",
+      "positionInitialMargin": "0",
+      "openOrderInitialMargin": "0",
+      "adl": 0,
+      "bidNotional": "0",
+      "askNotional": "0",
+      "updateTime": 0
+    }
+  ]
+}`)
+
+	requestID := "605a6d20-6588-4cb9-afa0-b0ab087507ba"
+	s.mockClient.EXPECT().WriteSync(requestID, gomock.Any(), gomock.Any()).DoAndReturn(func(id string, req []byte, _ any) ([]byte, error) {
+		s.Contains(string(req), `"method":"v2/account.position"`)
+		s.NotContains(string(req), `"symbol"`)
+		return data, nil
+	})
+
+	service := &WsAccountService{
+		c:          s.mockClient,
+		ApiKey:     s.apiKey,
+		SecretKey:  s.secretKey,
+		KeyType:    common.KeyTypeHmac,
+		RecvWindow: 5000,
+	}
+
+	response, err := service.SyncGetPositionsV2(requestID, "")
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("USDT", response.Result[0].MarginAsset)
+	s.Equal("6679.50671178", response.Result[0].MarkPrice)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/bitly/go-simplejson"
 
 	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/streamrec"
+	"github.com/adshao/go-binance/v2/common/websocket"
 )
 
 // SideType define side type of order
@@ -268,11 +270,27 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	ProxyUrl   string
+	// Endpoints overrides the endpoints selected by UseTestnet and UseDemo when set, see SetEndpoints
+	Endpoints *Endpoints
 
 	do doFunc
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+	// RateLimiter enables client-side rate limiting when set, see EnableRateLimiter
+	RateLimiter *common.RateLimiter
+	// TimeSync overrides TimeOffset with a synced offset when set, see EnableTimeSync
+	TimeSync *common.TimeSync
+	// RetryPolicy retries failed requests when set, it can be overridden with WithRetryPolicy
+	RetryPolicy *common.RetryPolicy
+	// WsApiClient is the connection shared by the websocket API services when set, see EnableWsApiConnection
+	WsApiClient websocket.Client
+	// StreamRecorder records the messages of the streams started afterwards when set
+	StreamRecorder *streamrec.Recorder
+	// StreamReplayer replays its recording to the streams started afterwards instead of opening
+	// their connection when set. The streams are not reconnected when the replay ends even if
+	// WebsocketAutoReconnect is enabled.
+	StreamReplayer *streamrec.Replayer
 }
 
 func (c *Client) SetUseTestnet() {
@@ -285,6 +303,9 @@ func (c *Client) SetUseDemo() {
 
 // getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.API != "" {
+		return c.Endpoints.API
+	}
 	if c.UseTestnet {
 		return BaseApiTestnetUrl
 	}
@@ -303,6 +324,9 @@ func (c *Client) getProxyUrl() *string {
 
 // getWsApiEndpoint return the base endpoint of the API WS according the UseTestnet flag
 func (c *Client) getWsApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.WsAPI != "" {
+		return c.Endpoints.WsAPI
+	}
 	if c.UseTestnet {
 		return BaseWsApiTestnetURL
 	}
@@ -314,6 +338,9 @@ func (c *Client) getWsApiEndpoint() string {
 
 // getWsPublicEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getWsPublicEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.PublicStream != "" {
+		return c.Endpoints.PublicStream
+	}
 	if c.UseTestnet {
 		return BaseWsTestnetURL
 	}
@@ -325,6 +352,9 @@ func (c *Client) getWsPublicEndpoint() string {
 
 // getWsMarketEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getWsMarketEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.MarketStream != "" {
+		return c.Endpoints.MarketStream
+	}
 	if c.UseTestnet {
 		return BaseWsTestnetURL
 	}
@@ -336,6 +366,9 @@ func (c *Client) getWsMarketEndpoint() string {
 
 // getWsPrivateEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getWsPrivateEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.PrivateStream != "" {
+		return c.Endpoints.PrivateStream
+	}
 	if c.UseTestnet {
 		return BaseWsTestnetURL
 	}
@@ -347,6 +380,9 @@ func (c *Client) getWsPrivateEndpoint() string {
 
 // getCombinedPublicEndpoint return the base endpoint of the combined stream according the UseTestnet flag
 func (c *Client) getCombinedPublicEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.CombinedPublicStream != "" {
+		return c.Endpoints.CombinedPublicStream
+	}
 	if c.UseTestnet {
 		return BaseCombinedTestnetURL
 	}
@@ -357,6 +393,9 @@ func (c *Client) getCombinedPublicEndpoint() string {
 }
 
 func (c *Client) getCombinedMarketEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.CombinedMarketStream != "" {
+		return c.Endpoints.CombinedMarketStream
+	}
 	if c.UseTestnet {
 		return BaseCombinedTestnetURL
 	}
@@ -367,6 +406,9 @@ func (c *Client) getCombinedMarketEndpoint() string {
 }
 
 func (c *Client) getCombinedPrivateEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.CombinedPrivateStream != "" {
+		return c.Endpoints.CombinedPrivateStream
+	}
 	if c.UseTestnet {
 		return BaseCombinedTestnetURL
 	}
@@ -397,7 +439,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -447,6 +489,55 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	// options are applied once so that retries send the same request
+	for _, opt := range opts {
+		opt(r)
+	}
+	policy := c.retryPolicy(r)
+	if policy != nil {
+		setClientOrderID(r)
+	}
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		data, header, err = c.doAPI(ctx, r)
+		if err == nil {
+			return data, header, nil
+		}
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			attempt--
+			continue
+		}
+		if ctx.Err() != nil || !policy.ShouldRetry(attempt, err) {
+			return data, header, err
+		}
+		switch {
+		case !common.IsUnknownStatusError(err):
+		case isOrderRequest(r):
+			// the order may have been placed, look it up before sending it again
+			orderData, orderHeader, found, qerr := c.queryPlacedOrder(ctx, r)
+			if qerr != nil {
+				return data, header, err
+			}
+			if found {
+				return orderData, orderHeader, nil
+			}
+		case r.method != http.MethodGet:
+			// e.g. a withdrawal or a transfer may have been executed, only the requests
+			// which never reached the server are resent
+			return data, header, err
+		}
+		if policy.Wait(ctx, attempt) != nil {
+			return data, header, err
+		}
+	}
+}
+
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	// wait before signing the request, so its timestamp is not stale once sent
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, &http.Header{}, err
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -469,6 +560,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	c.updateRateLimit(r, res)
 
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
@@ -495,6 +587,8 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
 		return nil, &res.Header, apiErr
 	}
 	return data, &res.Header, nil
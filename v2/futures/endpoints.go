@@ -0,0 +1,89 @@
+package futures
+
+import "strings"
+
+// Endpoints define the base URLs used by a client, set it with Client.SetEndpoints to run clients
+// against different environments in one process. An empty URL falls back to the endpoint selected
+// by the UseTestnet and UseDemo flags.
+type Endpoints struct {
+	// API is the base URL of the REST API, e.g. https://fapi.binance.com
+	API string
+	// PublicStream, MarketStream and PrivateStream are the base URLs of the raw streams of the
+	// high frequency public data, the market data and the user data
+	PublicStream  string
+	MarketStream  string
+	PrivateStream string
+	// CombinedPublicStream, CombinedMarketStream and CombinedPrivateStream are the base URLs of the
+	// combined streams, ending with "?streams="
+	CombinedPublicStream  string
+	CombinedMarketStream  string
+	CombinedPrivateStream string
+	// WsAPI is the URL of the WebSocket API
+	WsAPI string
+}
+
+// Endpoint profiles
+var (
+	// MainnetEndpoints is the production environment
+	MainnetEndpoints = Endpoints{
+		API:                   "https://fapi.binance.com",
+		PublicStream:          "wss://fstream.binance.com/public/ws",
+		MarketStream:          "wss://fstream.binance.com/market/ws",
+		PrivateStream:         "wss://fstream.binance.com/private/ws",
+		CombinedPublicStream:  "wss://fstream.binance.com/public/stream?streams=",
+		CombinedMarketStream:  "wss://fstream.binance.com/market/stream?streams=",
+		CombinedPrivateStream: "wss://fstream.binance.com/private/stream?streams=",
+		WsAPI:                 "wss://ws-fapi.binance.com/ws-fapi/v1",
+	}
+	// TestnetEndpoints is the futures test network
+	TestnetEndpoints = singleStreamEndpoints("https://testnet.binancefuture.com",
+		"wss://testnet.binancefuture.com", "wss://testnet.binancefuture.com/ws-fapi/v1")
+	// DemoEndpoints is the demo trading environment
+	DemoEndpoints = singleStreamEndpoints("https://demo-fapi.binance.com",
+		"wss://fstream.binancefuture.com", "wss://fstream.binancefuture.com/ws-fapi/v1")
+)
+
+// singleStreamEndpoints return endpoints serving the public, market and private streams on the
+// same host
+func singleStreamEndpoints(api, stream, wsAPI string) Endpoints {
+	return Endpoints{
+		API:                   api,
+		PublicStream:          stream + "/ws",
+		MarketStream:          stream + "/ws",
+		PrivateStream:         stream + "/ws",
+		CombinedPublicStream:  stream + "/stream?streams=",
+		CombinedMarketStream:  stream + "/stream?streams=",
+		CombinedPrivateStream: stream + "/stream?streams=",
+		WsAPI:                 wsAPI,
+	}
+}
+
+// WithAPI return a copy of the endpoints with the REST API base URL replaced, e.g. for a regional
+// proxy
+func (e Endpoints) WithAPI(url string) Endpoints {
+	e.API = url
+	return e
+}
+
+// NewLocalEndpoints return the endpoints of a server serving the REST API, the streams and the
+// WebSocket API under the paths of the production hosts, e.g. a simulator on
+// http://127.0.0.1:8080
+func NewLocalEndpoints(baseURL string) Endpoints {
+	ws := "ws" + strings.TrimPrefix(baseURL, "http")
+	return Endpoints{
+		API:                   baseURL,
+		PublicStream:          ws + "/fstream/public/ws",
+		MarketStream:          ws + "/fstream/market/ws",
+		PrivateStream:         ws + "/fstream/private/ws",
+		CombinedPublicStream:  ws + "/fstream/public/stream?streams=",
+		CombinedMarketStream:  ws + "/fstream/market/stream?streams=",
+		CombinedPrivateStream: ws + "/fstream/private/stream?streams=",
+		WsAPI:                 ws + "/ws-fapi/v1",
+	}
+}
+
+// SetEndpoints make the client use the endpoints instead of the ones selected by the UseTestnet
+// and UseDemo flags
+func (c *Client) SetEndpoints(endpoints Endpoints) {
+	c.Endpoints = &endpoints
+}
@@ -0,0 +1,33 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoints(t *testing.T) {
+	client := NewClient("key", "secret")
+	assert.Equal(t, BaseApiMainUrl, client.getApiEndpoint())
+	assert.Equal(t, BaseWsMarketURL, client.getWsMarketEndpoint())
+
+	testnet := NewClient("key", "secret")
+	testnet.SetEndpoints(TestnetEndpoints)
+	assert.Equal(t, BaseApiTestnetUrl, testnet.getApiEndpoint())
+	assert.Equal(t, BaseWsTestnetURL, testnet.getWsPublicEndpoint())
+	assert.Equal(t, BaseWsTestnetURL, testnet.getWsPrivateEndpoint())
+	assert.Equal(t, BaseCombinedTestnetURL, testnet.getCombinedMarketEndpoint())
+	assert.Equal(t, BaseWsApiTestnetURL, testnet.getWsApiEndpoint())
+
+	local := NewClient("key", "secret")
+	local.SetEndpoints(NewLocalEndpoints("http://127.0.0.1:8080"))
+	assert.Equal(t, "http://127.0.0.1:8080", local.getApiEndpoint())
+	assert.Equal(t, "ws://127.0.0.1:8080/fstream/public/ws", local.getWsPublicEndpoint())
+	assert.Equal(t, "ws://127.0.0.1:8080/fstream/private/stream?streams=", local.getCombinedPrivateEndpoint())
+	assert.Equal(t, "ws://127.0.0.1:8080/ws-fapi/v1", local.getWsApiEndpoint())
+	assert.Equal(t, BaseApiMainUrl, client.getApiEndpoint())
+
+	assert.Equal(t, MainnetEndpoints.API, BaseApiMainUrl)
+	assert.Equal(t, MainnetEndpoints.CombinedPublicStream, BaseCombinedPublicURL)
+	assert.Equal(t, DemoEndpoints.WsAPI, BaseWsApiDemoURL)
+}
@@ -0,0 +1,291 @@
+package futures
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// WsMarketService query market data over the websocket API, its requests are not signed
+type WsMarketService struct {
+	c websocket.Client
+}
+
+// NewWsMarketService init WsMarketService
+func (c *Client) NewWsMarketService() (*WsMarketService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &WsMarketService{
+		c: client,
+	}, nil
+}
+
+// WsDepthResponse define 'depth' websocket API response
+type WsDepthResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    DepthResponse      `json:"-"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the price levels of the result
+func (r *WsDepthResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID     string `json:"id"`
+		Status int    `json:"status"`
+		Result *struct {
+			LastUpdateID int64       `json:"lastUpdateId"`
+			Time         int64       `json:"E"`
+			TradeTime    int64       `json:"T"`
+			Bids         [][2]string `json:"bids"`
+			Asks         [][2]string `json:"asks"`
+		} `json:"result"`
+		RateLimit []AccountRateLimit `json:"rateLimits"`
+		Error     *common.APIError   `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.ID = raw.ID
+	r.Status = raw.Status
+	r.RateLimit = raw.RateLimit
+	r.Error = raw.Error
+	r.Result = DepthResponse{}
+	if raw.Result == nil {
+		return nil
+	}
+	r.Result.LastUpdateID = raw.Result.LastUpdateID
+	r.Result.Time = raw.Result.Time
+	r.Result.TradeTime = raw.Result.TradeTime
+	r.Result.Bids = make([]Bid, len(raw.Result.Bids))
+	for i, level := range raw.Result.Bids {
+		r.Result.Bids[i] = Bid{Price: level[0], Quantity: level[1]}
+	}
+	r.Result.Asks = make([]Ask, len(raw.Result.Asks))
+	for i, level := range raw.Result.Asks {
+		r.Result.Asks[i] = Ask{Price: level[0], Quantity: level[1]}
+	}
+	return nil
+}
+
+// WsBookTickerResponse define 'ticker.book' websocket API response, Result holds one
+// ticker when a symbol was requested
+type WsBookTickerResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    []*BookTicker      `json:"-"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the result which is an object for one symbol and an array otherwise
+func (r *WsBookTickerResponse) UnmarshalJSON(data []byte) error {
+	var raw wsTickerResponse
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.ID = raw.ID
+	r.Status = raw.Status
+	r.RateLimit = raw.RateLimit
+	r.Error = raw.Error
+	r.Result = nil
+	return unmarshalObjectOrArray(raw.Result, &r.Result)
+}
+
+// WsPriceResponse define 'ticker.price' websocket API response, Result holds one
+// price when a symbol was requested
+type WsPriceResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    []*SymbolPrice     `json:"-"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the result which is an object for one symbol and an array otherwise
+func (r *WsPriceResponse) UnmarshalJSON(data []byte) error {
+	var raw wsTickerResponse
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.ID = raw.ID
+	r.Status = raw.Status
+	r.RateLimit = raw.RateLimit
+	r.Error = raw.Error
+	r.Result = nil
+	return unmarshalObjectOrArray(raw.Result, &r.Result)
+}
+
+type wsTickerResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    json.RawMessage    `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// unmarshalObjectOrArray decode data into the slice v, data being an array or a single object
+func unmarshalObjectOrArray[T any](data json.RawMessage, v *[]*T) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if data[0] == '[' {
+		return json.Unmarshal(data, v)
+	}
+	item := new(T)
+	if err := json.Unmarshal(data, item); err != nil {
+		return err
+	}
+	*v = []*T{item}
+	return nil
+}
+
+// GetDepth sends 'depth' request, limit is ignored when not positive
+func (s *WsMarketService) GetDepth(requestID string, symbol string, limit int) error {
+	rawData, err := s.buildDepthRequest(requestID, symbol, limit)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetDepth sends 'depth' request and receives response
+func (s *WsMarketService) SyncGetDepth(requestID string, symbol string, limit int) (*WsDepthResponse, error) {
+	rawData, err := s.buildDepthRequest(requestID, symbol, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	depth := &WsDepthResponse{}
+	if err := json.Unmarshal(response, depth); err != nil {
+		return nil, err
+	}
+
+	return depth, nil
+}
+
+// GetBookTicker sends 'ticker.book' request, the tickers of all the symbols are requested
+// when symbol is empty
+func (s *WsMarketService) GetBookTicker(requestID string, symbol string) error {
+	rawData, err := s.buildTickerRequest(requestID, websocket.TickerBookFuturesWsApiMethod, symbol)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetBookTicker sends 'ticker.book' request and receives response
+func (s *WsMarketService) SyncGetBookTicker(requestID string, symbol string) (*WsBookTickerResponse, error) {
+	rawData, err := s.buildTickerRequest(requestID, websocket.TickerBookFuturesWsApiMethod, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tickers := &WsBookTickerResponse{}
+	if err := json.Unmarshal(response, tickers); err != nil {
+		return nil, err
+	}
+
+	return tickers, nil
+}
+
+// GetPrice sends 'ticker.price' request, the prices of all the symbols are requested
+// when symbol is empty
+func (s *WsMarketService) GetPrice(requestID string, symbol string) error {
+	rawData, err := s.buildTickerRequest(requestID, websocket.TickerPriceFuturesWsApiMethod, symbol)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncGetPrice sends 'ticker.price' request and receives response
+func (s *WsMarketService) SyncGetPrice(requestID string, symbol string) (*WsPriceResponse, error) {
+	rawData, err := s.buildTickerRequest(requestID, websocket.TickerPriceFuturesWsApiMethod, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := &WsPriceResponse{}
+	if err := json.Unmarshal(response, prices); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+func (s *WsMarketService) buildDepthRequest(requestID string, symbol string, limit int) ([]byte, error) {
+	params := map[string]any{
+		"symbol": symbol,
+	}
+	if limit > 0 {
+		params["limit"] = limit
+	}
+	return websocket.CreateUnsignedRequest(requestID, websocket.DepthFuturesWsApiMethod, params)
+}
+
+func (s *WsMarketService) buildTickerRequest(requestID string, method websocket.WsApiMethodType, symbol string) ([]byte, error) {
+	params := map[string]any{}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+	return websocket.CreateUnsignedRequest(requestID, method, params)
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *WsMarketService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *WsMarketService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *WsMarketService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *WsMarketService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
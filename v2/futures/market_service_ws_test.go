@@ -0,0 +1,116 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type marketWsServiceTestSuite struct {
+	suite.Suite
+	mockClient *mock.MockClient
+	mockCtrl   *gomock.Controller
+	service    *WsMarketService
+}
+
+func TestMarketWsService(t *testing.T) {
+	suite.Run(t, new(marketWsServiceTestSuite))
+}
+
+func (s *marketWsServiceTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockClient = mock.NewMockClient(s.mockCtrl)
+	s.service = &WsMarketService{c: s.mockClient}
+}
+
+func (s *marketWsServiceTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *marketWsServiceTestSuite) TestGetDepth() {
+	s.mockClient.EXPECT().Write("depth-1", gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"depth"`)
+		s.Contains(string(data), `"limit":5`)
+		s.NotContains(string(data), `"signature"`)
+		return nil
+	})
+	s.NoError(s.service.GetDepth("depth-1", "BTCUSDT", 5))
+}
+
+func (s *marketWsServiceTestSuite) TestSyncGetDepth() {
+	data := []byte(`{
+  "id": "depth-1",
+  "status": 200,
+  "result": {
+    "lastUpdateId": 1027024,
+    "E": 1589436922972,
+    "T": 1589436922959,
+    "bids": [["4.00000000", "431.00000000"]],
+    "asks": [["4.00000200", "12.00000000"], ["4.00000300", "1.00000000"]]
+  },
+  "rateLimits": [
+    {"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400, "count": 5}
+  ]
+}`)
+	s.mockClient.EXPECT().WriteSync("depth-1", gomock.Any(), gomock.Any()).Return(data, nil)
+
+	response, err := s.service.SyncGetDepth("depth-1", "BTCUSDT", 5)
+	s.Require().NoError(err)
+	s.Equal("depth-1", response.ID)
+	s.Equal(int64(1027024), response.Result.LastUpdateID)
+	s.Equal(int64(1589436922959), response.Result.TradeTime)
+	s.Equal([]Bid{{Price: "4.00000000", Quantity: "431.00000000"}}, response.Result.Bids)
+	s.Len(response.Result.Asks, 2)
+	s.Require().Len(response.RateLimit, 1)
+	s.Equal(5, response.RateLimit[0].Count)
+}
+
+func (s *marketWsServiceTestSuite) TestSyncGetBookTicker() {
+	data := []byte(`{
+  "id": "book-1",
+  "status": 200,
+  "result": {
+    "lastUpdateId": 1027024,
+    "symbol": "BTCUSDT",
+    "bidPrice": "4.00000000",
+    "bidQty": "431.00000000",
+    "askPrice": "4.00000200",
+    "askQty": "9.00000000",
+    "time": 1589437530011
+  }
+}`)
+	s.mockClient.EXPECT().WriteSync("book-1", gomock.Any(), gomock.Any()).DoAndReturn(func(id string, req []byte, _ any) ([]byte, error) {
+		s.Contains(string(req), `"method":"ticker.book"`)
+		s.Contains(string(req), `"symbol":"BTCUSDT"`)
+		return data, nil
+	})
+
+	response, err := s.service.SyncGetBookTicker("book-1", "BTCUSDT")
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("4.00000200", response.Result[0].AskPrice)
+	s.Equal(int64(1027024), response.Result[0].LastUpdateId)
+}
+
+func (s *marketWsServiceTestSuite) TestSyncGetPrice() {
+	data := []byte(`{
+  "id": "price-1",
+  "status": 200,
+  "result": [
+    {"symbol": "BTCUSDT", "price": "6000.01", "time": 1589437530011},
+    {"symbol": "ETHUSDT", "price": "200.01", "time": 1589437530011}
+  ]
+}`)
+	s.mockClient.EXPECT().WriteSync("price-1", gomock.Any(), gomock.Any()).DoAndReturn(func(id string, req []byte, _ any) ([]byte, error) {
+		s.Contains(string(req), `"method":"ticker.price"`)
+		s.NotContains(string(req), `"symbol"`)
+		return data, nil
+	})
+
+	response, err := s.service.SyncGetPrice("price-1", "")
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 2)
+	s.Equal(&SymbolPrice{Symbol: "ETHUSDT", Price: "200.01"}, response.Result[1])
+}
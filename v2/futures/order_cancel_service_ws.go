@@ -81,36 +81,36 @@ type OrderCancelWsService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewOrderCancelWsService init OrderCancelWsService
 func (c *Client) NewOrderCancelWsService() (*OrderCancelWsService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
 
 	return &OrderCancelWsService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
 // Do - sends 'order.cancel' request
 func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		websocket.CancelFuturesWsApiMethod,
@@ -129,22 +129,20 @@ func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest)
 
 // SyncDo - sends 'order.cancel' request and receives response
 func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelRequest) (*OrderCancelWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		websocket.CancelFuturesWsApiMethod,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.CancelFuturesWsApiMethod,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
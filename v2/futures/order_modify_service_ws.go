@@ -0,0 +1,214 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderModifyWsService modify order
+type OrderModifyWsService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewOrderModifyWsService init OrderModifyWsService
+func (c *Client) NewOrderModifyWsService() (*OrderModifyWsService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderModifyWsService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// OrderModifyWsRequest parameters for 'order.modify' websocket API
+type OrderModifyWsRequest struct {
+	symbol            string
+	side              SideType
+	quantity          string
+	orderID           *int64
+	origClientOrderID *string
+	price             *string
+	priceMatch        *PriceMatchType
+	recvWindow        *int64
+}
+
+// NewOrderModifyWsRequest init OrderModifyWsRequest
+func NewOrderModifyWsRequest() *OrderModifyWsRequest {
+	return &OrderModifyWsRequest{}
+}
+
+// Symbol set symbol
+func (s *OrderModifyWsRequest) Symbol(symbol string) *OrderModifyWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *OrderModifyWsRequest) Side(side SideType) *OrderModifyWsRequest {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *OrderModifyWsRequest) Quantity(quantity string) *OrderModifyWsRequest {
+	s.quantity = quantity
+	return s
+}
+
+// OrderID will prevail over OrigClientOrderID
+func (s *OrderModifyWsRequest) OrderID(orderID int64) *OrderModifyWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *OrderModifyWsRequest) OrigClientOrderID(origClientOrderID string) *OrderModifyWsRequest {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Price set price, it can't be sent with PriceMatch
+func (s *OrderModifyWsRequest) Price(price string) *OrderModifyWsRequest {
+	s.price = &price
+	return s
+}
+
+// PriceMatch set priceMatch, it can't be sent with Price
+func (s *OrderModifyWsRequest) PriceMatch(priceMatch PriceMatchType) *OrderModifyWsRequest {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderModifyWsRequest) RecvWindow(recvWindow int64) *OrderModifyWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+func (s *OrderModifyWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OrderModifyWsRequest) buildParams() params {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// OrderModifyWsResponse define 'order.modify' websocket API response
+type OrderModifyWsResponse struct {
+	Id     string              `json:"id"`
+	Status int                 `json:"status"`
+	Result ModifyOrderResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// Do - sends 'order.modify' request
+func (s *OrderModifyWsService) Do(requestID string, request *OrderModifyWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.OrderModifyFuturesWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'order.modify' request and receives response
+func (s *OrderModifyWsService) SyncDo(requestID string, request *OrderModifyWsRequest) (*OrderModifyWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderModifyFuturesWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	modifyOrderWsResponse := &OrderModifyWsResponse{}
+	if err := json.Unmarshal(response, modifyOrderWsResponse); err != nil {
+		return nil, err
+	}
+
+	return modifyOrderWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OrderModifyWsService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OrderModifyWsService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OrderModifyWsService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OrderModifyWsService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
@@ -15,25 +15,24 @@ type OrderPlaceWsService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewOrderPlaceWsService init OrderPlaceWsService
-func (c *Client)NewOrderPlaceWsService() (*OrderPlaceWsService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+func (c *Client) NewOrderPlaceWsService() (*OrderPlaceWsService, error) {
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
 
 	return &OrderPlaceWsService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -239,12 +238,13 @@ func (s *OrderPlaceWsRequest) buildParams() params {
 
 // Do - sends 'order.place' request
 func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		websocket.OrderPlaceFuturesWsApiMethod,
@@ -263,22 +263,20 @@ func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest)
 
 // SyncDo - sends 'order.place' request and receives response
 func (s *OrderPlaceWsService) SyncDo(requestID string, request *OrderPlaceWsRequest) (*CreateOrderWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		websocket.OrderPlaceFuturesWsApiMethod,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderPlaceFuturesWsApiMethod,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -15,25 +15,24 @@ type OrderStatusWsService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewOrderStatusWsService init OrderStatusWsService
 func (c *Client) NewOrderStatusWsService() (*OrderStatusWsService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
 
 	return &OrderStatusWsService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -129,12 +128,13 @@ func (s *OrderStatusWsRequest) buildParams() params {
 
 // Do - sends 'order.status' request
 func (s *OrderStatusWsService) Do(requestID string, request *OrderStatusWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		websocket.OrderStatusFuturesWsApiMethod,
@@ -153,22 +153,20 @@ func (s *OrderStatusWsService) Do(requestID string, request *OrderStatusWsReques
 
 // SyncDo - sends 'order.status' request and receives response
 func (s *OrderStatusWsService) SyncDo(requestID string, request *OrderStatusWsRequest) (*QueryOrderWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		websocket.OrderStatusFuturesWsApiMethod,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderStatusFuturesWsApiMethod,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
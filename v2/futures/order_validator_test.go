@@ -0,0 +1,96 @@
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func newTestOrderValidator(t *testing.T) *OrderValidator {
+	data := []byte(`{
+		"symbols": [{
+			"symbol": "BTCUSDT",
+			"status": "TRADING",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+				{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+				{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+				{"filterType": "MIN_NOTIONAL", "notional": "100"},
+				{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+			]
+		}]
+	}`)
+	info := new(ExchangeInfo)
+	require.NoError(t, json.Unmarshal(data, info))
+	return NewOrderValidator(info)
+}
+
+func TestOrderValidator(t *testing.T) {
+	v := newTestOrderValidator(t)
+	o := &OrderParams{
+		Symbol:   "BTCUSDT",
+		Side:     SideTypeBuy,
+		Type:     OrderTypeLimit,
+		Quantity: "0.0129",
+		Price:    "60000.04",
+	}
+	assert.NoError(t, v.Validate(o))
+	assert.Equal(t, "0.012", o.Quantity)
+	assert.Equal(t, "60000", o.Price)
+
+	// MIN_NOTIONAL, reduce only orders are exempted
+	o = &OrderParams{Symbol: "BTCUSDT", Side: SideTypeBuy, Type: OrderTypeLimit, Quantity: "0.001", Price: "60000"}
+	assert.EqualError(t, v.Validate(o), "order violates symbol filters: MIN_NOTIONAL: notional 60 is below minimum 100")
+	o.ReduceOnly = true
+	assert.NoError(t, v.Validate(o))
+
+	// market orders use MARKET_LOT_SIZE and the mark price
+	o = &OrderParams{Symbol: "BTCUSDT", Side: SideTypeSell, Type: OrderTypeMarket, Quantity: "130"}
+	err := v.Validate(o)
+	assert.True(t, err.(common.FilterViolations).Has(string(SymbolFilterTypeMarketLotSize)))
+	o.Quantity = "0.001"
+	assert.NoError(t, v.Validate(o))
+	v.HandleMarkPrice(&WsMarkPriceEvent{Symbol: "BTCUSDT", MarkPrice: "60000"})
+	err = v.Validate(o)
+	assert.True(t, err.(common.FilterViolations).Has(string(SymbolFilterTypeMinNotional)))
+
+	// PERCENT_PRICE bounds the buy price above and the sell price below the mark price
+	o = &OrderParams{Symbol: "BTCUSDT", Side: SideTypeBuy, Type: OrderTypeLimit, Quantity: "1", Price: "63000.1"}
+	assert.EqualError(t, v.Validate(o), "order violates symbol filters: PERCENT_PRICE: price 63000.1 is above maximum 63000")
+	o.Price = "50000"
+	assert.NoError(t, v.Validate(o))
+	o.Side = SideTypeSell
+	assert.EqualError(t, v.Validate(o), "order violates symbol filters: PERCENT_PRICE: price 50000 is below minimum 57000")
+
+	assert.Error(t, v.Validate(&OrderParams{Symbol: "ETHUSDT"}))
+}
+
+func TestOrderValidatorServices(t *testing.T) {
+	v := newTestOrderValidator(t)
+	s := (&CreateOrderService{}).Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		Quantity("0.0129").Price("60000.04").StopPrice("59000.06")
+	assert.NoError(t, v.ValidateCreateOrder(s))
+	assert.Equal(t, "0.012", s.quantity)
+	assert.Equal(t, "60000", *s.price)
+	assert.Equal(t, "59000.1", *s.stopPrice)
+	assert.Nil(t, s.activationPrice)
+
+	// the quantity of close position orders is not checked
+	s = (&CreateOrderService{}).Symbol("BTCUSDT").Side(SideTypeSell).Type("STOP_MARKET").
+		StopPrice("59000").ClosePosition(true)
+	assert.NoError(t, v.ValidateCreateOrder(s))
+
+	r := NewOrderPlaceWsRequest().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+		Quantity("0.0001").Price("60000")
+	err := v.ValidateOrderPlaceWsRequest(r)
+	assert.True(t, err.(common.FilterViolations).Has(string(SymbolFilterTypeLotSize)))
+	assert.Equal(t, "0.0001", r.quantity)
+	r.ReduceOnly(true).Quantity("0.0016")
+	assert.NoError(t, v.ValidateOrderPlaceWsRequest(r))
+	assert.Equal(t, "0.001", r.quantity)
+}
@@ -0,0 +1,837 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
+)
+
+var (
+	// PortfolioStateSnapshotRetryInterval is the delay before loading the portfolio snapshot again after a failure
+	PortfolioStateSnapshotRetryInterval = time.Second
+	// PortfolioStateMaxBufferedEvents is the maximum number of user data events buffered while loading a snapshot
+	PortfolioStateMaxBufferedEvents = 10000
+)
+
+// PortfolioBalance define a wallet balance of the portfolio state
+type PortfolioBalance struct {
+	Asset              string
+	WalletBalance      string
+	CrossWalletBalance string
+	UpdateTime         int64
+}
+
+// PortfolioPosition define a position of the portfolio state. UnrealizedPnL and
+// LiquidationPrice are estimated from MarkPrice when mark prices are fed to the state.
+type PortfolioPosition struct {
+	Symbol           string
+	PositionSide     PositionSideType
+	Amount           string
+	EntryPrice       string
+	MarkPrice        string
+	UnrealizedPnL    string
+	LiquidationPrice string
+	MarginType       MarginType
+	MarginAsset      string
+	IsolatedWallet   string
+	Leverage         int
+	MaintMargin      string
+	UpdateTime       int64
+}
+
+// PortfolioSummary define the margin of the positions of one margin asset
+type PortfolioSummary struct {
+	Asset              string
+	WalletBalance      string
+	CrossWalletBalance string
+	// CrossUnrealizedPnL is the unrealized PnL of the cross positions
+	CrossUnrealizedPnL string
+	// CrossMarginBalance is CrossWalletBalance plus CrossUnrealizedPnL
+	CrossMarginBalance string
+	// IsolatedMargin is the isolated wallets plus the unrealized PnL of the isolated positions
+	IsolatedMargin string
+	// UnrealizedPnL is the unrealized PnL of all the positions
+	UnrealizedPnL string
+}
+
+// PortfolioStateChangeType define the type of a portfolio state change
+type PortfolioStateChangeType string
+
+const (
+	PortfolioStateChangeTypeSnapshot PortfolioStateChangeType = "SNAPSHOT"
+	PortfolioStateChangeTypeBalance  PortfolioStateChangeType = "BALANCE"
+	PortfolioStateChangeTypePosition PortfolioStateChangeType = "POSITION"
+	PortfolioStateChangeTypeOrder    PortfolioStateChangeType = "ORDER"
+)
+
+// PortfolioStateChange define a change of the portfolio state, the field matching Type is set.
+// An order which is not open anymore is removed from the state after the change.
+type PortfolioStateChange struct {
+	Type     PortfolioStateChangeType
+	Balance  *PortfolioBalance
+	Position *PortfolioPosition
+	Order    *Order
+}
+
+// PortfolioStateHandler handle portfolio state changes
+type PortfolioStateHandler func(change *PortfolioStateChange)
+
+// PortfolioStateServeFunc open the user data stream feeding a PortfolioState
+type PortfolioStateServeFunc func(handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error)
+
+type portfolioPositionKey struct {
+	symbol       string
+	positionSide PositionSideType
+}
+
+type portfolioSymbolConfig struct {
+	leverage   int
+	marginType MarginType
+}
+
+// portfolioPosition is a position with its maintenance margin rate, estimated from the
+// maintenance margin and notional known for the position
+type portfolioPosition struct {
+	PortfolioPosition
+	maintMarginRate decimal.Decimal
+}
+
+// PortfolioState maintains the wallet balances, positions, symbol configurations and open orders
+// of the USD-M futures account from a REST snapshot and the user data stream, without polling.
+// Feed it mark prices with HandleMarkPrice or HandleAllMarkPrice to update the unrealized PnL and
+// the liquidation price estimates of the positions.
+type PortfolioState struct {
+	c          *Client
+	serve      PortfolioStateServeFunc
+	errHandler ErrHandler
+
+	mu          sync.RWMutex
+	synced      int32
+	buffer      []*WsUserDataEvent
+	balances    map[string]*PortfolioBalance
+	positions   map[portfolioPositionKey]*portfolioPosition
+	configs     map[string]portfolioSymbolConfig
+	orders      map[int64]*Order
+	markPrices  map[string]decimal.Decimal
+	subscribers map[int]PortfolioStateHandler
+	nextSubID   int
+	changes     []*PortfolioStateChange
+	pending     chan struct{}
+
+	// dispatchMu keep the changes in order once mu is released
+	dispatchMu sync.Mutex
+
+	stopOnce sync.Once
+	cancel   context.CancelFunc
+	doneC    chan struct{}
+	stopC    chan struct{}
+}
+
+// NewPortfolioState init the state of the USD-M futures account, call Start to begin syncing
+func (c *Client) NewPortfolioState() *PortfolioState {
+	s := &PortfolioState{
+		c:           c,
+		balances:    make(map[string]*PortfolioBalance),
+		positions:   make(map[portfolioPositionKey]*portfolioPosition),
+		configs:     make(map[string]portfolioSymbolConfig),
+		orders:      make(map[int64]*Order),
+		markPrices:  make(map[string]decimal.Decimal),
+		subscribers: make(map[int]PortfolioStateHandler),
+		pending:     make(chan struct{}, 1),
+	}
+	s.serve = s.serveUserDataStream
+	return s
+}
+
+// Stream set the user data stream feeding the state, a UserDataStream managing its listen key by default
+func (s *PortfolioState) Stream(serve PortfolioStateServeFunc) *PortfolioState {
+	s.serve = serve
+	return s
+}
+
+// OnError set the handler for stream and snapshot errors
+func (s *PortfolioState) OnError(errHandler ErrHandler) *PortfolioState {
+	s.errHandler = errHandler
+	return s
+}
+
+// Subscribe add a handler called after every change of the state, call unsubscribe to remove it.
+// The handlers are called one at a time, they may read the state.
+func (s *PortfolioState) Subscribe(handler PortfolioStateHandler) (unsubscribe func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.nextSubID
+	s.nextSubID++
+	s.subscribers[id] = handler
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers, id)
+	}
+}
+
+// Start open the user data stream and load the first snapshot
+func (s *PortfolioState) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	doneC, stopC, err := s.serve(s.onEvent, s.onStreamError)
+	if err != nil {
+		cancel()
+		return err
+	}
+	s.cancel = cancel
+	s.doneC = doneC
+	s.stopC = stopC
+	s.Resync()
+	go s.snapshotLoop(ctx)
+	go func() {
+		<-doneC
+		cancel()
+	}()
+	return nil
+}
+
+// Stop close the user data stream, it is safe to call Stop more than once
+func (s *PortfolioState) Stop() {
+	s.stopOnce.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+		}
+		if s.stopC != nil {
+			close(s.stopC)
+		}
+	})
+}
+
+// Done return a channel closed when the user data stream has terminated
+func (s *PortfolioState) Done() <-chan struct{} {
+	return s.doneC
+}
+
+// Resync load the state from the REST API again, the events received meanwhile are buffered
+func (s *PortfolioState) Resync() {
+	// taken with mu so an event being applied is not dropped from the buffer
+	s.mu.Lock()
+	s.setSynced(false)
+	s.mu.Unlock()
+	select {
+	case s.pending <- struct{}{}:
+	default:
+	}
+}
+
+// IsSynced return true when the state has been loaded from a snapshot and is not resyncing
+func (s *PortfolioState) IsSynced() bool {
+	return atomic.LoadInt32(&s.synced) == 1
+}
+
+func (s *PortfolioState) setSynced(synced bool) {
+	var v int32
+	if synced {
+		v = 1
+	}
+	atomic.StoreInt32(&s.synced, v)
+}
+
+// serveUserDataStream is the default stream, the state is resynced when events may have been missed
+func (s *PortfolioState) serveUserDataStream(handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	stream := s.c.NewUserDataStream(handler, errHandler, commonws.WithResyncHandler(func(reason error) {
+		s.Resync()
+	}))
+	if err := stream.Start(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		select {
+		case <-stopC:
+			stream.Stop()
+		case <-stream.Done():
+		}
+		close(doneC)
+	}()
+	return doneC, stopC, nil
+}
+
+// Balance return the wallet balance of asset
+func (s *PortfolioState) Balance(asset string) (PortfolioBalance, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.balances[asset]
+	if !ok {
+		return PortfolioBalance{}, false
+	}
+	return *b, true
+}
+
+// Balances return the wallet balances sorted by asset
+func (s *PortfolioState) Balances() []PortfolioBalance {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]PortfolioBalance, 0, len(s.balances))
+	for _, b := range s.balances {
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Asset < res[j].Asset })
+	return res
+}
+
+// Position return the position of symbol on positionSide, PositionSideTypeBoth in one-way mode
+func (s *PortfolioState) Position(symbol string, positionSide PositionSideType) (PortfolioPosition, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.positions[portfolioPositionKey{symbol: symbol, positionSide: positionSide}]
+	if !ok {
+		return PortfolioPosition{}, false
+	}
+	return s.position(p), true
+}
+
+// Positions return the open positions sorted by symbol and position side
+func (s *PortfolioState) Positions() []PortfolioPosition {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]PortfolioPosition, 0, len(s.positions))
+	for _, p := range s.positions {
+		if amount, err := decimal.NewFromString(p.Amount); err == nil && amount.IsZero() {
+			continue
+		}
+		res = append(res, s.position(p))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Symbol != res[j].Symbol {
+			return res[i].Symbol < res[j].Symbol
+		}
+		return res[i].PositionSide < res[j].PositionSide
+	})
+	return res
+}
+
+// position return a copy of p with the configuration of its symbol, must be called with mu held
+func (s *PortfolioState) position(p *portfolioPosition) PortfolioPosition {
+	res := p.PortfolioPosition
+	if cfg, ok := s.configs[p.Symbol]; ok {
+		res.Leverage = cfg.leverage
+		if res.MarginType == "" {
+			res.MarginType = cfg.marginType
+		}
+	}
+	return res
+}
+
+// Leverage return the leverage of symbol
+func (s *PortfolioState) Leverage(symbol string) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cfg, ok := s.configs[symbol]
+	return cfg.leverage, ok
+}
+
+// MarginType return the margin type of symbol
+func (s *PortfolioState) MarginType(symbol string) (MarginType, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cfg, ok := s.configs[symbol]
+	return cfg.marginType, ok
+}
+
+// OpenOrder return the open order with orderID
+func (s *PortfolioState) OpenOrder(orderID int64) (*Order, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	o, ok := s.orders[orderID]
+	if !ok {
+		return nil, false
+	}
+	order := *o
+	return &order, true
+}
+
+// OpenOrders return the open orders of symbol sorted by order id, of all the symbols if symbol is empty
+func (s *PortfolioState) OpenOrders(symbol string) []*Order {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]*Order, 0, len(s.orders))
+	for _, o := range s.orders {
+		if symbol != "" && o.Symbol != symbol {
+			continue
+		}
+		order := *o
+		res = append(res, &order)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].OrderID < res[j].OrderID })
+	return res
+}
+
+// Summary return the margin of the positions whose margin asset is asset
+func (s *PortfolioState) Summary(asset string) PortfolioSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := PortfolioSummary{Asset: asset, WalletBalance: "0", CrossWalletBalance: "0"}
+	crossWallet := decimal.Zero
+	if b, ok := s.balances[asset]; ok {
+		res.WalletBalance = b.WalletBalance
+		res.CrossWalletBalance = b.CrossWalletBalance
+		crossWallet = decimalOrZero(b.CrossWalletBalance)
+	}
+	crossPnL, isolatedMargin, pnl := decimal.Zero, decimal.Zero, decimal.Zero
+	for _, p := range s.positions {
+		if p.MarginAsset != asset {
+			continue
+		}
+		upnl := decimalOrZero(p.UnrealizedPnL)
+		pnl = pnl.Add(upnl)
+		if s.position(p).MarginType == MarginTypeIsolated {
+			isolatedMargin = isolatedMargin.Add(decimalOrZero(p.IsolatedWallet)).Add(upnl)
+		} else {
+			crossPnL = crossPnL.Add(upnl)
+		}
+	}
+	res.CrossUnrealizedPnL = crossPnL.String()
+	res.CrossMarginBalance = crossWallet.Add(crossPnL).String()
+	res.IsolatedMargin = isolatedMargin.String()
+	res.UnrealizedPnL = pnl.String()
+	return res
+}
+
+// HandleMarkPrice update the unrealized PnL and the liquidation price estimates with a mark
+// price, it can be passed to WsMarkPriceServe
+func (s *PortfolioState) HandleMarkPrice(event *WsMarkPriceEvent) {
+	s.mu.Lock()
+	defer s.unlock()
+	s.applyMarkPrice(event)
+	s.updateEstimates(event.Symbol)
+	s.notifyPositions(event.Symbol)
+}
+
+// HandleAllMarkPrice update the unrealized PnL and the liquidation price estimates with the mark
+// prices of all the symbols, it can be passed to WsAllMarkPriceServe
+func (s *PortfolioState) HandleAllMarkPrice(event WsAllMarkPriceEvent) {
+	s.mu.Lock()
+	defer s.unlock()
+	for _, e := range event {
+		s.applyMarkPrice(e)
+	}
+	s.updateEstimates("")
+	s.notifyPositions("")
+}
+
+// notifyPositions queue a change for the open positions of symbol, of all the symbols if
+// symbol is empty, must be called with mu held
+func (s *PortfolioState) notifyPositions(symbol string) {
+	for key, p := range s.positions {
+		if symbol != "" && key.symbol != symbol {
+			continue
+		}
+		if decimalOrZero(p.Amount).IsZero() {
+			continue
+		}
+		position := s.position(p)
+		s.notify(&PortfolioStateChange{Type: PortfolioStateChangeTypePosition, Position: &position})
+	}
+}
+
+// applyMarkPrice must be called with mu held
+func (s *PortfolioState) applyMarkPrice(event *WsMarkPriceEvent) {
+	price, err := decimal.NewFromString(event.MarkPrice)
+	if err != nil {
+		return
+	}
+	s.markPrices[event.Symbol] = price
+}
+
+// onStreamError resync the state when the user data stream reconnects, the events sent
+// while it was disconnected are lost
+func (s *PortfolioState) onStreamError(err error) {
+	var reconnected *commonws.ReconnectedEvent
+	if errors.As(err, &reconnected) {
+		s.Resync()
+	}
+	s.onError(err)
+}
+
+func (s *PortfolioState) onError(err error) {
+	if s.errHandler != nil {
+		s.errHandler(err)
+	}
+}
+
+// notify queue a change for the subscribers, must be called with mu held
+func (s *PortfolioState) notify(change *PortfolioStateChange) {
+	s.changes = append(s.changes, change)
+}
+
+// unlock release mu and call the subscribers with the queued changes
+func (s *PortfolioState) unlock() {
+	changes := s.changes
+	s.changes = nil
+	handlers := make([]PortfolioStateHandler, 0, len(s.subscribers))
+	for _, handler := range s.subscribers {
+		handlers = append(handlers, handler)
+	}
+	s.dispatchMu.Lock()
+	defer s.dispatchMu.Unlock()
+	s.mu.Unlock()
+	for _, change := range changes {
+		for _, handler := range handlers {
+			handler(change)
+		}
+	}
+}
+
+func (s *PortfolioState) onEvent(event *WsUserDataEvent) {
+	s.mu.Lock()
+	defer s.unlock()
+	if !s.IsSynced() {
+		if len(s.buffer) >= PortfolioStateMaxBufferedEvents {
+			s.buffer = s.buffer[1:]
+		}
+		s.buffer = append(s.buffer, event)
+		return
+	}
+	s.applyEvent(event)
+}
+
+// applyEvent apply a user data event to the synced state, must be called with mu held.
+// TRADE_LITE events are not applied, the fills are followed by an ORDER_TRADE_UPDATE.
+func (s *PortfolioState) applyEvent(event *WsUserDataEvent) {
+	switch event.Event {
+	case UserDataEventTypeAccountUpdate:
+		s.applyAccountUpdate(event.TransactionTime, &event.AccountUpdate)
+	case UserDataEventTypeOrderTradeUpdate:
+		s.applyOrderTradeUpdate(&event.OrderTradeUpdate)
+	case UserDataEventTypeAccountConfigUpdate:
+		s.applyAccountConfigUpdate(&event.AccountConfigUpdate)
+	}
+}
+
+func (s *PortfolioState) applyAccountUpdate(transactionTime int64, update *WsAccountUpdate) {
+	for _, u := range update.Balances {
+		b, ok := s.balances[u.Asset]
+		if ok && b.UpdateTime > transactionTime {
+			continue
+		}
+		if !ok {
+			b = &PortfolioBalance{Asset: u.Asset}
+			s.balances[u.Asset] = b
+		}
+		b.WalletBalance = u.Balance
+		b.CrossWalletBalance = u.CrossWalletBalance
+		b.UpdateTime = transactionTime
+		balance := *b
+		s.notify(&PortfolioStateChange{Type: PortfolioStateChangeTypeBalance, Balance: &balance})
+	}
+	updated := make(map[string]bool)
+	for _, u := range update.Positions {
+		key := portfolioPositionKey{symbol: u.Symbol, positionSide: u.Side}
+		p, ok := s.positions[key]
+		if ok && p.UpdateTime > transactionTime {
+			continue
+		}
+		if !ok {
+			p = &portfolioPosition{PortfolioPosition: PortfolioPosition{
+				Symbol:       u.Symbol,
+				PositionSide: u.Side,
+				MarginAsset:  s.marginAsset(u.Symbol),
+			}}
+			s.positions[key] = p
+		}
+		p.Amount = u.Amount
+		p.EntryPrice = u.EntryPrice
+		p.UnrealizedPnL = u.UnrealizedPnL
+		p.IsolatedWallet = u.IsolatedWallet
+		p.MarginType = wsMarginType(u.MarginType)
+		if u.MarkPrice != "" {
+			p.MarkPrice = u.MarkPrice
+		}
+		if u.MaintenanceMarginRequired != "" {
+			p.MaintMargin = u.MaintenanceMarginRequired
+			p.maintMarginRate = maintMarginRate(p.MaintMargin, p.Amount, p.MarkPrice, p.maintMarginRate)
+		}
+		p.UpdateTime = transactionTime
+		updated[u.Symbol] = true
+	}
+	if len(updated) == 0 {
+		return
+	}
+	// a cross position changes the liquidation price of the other cross positions
+	s.updateEstimates("")
+	for key, p := range s.positions {
+		if !updated[key.symbol] {
+			continue
+		}
+		position := s.position(p)
+		s.notify(&PortfolioStateChange{Type: PortfolioStateChangeTypePosition, Position: &position})
+	}
+}
+
+func (s *PortfolioState) applyOrderTradeUpdate(update *WsOrderTradeUpdate) {
+	o, ok := s.orders[update.ID]
+	if ok && o.UpdateTime > update.TradeTime {
+		return
+	}
+	if !ok {
+		o = &Order{
+			Symbol:  update.Symbol,
+			OrderID: update.ID,
+		}
+	}
+	o.ClientOrderID = update.ClientOrderID
+	o.Price = update.OriginalPrice
+	o.ReduceOnly = update.IsReduceOnly
+	o.OrigQuantity = update.OriginalQty
+	o.ExecutedQuantity = update.AccumulatedFilledQty
+	o.Status = update.Status
+	o.TimeInForce = update.TimeInForce
+	o.Type = update.Type
+	o.Side = update.Side
+	o.StopPrice = update.StopPrice
+	o.UpdateTime = update.TradeTime
+	o.WorkingType = update.WorkingType
+	o.ActivatePrice = update.ActivationPrice
+	o.PriceRate = update.CallbackRate
+	o.AvgPrice = update.AveragePrice
+	o.OrigType = update.OriginalType
+	o.PositionSide = update.PositionSide
+	o.PriceProtect = update.PriceProtect
+	o.ClosePosition = update.IsClosingPosition
+	o.PriceMatch = update.PriceMode
+	o.SelfTradePreventionMode = update.STP
+	o.GoodTillDate = update.GTD
+	if o.Status == OrderStatusTypeNew || o.Status == OrderStatusTypePartiallyFilled {
+		s.orders[update.ID] = o
+	} else {
+		delete(s.orders, update.ID)
+	}
+	order := *o
+	s.notify(&PortfolioStateChange{Type: PortfolioStateChangeTypeOrder, Order: &order})
+}
+
+func (s *PortfolioState) applyAccountConfigUpdate(update *WsAccountConfigUpdate) {
+	if update.Symbol == "" {
+		// multi-assets mode change
+		return
+	}
+	cfg := s.configs[update.Symbol]
+	cfg.leverage = int(update.Leverage)
+	s.configs[update.Symbol] = cfg
+	for key, p := range s.positions {
+		if key.symbol != update.Symbol {
+			continue
+		}
+		position := s.position(p)
+		s.notify(&PortfolioStateChange{Type: PortfolioStateChangeTypePosition, Position: &position})
+	}
+}
+
+// marginAsset return the margin asset of the known positions of symbol, must be called with mu held
+func (s *PortfolioState) marginAsset(symbol string) string {
+	for key, p := range s.positions {
+		if key.symbol == symbol && p.MarginAsset != "" {
+			return p.MarginAsset
+		}
+	}
+	return "USDT"
+}
+
+// updateEstimates recompute the unrealized PnL and the liquidation price of the positions
+// from the mark prices, of the positions of symbol only if it is not empty. The liquidation
+// price of a cross position depends on the other cross positions of its margin asset, all
+// of them are updated. Must be called with mu held.
+//
+// The estimates solve margin balance = maintenance margin at the liquidation price with the
+// maintenance margin rate of the position, ignoring the maintenance amount of the bracket
+// and the multi-assets mode.
+func (s *PortfolioState) updateEstimates(symbol string) {
+	crossAssets := make(map[string]bool)
+	for key, p := range s.positions {
+		if symbol != "" && key.symbol != symbol {
+			continue
+		}
+		mark, ok := s.markPrices[key.symbol]
+		if !ok {
+			continue
+		}
+		p.MarkPrice = mark.String()
+		amount := decimalOrZero(p.Amount)
+		p.UnrealizedPnL = amount.Mul(mark.Sub(decimalOrZero(p.EntryPrice))).String()
+		if s.position(p).MarginType == MarginTypeIsolated {
+			p.LiquidationPrice = liquidationPrice(amount, decimalOrZero(p.EntryPrice), decimalOrZero(p.IsolatedWallet), p.maintMarginRate)
+			continue
+		}
+		crossAssets[p.MarginAsset] = true
+	}
+	if len(crossAssets) == 0 {
+		return
+	}
+	for _, p := range s.positions {
+		if !crossAssets[p.MarginAsset] || s.position(p).MarginType == MarginTypeIsolated {
+			continue
+		}
+		// the margin available to p is the cross wallet plus the equity of the other cross positions
+		margin := decimal.Zero
+		if b, ok := s.balances[p.MarginAsset]; ok {
+			margin = decimalOrZero(b.CrossWalletBalance)
+		}
+		for _, other := range s.positions {
+			if other == p || other.MarginAsset != p.MarginAsset || s.position(other).MarginType == MarginTypeIsolated {
+				continue
+			}
+			margin = margin.Add(decimalOrZero(other.UnrealizedPnL)).Sub(decimalOrZero(other.MaintMargin))
+		}
+		p.LiquidationPrice = liquidationPrice(decimalOrZero(p.Amount), decimalOrZero(p.EntryPrice), margin, p.maintMarginRate)
+	}
+}
+
+// liquidationPrice solve margin + amount * (price - entryPrice) = |amount| * price * rate,
+// it returns "0" when the position can not be liquidated and "" when rate is unknown
+func liquidationPrice(amount, entryPrice, margin, rate decimal.Decimal) string {
+	if amount.IsZero() {
+		return "0"
+	}
+	if rate.IsZero() {
+		return ""
+	}
+	denominator := amount.Sub(amount.Abs().Mul(rate))
+	if denominator.IsZero() {
+		return "0"
+	}
+	price := amount.Mul(entryPrice).Sub(margin).DivRound(denominator, 8)
+	if !price.IsPositive() {
+		return "0"
+	}
+	return price.String()
+}
+
+// maintMarginRate return the maintenance margin rate of a position, or rate when it can not be computed
+func maintMarginRate(maintMargin, amount, price string, rate decimal.Decimal) decimal.Decimal {
+	notional := decimalOrZero(amount).Mul(decimalOrZero(price)).Abs()
+	if notional.IsZero() {
+		return rate
+	}
+	return decimalOrZero(maintMargin).DivRound(notional, 8)
+}
+
+// wsMarginType convert the margin type of ACCOUNT_UPDATE, which is 'isolated' or 'cross'
+func wsMarginType(marginType MarginType) MarginType {
+	if strings.EqualFold(string(marginType), "isolated") {
+		return MarginTypeIsolated
+	}
+	return MarginTypeCrossed
+}
+
+func decimalOrZero(v string) decimal.Decimal {
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
+
+func (s *PortfolioState) snapshotLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.pending:
+		}
+		for {
+			err := s.loadSnapshot(ctx)
+			if err == nil {
+				break
+			}
+			s.onError(err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(PortfolioStateSnapshotRetryInterval):
+			}
+		}
+	}
+}
+
+// loadSnapshot replace the state with a REST snapshot and replay the buffered events on top of it
+func (s *PortfolioState) loadSnapshot(ctx context.Context) error {
+	account, err := s.c.NewGetAccountV3Service().Do(ctx)
+	if err != nil {
+		return err
+	}
+	positions, err := s.c.NewGetPositionRiskV3Service().Do(ctx)
+	if err != nil {
+		return err
+	}
+	configs, err := s.c.NewGetSymbolConfigService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	orders, err := s.c.NewListOpenOrdersService().Do(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.unlock()
+	if s.IsSynced() {
+		return nil
+	}
+	s.balances = make(map[string]*PortfolioBalance, len(account.Assets))
+	for _, a := range account.Assets {
+		s.balances[a.Asset] = &PortfolioBalance{
+			Asset:              a.Asset,
+			WalletBalance:      a.WalletBalance,
+			CrossWalletBalance: a.CrossWalletBalance,
+			UpdateTime:         a.UpdateTime,
+		}
+	}
+	s.configs = make(map[string]portfolioSymbolConfig, len(configs))
+	for _, cfg := range configs {
+		s.configs[cfg.Symbol] = portfolioSymbolConfig{leverage: cfg.Leverage, marginType: MarginType(cfg.MarginType)}
+	}
+	s.positions = make(map[portfolioPositionKey]*portfolioPosition, len(positions))
+	for _, r := range positions {
+		key := portfolioPositionKey{symbol: r.Symbol, positionSide: PositionSideType(r.PositionSide)}
+		p := &portfolioPosition{PortfolioPosition: PortfolioPosition{
+			Symbol:           r.Symbol,
+			PositionSide:     key.positionSide,
+			Amount:           r.PositionAmt,
+			EntryPrice:       r.EntryPrice,
+			MarkPrice:        r.MarkPrice,
+			UnrealizedPnL:    r.UnRealizedProfit,
+			LiquidationPrice: r.LiquidationPrice,
+			MarginType:       s.configs[r.Symbol].marginType,
+			MarginAsset:      r.MarginAsset,
+			IsolatedWallet:   r.IsolatedWallet,
+			MaintMargin:      r.MaintMargin,
+			UpdateTime:       r.UpdateTime,
+		}}
+		if p.MarginAsset == "" {
+			p.MarginAsset = "USDT"
+		}
+		p.maintMarginRate = maintMarginRate(r.MaintMargin, r.PositionAmt, r.MarkPrice, decimal.Zero)
+		s.positions[key] = p
+	}
+	s.orders = make(map[int64]*Order, len(orders))
+	for _, o := range orders {
+		s.orders[o.OrderID] = o
+	}
+	s.setSynced(true)
+	s.notify(&PortfolioStateChange{Type: PortfolioStateChangeTypeSnapshot})
+
+	buffer := s.buffer
+	s.buffer = nil
+	for _, event := range buffer {
+		s.applyEvent(event)
+	}
+	if len(s.markPrices) > 0 {
+		s.updateEstimates("")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package futures
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type portfolioStateTestSuite struct {
+	baseTestSuite
+	handler WsUserDataHandler
+}
+
+func TestPortfolioState(t *testing.T) {
+	suite.Run(t, new(portfolioStateTestSuite))
+}
+
+func (s *portfolioStateTestSuite) serve(handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	s.handler = handler
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		<-stopC
+		close(doneC)
+	}()
+	return doneC, stopC, nil
+}
+
+func (s *portfolioStateTestSuite) startState() *PortfolioState {
+	s.client.Client.do = s.client.do
+	for _, data := range []string{
+		`{"assets":[{"asset":"USDT","walletBalance":"6500.00000000","crossWalletBalance":"5000.00000000","updateTime":1000}],"positions":[]}`,
+		`[
+			{"symbol":"BTCUSDT","positionSide":"BOTH","positionAmt":"1.000","entryPrice":"30000.0","markPrice":"30000.00000000","unRealizedProfit":"0.00000000","liquidationPrice":"28600.00","isolatedMargin":"1500.00000000","notional":"30000.00000000","marginAsset":"USDT","isolatedWallet":"1500.00000000","maintMargin":"120.00000000","updateTime":1000},
+			{"symbol":"ETHUSDT","positionSide":"BOTH","positionAmt":"-10.000","entryPrice":"2000.0","markPrice":"2000.00000000","unRealizedProfit":"0.00000000","liquidationPrice":"2480.00","isolatedMargin":"0","notional":"-20000.00000000","marginAsset":"USDT","isolatedWallet":"0","maintMargin":"100.00000000","updateTime":1000}
+		]`,
+		`[{"symbol":"BTCUSDT","marginType":"ISOLATED","isAutoAddMargin":false,"leverage":20,"maxNotionalValue":"1000000"},{"symbol":"ETHUSDT","marginType":"CROSSED","isAutoAddMargin":false,"leverage":10,"maxNotionalValue":"1000000"}]`,
+		`[{"symbol":"BTCUSDT","orderId":1,"clientOrderId":"order1","price":"32000","origQty":"1","executedQty":"0","status":"NEW","side":"SELL","type":"LIMIT","positionSide":"BOTH","updateTime":900}]`,
+	} {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(data), http.StatusOK), nil).Once()
+	}
+	state := s.client.NewPortfolioState().Stream(s.serve)
+	s.r().NoError(state.Start())
+	s.r().Eventually(state.IsSynced, 5*time.Second, 10*time.Millisecond)
+	return state
+}
+
+func (s *portfolioStateTestSuite) TestBootstrap() {
+	state := s.startState()
+	defer state.Stop()
+
+	usdt, ok := state.Balance("USDT")
+	s.r().True(ok)
+	s.r().Equal("5000.00000000", usdt.CrossWalletBalance)
+
+	btc, ok := state.Position("BTCUSDT", PositionSideTypeBoth)
+	s.r().True(ok)
+	s.r().Equal(MarginTypeIsolated, btc.MarginType)
+	s.r().Equal(20, btc.Leverage)
+	s.r().Equal("28600.00", btc.LiquidationPrice)
+	s.r().Len(state.Positions(), 2)
+
+	order, ok := state.OpenOrder(1)
+	s.r().True(ok)
+	s.r().Equal("order1", order.ClientOrderID)
+}
+
+func (s *portfolioStateTestSuite) TestMarkPrice() {
+	state := s.startState()
+	defer state.Stop()
+
+	var positions []string
+	unsubscribe := state.Subscribe(func(change *PortfolioStateChange) {
+		if change.Type == PortfolioStateChangeTypePosition {
+			positions = append(positions, change.Position.Symbol)
+		}
+	})
+	defer unsubscribe()
+
+	state.HandleAllMarkPrice(WsAllMarkPriceEvent{
+		{Symbol: "BTCUSDT", MarkPrice: "31000.00000000"},
+		{Symbol: "ETHUSDT", MarkPrice: "2000.00000000"},
+	})
+	s.r().ElementsMatch([]string{"BTCUSDT", "ETHUSDT"}, positions)
+
+	btc, _ := state.Position("BTCUSDT", PositionSideTypeBoth)
+	s.r().Equal("1000", btc.UnrealizedPnL)
+	// (30000 - 1500) / (1 - 0.004)
+	s.r().Equal("28614.45783133", btc.LiquidationPrice)
+
+	eth, _ := state.Position("ETHUSDT", PositionSideTypeBoth)
+	s.r().Equal("0", eth.UnrealizedPnL)
+	// (-20000 - 5000) / (-10 - 0.05)
+	s.r().Equal("2487.56218905", eth.LiquidationPrice)
+
+	summary := state.Summary("USDT")
+	s.r().Equal("1000", summary.UnrealizedPnL)
+	s.r().Equal("0", summary.CrossUnrealizedPnL)
+	s.r().Equal("5000", summary.CrossMarginBalance)
+	s.r().Equal("2500", summary.IsolatedMargin)
+}
+
+func (s *portfolioStateTestSuite) TestEvents() {
+	state := s.startState()
+	defer state.Stop()
+
+	s.handler(&WsUserDataEvent{
+		Event:           UserDataEventTypeAccountUpdate,
+		TransactionTime: 2000,
+		WsUserDataAccountUpdate: WsUserDataAccountUpdate{AccountUpdate: WsAccountUpdate{
+			Reason:   UserDataEventReasonTypeOrder,
+			Balances: []WsBalance{{Asset: "USDT", Balance: "6490.00000000", CrossWalletBalance: "4990.00000000"}},
+			Positions: []WsPosition{{
+				Symbol:         "ETHUSDT",
+				Side:           PositionSideTypeBoth,
+				Amount:         "-5.000",
+				MarginType:     "cross",
+				IsolatedWallet: "0",
+				EntryPrice:     "2000.0",
+				UnrealizedPnL:  "0",
+			}},
+		}},
+	})
+	// stale
+	s.handler(&WsUserDataEvent{
+		Event:           UserDataEventTypeAccountUpdate,
+		TransactionTime: 1500,
+		WsUserDataAccountUpdate: WsUserDataAccountUpdate{AccountUpdate: WsAccountUpdate{
+			Positions: []WsPosition{{Symbol: "ETHUSDT", Side: PositionSideTypeBoth, Amount: "-8.000", MarginType: "cross"}},
+		}},
+	})
+	eth, _ := state.Position("ETHUSDT", PositionSideTypeBoth)
+	s.r().Equal("-5.000", eth.Amount)
+	s.r().Equal(MarginTypeCrossed, eth.MarginType)
+	usdt, _ := state.Balance("USDT")
+	s.r().Equal("4990.00000000", usdt.CrossWalletBalance)
+
+	s.handler(&WsUserDataEvent{
+		Event:                         UserDataEventTypeAccountConfigUpdate,
+		WsUserDataAccountConfigUpdate: WsUserDataAccountConfigUpdate{AccountConfigUpdate: WsAccountConfigUpdate{Symbol: "ETHUSDT", Leverage: 25}},
+	})
+	leverage, _ := state.Leverage("ETHUSDT")
+	s.r().Equal(25, leverage)
+
+	orderUpdate := func(status OrderStatusType, tradeTime int64) *WsUserDataEvent {
+		return &WsUserDataEvent{
+			Event: UserDataEventTypeOrderTradeUpdate,
+			WsUserDataOrderTradeUpdate: WsUserDataOrderTradeUpdate{OrderTradeUpdate: WsOrderTradeUpdate{
+				Symbol:        "ETHUSDT",
+				ClientOrderID: "order2",
+				Side:          SideTypeBuy,
+				Type:          OrderTypeLimit,
+				OriginalQty:   "5",
+				OriginalPrice: "1900",
+				Status:        status,
+				ID:            2,
+				TradeTime:     tradeTime,
+				PositionSide:  PositionSideTypeBoth,
+			}},
+		}
+	}
+	s.handler(orderUpdate(OrderStatusTypeNew, 2100))
+	s.r().Len(state.OpenOrders("ETHUSDT"), 1)
+	s.handler(orderUpdate(OrderStatusTypeFilled, 2200))
+	s.r().Empty(state.OpenOrders("ETHUSDT"))
+	s.r().Len(state.OpenOrders(""), 1)
+}
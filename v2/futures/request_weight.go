@@ -0,0 +1,191 @@
+package futures
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the request weight of the endpoints with a fixed weight,
+// see https://developers.binance.com/docs/derivatives/usds-margined-futures/general-info
+var requestWeights = map[string]int64{
+	"GET /fapi/v1/ping":                   1,
+	"GET /fapi/v1/time":                   1,
+	"GET /fapi/v1/exchangeInfo":           1,
+	"GET /fapi/v1/trades":                 5,
+	"GET /fapi/v1/historicalTrades":       20,
+	"GET /fapi/v1/aggTrades":              20,
+	"GET /fapi/v1/fundingRate":            1,
+	"GET /fapi/v1/fundingInfo":            1,
+	"GET /fapi/v1/openInterest":           1,
+	"GET /fapi/v1/assetIndex":             1,
+	"GET /fapi/v1/constituents":           2,
+	"POST /fapi/v1/order":                 0,
+	"PUT /fapi/v1/order":                  1,
+	"POST /fapi/v1/batchOrders":           5,
+	"PUT /fapi/v1/batchOrders":            5,
+	"GET /fapi/v1/order":                  1,
+	"DELETE /fapi/v1/order":               1,
+	"DELETE /fapi/v1/allOpenOrders":       1,
+	"DELETE /fapi/v1/batchOrders":         1,
+	"GET /fapi/v1/openOrder":              1,
+	"GET /fapi/v1/allOrders":              5,
+	"GET /fapi/v2/account":                5,
+	"GET /fapi/v3/account":                5,
+	"GET /fapi/v2/balance":                5,
+	"GET /fapi/v3/balance":                5,
+	"GET /fapi/v2/positionRisk":           5,
+	"GET /fapi/v3/positionRisk":           5,
+	"GET /fapi/v1/userTrades":             5,
+	"GET /fapi/v1/income":                 30,
+	"GET /fapi/v1/leverageBracket":        1,
+	"GET /fapi/v1/adlQuantile":            5,
+	"GET /fapi/v1/commissionRate":         20,
+	"GET /fapi/v1/accountConfig":          5,
+	"GET /fapi/v1/symbolConfig":           5,
+	"GET /fapi/v1/apiTradingStatus":       1,
+	"GET /fapi/v1/positionSide/dual":      30,
+	"GET /fapi/v1/multiAssetsMargin":      30,
+	"GET /fapi/v1/positionMargin/history": 1,
+}
+
+// orderEndpoints define the number of orders placed by the endpoints counted by the ORDERS rate limits
+var orderEndpoints = map[string]int64{
+	"POST /fapi/v1/order":       1,
+	"PUT /fapi/v1/order":        1,
+	"POST /fapi/v1/batchOrders": 5,
+	"PUT /fapi/v1/batchOrders":  5,
+}
+
+// param return the value of key from the query string or the form body
+func (r *request) param(key string) string {
+	if v := r.query.Get(key); v != "" {
+		return v
+	}
+	return r.form.Get(key)
+}
+
+// klinesWeight return the weight of the kline endpoints according to limit
+func klinesWeight(limit int) int64 {
+	switch {
+	case limit > 1000:
+		return 10
+	case limit >= 500 || limit == 0:
+		return 5
+	case limit >= 100:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// depthWeight return the weight of the depth endpoint according to limit
+func depthWeight(limit int) int64 {
+	switch {
+	case limit > 500:
+		return 20
+	case limit > 100 || limit == 0:
+		return 10
+	case limit > 50:
+		return 5
+	default:
+		return 2
+	}
+}
+
+// requestWeight return the request weight of r and the number of orders it places
+func requestWeight(r *request) (weight, orders int64) {
+	key := r.method + " " + r.endpoint
+	orders = orderEndpoints[key]
+	limit, _ := strconv.Atoi(r.param("limit"))
+	hasSymbol := r.param("symbol") != ""
+	switch key {
+	case "GET /fapi/v1/depth":
+		return depthWeight(limit), orders
+	case "GET /fapi/v1/klines", "GET /fapi/v1/continuousKlines", "GET /fapi/v1/indexPriceKlines",
+		"GET /fapi/v1/markPriceKlines", "GET /fapi/v1/premiumIndexKlines":
+		return klinesWeight(limit), orders
+	case "GET /fapi/v1/ticker/24hr", "GET /fapi/v1/openOrders":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	case "GET /fapi/v1/ticker/price", "GET /fapi/v2/ticker/price":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 2, orders
+	case "GET /fapi/v1/ticker/bookTicker":
+		if hasSymbol {
+			return 2, orders
+		}
+		return 5, orders
+	case "GET /fapi/v1/premiumIndex":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 10, orders
+	case "GET /fapi/v1/forceOrders":
+		if hasSymbol {
+			return 20, orders
+		}
+		return 50, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
+
+// isRateLimited return true for the endpoints counted by the exchangeInfo rate limits
+func isRateLimited(r *request) bool {
+	return strings.HasPrefix(r.endpoint, "/fapi/")
+}
+
+// EnableRateLimiter fetch the exchange rate limits and enable the client-side rate limiter.
+// When failFast is true requests exceeding a limit fail with a *common.RateLimitError
+// instead of waiting for the next window.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool, opts ...RequestOption) (*common.RateLimiter, error) {
+	res, err := c.NewExchangeInfoService().Do(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]common.RateLimitRule, 0, len(res.RateLimits))
+	for _, l := range res.RateLimits {
+		rules = append(rules, common.RateLimitRule{
+			RateLimitType: l.RateLimitType,
+			Interval:      l.Interval,
+			IntervalNum:   l.IntervalNum,
+			Limit:         l.Limit,
+		})
+	}
+	limiter := common.NewRateLimiter(rules)
+	limiter.FailFast = failFast
+	c.RateLimiter = limiter
+	return limiter, nil
+}
+
+// waitRateLimit block until r fits the rate limits of the client
+func (c *Client) waitRateLimit(ctx context.Context, r *request) error {
+	if c.RateLimiter == nil || !isRateLimited(r) {
+		return nil
+	}
+	weight, orders := requestWeight(r)
+	return c.RateLimiter.Wait(ctx, weight, orders)
+}
+
+// updateRateLimit reconcile the rate limiter with the response, the 429 and 418 responses
+// of every endpoint pause the limiter
+func (c *Client) updateRateLimit(r *request, res *http.Response) {
+	if c.RateLimiter == nil {
+		return
+	}
+	if !isRateLimited(r) {
+		c.RateLimiter.UpdateRetryAfter(res.StatusCode, res.Header)
+		return
+	}
+	c.RateLimiter.Update(res.StatusCode, res.Header)
+}
@@ -0,0 +1,65 @@
+package futures
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type requestWeightTestSuite struct {
+	baseTestSuite
+}
+
+func TestRequestWeight(t *testing.T) {
+	suite.Run(t, new(requestWeightTestSuite))
+}
+
+func (s *requestWeightTestSuite) TestRequestWeight() {
+	tests := []struct {
+		method   string
+		endpoint string
+		params   params
+		weight   int64
+		orders   int64
+	}{
+		{http.MethodGet, "/fapi/v1/depth", params{"limit": 1000}, 20, 0},
+		{http.MethodGet, "/fapi/v1/depth", params{"limit": 50}, 2, 0},
+		{http.MethodGet, "/fapi/v1/klines", params{"limit": 1500}, 10, 0},
+		{http.MethodGet, "/fapi/v1/klines", nil, 5, 0},
+		{http.MethodGet, "/fapi/v1/openOrders", nil, 40, 0},
+		{http.MethodPost, "/fapi/v1/order", params{"symbol": "BTCUSDT"}, 0, 1},
+		{http.MethodPost, "/fapi/v1/batchOrders", nil, 5, 5},
+		{http.MethodGet, "/fapi/v1/income", nil, 30, 0},
+	}
+	for _, t := range tests {
+		r := newRequest()
+		r.method = t.method
+		r.endpoint = t.endpoint
+		r.setParams(t.params)
+		weight, orders := requestWeight(r)
+		s.r().Equal(t.weight, weight, t.endpoint)
+		s.r().Equal(t.orders, orders, t.endpoint)
+	}
+}
+
+func (s *requestWeightTestSuite) TestWaitRateLimitBeforeSigning() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	var timestamp int64
+	s.assertReq(func(r *request) {
+		timestamp, _ = strconv.ParseInt(r.query.Get(timestampKey), 10, 64)
+	})
+	s.client.RateLimiter = common.NewRateLimiter(nil)
+	start := time.Now()
+	s.client.RateLimiter.Pause(200 * time.Millisecond)
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	// the request is signed once the limiter let it through
+	s.r().GreaterOrEqual(timestamp, start.Add(200*time.Millisecond).UnixMilli())
+}
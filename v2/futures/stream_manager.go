@@ -0,0 +1,200 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	gorilla "github.com/gorilla/websocket"
+)
+
+// StreamManager multiplexes market streams over combined stream connections.
+// Streams are added and removed without reconnecting, spread over several
+// connections past 1024 streams, and subscribed again after a reconnect.
+// Depth and book ticker streams are served by the public endpoint, the other
+// ones by the market endpoint.
+type StreamManager struct {
+	public     *websocket.StreamManager
+	market     *websocket.StreamManager
+	errHandler ErrHandler
+}
+
+// NewStreamManager init a StreamManager on the combined stream endpoints
+func (c *Client) NewStreamManager(errHandler ErrHandler) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	return &StreamManager{
+		public:     websocket.NewStreamManager(c.streamDialer(c.getCombinedPublicEndpoint), errHandler),
+		market:     websocket.NewStreamManager(c.streamDialer(c.getCombinedMarketEndpoint), errHandler),
+		errHandler: errHandler,
+	}
+}
+
+func (c *Client) streamDialer(baseEndpoint func() string) websocket.StreamDialer {
+	return func(streams []string, handler func(message []byte), errHandler func(err error), onConn func(conn *gorilla.Conn)) (doneC, stopC chan struct{}, err error) {
+		endpoint := baseEndpoint() + strings.Join(streams, "/")
+		if len(streams) == 0 {
+			endpoint = strings.TrimSuffix(endpoint, "?streams=")
+		}
+		cfg := c.wsConfig(endpoint)
+		return wsServeWithConnHandler(cfg, handler, errHandler, func(ctx context.Context, conn *gorilla.Conn) {
+			onConn(conn)
+		})
+	}
+}
+
+// isPublicStream check if stream is served by the public endpoint
+func isPublicStream(stream string) bool {
+	return strings.Contains(stream, "@depth") || strings.Contains(stream, "bookTicker")
+}
+
+func (m *StreamManager) manager(stream string) *websocket.StreamManager {
+	if isPublicStream(stream) {
+		return m.public
+	}
+	return m.market
+}
+
+// Subscribe route the raw data of streams to handler
+func (m *StreamManager) Subscribe(handler websocket.StreamHandler, streams ...string) error {
+	var public, market []string
+	for _, stream := range streams {
+		if isPublicStream(stream) {
+			public = append(public, stream)
+		} else {
+			market = append(market, stream)
+		}
+	}
+	if err := m.public.Subscribe(handler, public...); err != nil {
+		return err
+	}
+	return m.market.Subscribe(handler, market...)
+}
+
+// Unsubscribe stop routing streams and unsubscribe them
+func (m *StreamManager) Unsubscribe(streams ...string) error {
+	var public, market []string
+	for _, stream := range streams {
+		if isPublicStream(stream) {
+			public = append(public, stream)
+		} else {
+			market = append(market, stream)
+		}
+	}
+	if err := m.public.Unsubscribe(public...); err != nil {
+		return err
+	}
+	return m.market.Unsubscribe(market...)
+}
+
+// Streams return the streams subscribed through the manager
+func (m *StreamManager) Streams() []string {
+	streams := append(m.public.Streams(), m.market.Streams()...)
+	sort.Strings(streams)
+	return streams
+}
+
+// ListSubscriptions ask every connection for its subscriptions with LIST_SUBSCRIPTIONS
+func (m *StreamManager) ListSubscriptions() ([]string, error) {
+	public, err := m.public.ListSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+	market, err := m.market.ListSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+	streams := append(public, market...)
+	sort.Strings(streams)
+	return streams, nil
+}
+
+// Connections return the number of open connections
+func (m *StreamManager) Connections() int {
+	return m.public.Connections() + m.market.Connections()
+}
+
+// Close unsubscribe every stream and close the connections
+func (m *StreamManager) Close() {
+	m.public.Close()
+	m.market.Close()
+}
+
+// subscribeStreams decode the data of streams into a new T and pass it to handler
+func subscribeStreams[T any](m *StreamManager, streams []string, handler func(event *T)) ([]string, error) {
+	if len(streams) == 0 {
+		return nil, nil
+	}
+	err := m.manager(streams[0]).Subscribe(func(stream string, data []byte) {
+		event := new(T)
+		if err := json.Unmarshal(data, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	}, streams...)
+	return streams, err
+}
+
+func symbolStreams(symbols []string, format string) []string {
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		streams[i] = fmt.Sprintf(format, strings.ToLower(symbol))
+	}
+	return streams
+}
+
+// SubscribeAggTrade subscribe the aggregate trade streams of symbols and return their names
+func (m *StreamManager) SubscribeAggTrade(symbols []string, handler WsAggTradeHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@aggTrade"), handler)
+}
+
+// SubscribeMarkPrice subscribe the mark price streams of symbols and return their names
+func (m *StreamManager) SubscribeMarkPrice(symbols []string, handler WsMarkPriceHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@markPrice"), handler)
+}
+
+// SubscribeKline subscribe the kline streams of symbols at interval and return their names
+func (m *StreamManager) SubscribeKline(symbols []string, interval string, handler WsKlineHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@kline_"+interval), handler)
+}
+
+// SubscribeMarketTicker subscribe the 24hr ticker streams of symbols and return their names
+func (m *StreamManager) SubscribeMarketTicker(symbols []string, handler WsMarketTickerHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@ticker"), handler)
+}
+
+// SubscribeBookTicker subscribe the best bid and ask streams of symbols and return their names
+func (m *StreamManager) SubscribeBookTicker(symbols []string, handler WsBookTickerHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@bookTicker"), handler)
+}
+
+// SubscribeDiffDepth subscribe the diff depth streams of symbols and return their names
+func (m *StreamManager) SubscribeDiffDepth(symbols []string, handler WsDepthHandler) ([]string, error) {
+	return m.subscribeDepth(symbolStreams(symbols, "%s@depth"), handler)
+}
+
+// SubscribePartialDepth subscribe the partial depth streams of symbols and return their names, levels is 5, 10 or 20
+func (m *StreamManager) SubscribePartialDepth(symbols []string, levels int, handler WsDepthHandler) ([]string, error) {
+	if levels != 5 && levels != 10 && levels != 20 {
+		return nil, errors.New("Invalid levels")
+	}
+	return m.subscribeDepth(symbolStreams(symbols, fmt.Sprintf("%%s@depth%d", levels)), handler)
+}
+
+func (m *StreamManager) subscribeDepth(streams []string, handler WsDepthHandler) ([]string, error) {
+	err := m.public.Subscribe(func(stream string, data []byte) {
+		event, err := parseWsDepthEvent(data)
+		if err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	}, streams...)
+	return streams, err
+}
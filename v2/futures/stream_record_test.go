@@ -0,0 +1,52 @@
+package futures
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/streamrec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mark_price.jsonl.gz")
+	rec, err := streamrec.NewRecorder(path)
+	require.NoError(t, err)
+	require.NoError(t, rec.Record(BaseWsMarketURL+"/btcusdt@markPrice",
+		[]byte(`{"e":"markPriceUpdate","E":1,"s":"BTCUSDT","p":"100.0","i":"99.9","P":"100.1","r":"0.0001","T":2}`)))
+	require.NoError(t, rec.Record(BaseWsMarketURL+"/ethusdt@markPrice",
+		[]byte(`{"e":"markPriceUpdate","E":3,"s":"ETHUSDT","p":"10.0","i":"9.9","P":"10.1","r":"0.0002","T":4}`)))
+	require.NoError(t, rec.Close())
+
+	replayer := streamrec.NewReplayer(path, streamrec.SpeedMax)
+	errHandler := func(err error) { t.Error(err) }
+	var raw, combined []*WsMarkPriceEvent
+	client := NewClient("", "")
+	client.StreamReplayer = replayer
+	_, _, err = client.WsMarkPriceServe("BTCUSDT", func(event *WsMarkPriceEvent) {
+		raw = append(raw, event)
+	}, errHandler)
+	require.NoError(t, err)
+	doneC, _, err := client.WsCombinedMarkPriceServe([]string{"BTCUSDT", "ETHUSDT"}, func(event *WsMarkPriceEvent) {
+		combined = append(combined, event)
+	}, errHandler)
+	require.NoError(t, err)
+	require.NoError(t, replayer.Run())
+	<-doneC
+
+	require.Len(t, raw, 1)
+	assert.Equal(t, &WsMarkPriceEvent{
+		Event:                "markPriceUpdate",
+		Time:                 1,
+		Symbol:               "BTCUSDT",
+		MarkPrice:            "100.0",
+		IndexPrice:           "99.9",
+		EstimatedSettlePrice: "100.1",
+		FundingRate:          "0.0001",
+		NextFundingTime:      2,
+	}, raw[0])
+	require.Len(t, combined, 2)
+	assert.Equal(t, raw[0], combined[0])
+	assert.Equal(t, "ETHUSDT", combined[1].Symbol)
+}
@@ -0,0 +1,135 @@
+package futures
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// WsUserStreamService manage the listen key of the user data stream over the websocket API
+type WsUserStreamService struct {
+	c      websocket.Client
+	ApiKey string
+}
+
+// NewWsUserStreamService init WsUserStreamService
+func (c *Client) NewWsUserStreamService() (*WsUserStreamService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &WsUserStreamService{
+		c:      client,
+		ApiKey: c.APIKey,
+	}, nil
+}
+
+// UserStreamResult define user data stream result
+type UserStreamResult struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// WsUserStreamResponse define 'userDataStream.start', 'userDataStream.ping' and
+// 'userDataStream.stop' websocket API response, ListenKey is empty for 'userDataStream.stop'
+type WsUserStreamResponse struct {
+	ID        string             `json:"id"`
+	Status    int                `json:"status"`
+	Result    UserStreamResult   `json:"result"`
+	RateLimit []AccountRateLimit `json:"rateLimits"`
+	Error     *common.APIError   `json:"error,omitempty"`
+}
+
+// StartUserStream sends 'userDataStream.start' request
+func (s *WsUserStreamService) StartUserStream(requestID string) error {
+	return s.write(requestID, websocket.UserDataStreamStartFuturesWsApiMethod)
+}
+
+// SyncStartUserStream sends 'userDataStream.start' request and receives the listen key
+func (s *WsUserStreamService) SyncStartUserStream(requestID string) (*WsUserStreamResponse, error) {
+	return s.writeSync(requestID, websocket.UserDataStreamStartFuturesWsApiMethod)
+}
+
+// KeepaliveUserStream sends 'userDataStream.ping' request
+func (s *WsUserStreamService) KeepaliveUserStream(requestID string) error {
+	return s.write(requestID, websocket.UserDataStreamPingFuturesWsApiMethod)
+}
+
+// SyncKeepaliveUserStream sends 'userDataStream.ping' request and receives response
+func (s *WsUserStreamService) SyncKeepaliveUserStream(requestID string) (*WsUserStreamResponse, error) {
+	return s.writeSync(requestID, websocket.UserDataStreamPingFuturesWsApiMethod)
+}
+
+// CloseUserStream sends 'userDataStream.stop' request
+func (s *WsUserStreamService) CloseUserStream(requestID string) error {
+	return s.write(requestID, websocket.UserDataStreamStopFuturesWsApiMethod)
+}
+
+// SyncCloseUserStream sends 'userDataStream.stop' request and receives response
+func (s *WsUserStreamService) SyncCloseUserStream(requestID string) (*WsUserStreamResponse, error) {
+	return s.writeSync(requestID, websocket.UserDataStreamStopFuturesWsApiMethod)
+}
+
+func (s *WsUserStreamService) write(requestID string, method websocket.WsApiMethodType) error {
+	rawData, err := s.buildRequest(requestID, method)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *WsUserStreamService) writeSync(requestID string, method websocket.WsApiMethodType) (*WsUserStreamResponse, error) {
+	rawData, err := s.buildRequest(requestID, method)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	userStream := &WsUserStreamResponse{}
+	if err := json.Unmarshal(response, userStream); err != nil {
+		return nil, err
+	}
+
+	return userStream, nil
+}
+
+// buildRequest builds request, user data stream requests only need the API key
+func (s *WsUserStreamService) buildRequest(requestID string, method websocket.WsApiMethodType) ([]byte, error) {
+	if s.ApiKey == "" {
+		return nil, websocket.ErrorApiKeyIsNotSet
+	}
+	return websocket.CreateUnsignedRequest(requestID, method, map[string]any{
+		"apiKey": s.ApiKey,
+	})
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *WsUserStreamService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *WsUserStreamService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *WsUserStreamService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *WsUserStreamService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
@@ -0,0 +1,77 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type userStreamWsServiceTestSuite struct {
+	suite.Suite
+	mockClient *mock.MockClient
+	mockCtrl   *gomock.Controller
+	service    *WsUserStreamService
+}
+
+func TestUserStreamWsService(t *testing.T) {
+	suite.Run(t, new(userStreamWsServiceTestSuite))
+}
+
+func (s *userStreamWsServiceTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockClient = mock.NewMockClient(s.mockCtrl)
+	s.service = &WsUserStreamService{c: s.mockClient, ApiKey: "dummyApiKey"}
+}
+
+func (s *userStreamWsServiceTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *userStreamWsServiceTestSuite) TestSyncStartUserStream() {
+	data := []byte(`{
+  "id": "d3df8a61-98ea-4fe0-8f4e-0fcea5d418b0",
+  "status": 200,
+  "result": {
+    "listenKey": "xs0mRXdAKlIPDRFrlPcw0qI41Eh3ixNntmymGyhrhgqo7L6FuLaWArTD7RLP"
+  },
+  "rateLimits": [
+    {"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400, "count": 2}
+  ]
+}`)
+	requestID := "d3df8a61-98ea-4fe0-8f4e-0fcea5d418b0"
+	s.mockClient.EXPECT().WriteSync(requestID, gomock.Any(), gomock.Any()).DoAndReturn(func(id string, req []byte, _ any) ([]byte, error) {
+		s.Contains(string(req), `"method":"userDataStream.start"`)
+		s.Contains(string(req), `"apiKey":"dummyApiKey"`)
+		s.NotContains(string(req), `"signature"`)
+		return data, nil
+	})
+
+	response, err := s.service.SyncStartUserStream(requestID)
+	s.Require().NoError(err)
+	s.Equal(200, response.Status)
+	s.Equal("xs0mRXdAKlIPDRFrlPcw0qI41Eh3ixNntmymGyhrhgqo7L6FuLaWArTD7RLP", response.Result.ListenKey)
+}
+
+func (s *userStreamWsServiceTestSuite) TestKeepaliveAndClose() {
+	gomock.InOrder(
+		s.mockClient.EXPECT().Write("ping-1", gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+			s.Contains(string(data), `"method":"userDataStream.ping"`)
+			return nil
+		}),
+		s.mockClient.EXPECT().Write("stop-1", gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+			s.Contains(string(data), `"method":"userDataStream.stop"`)
+			return nil
+		}),
+	)
+	s.NoError(s.service.KeepaliveUserStream("ping-1"))
+	s.NoError(s.service.CloseUserStream("stop-1"))
+}
+
+func (s *userStreamWsServiceTestSuite) TestEmptyApiKey() {
+	s.service.ApiKey = ""
+	s.mockClient.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+	s.ErrorIs(s.service.StartUserStream("start-1"), websocket.ErrorApiKeyIsNotSet)
+}
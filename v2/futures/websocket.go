@@ -1,10 +1,13 @@
 package futures
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common/streamrec"
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 	"github.com/gorilla/websocket"
 )
 
@@ -18,6 +21,10 @@ type ErrHandler func(err error)
 type WsConfig struct {
 	Endpoint string
 	Proxy    *string
+	// Recorder records the messages of the stream when set
+	Recorder *streamrec.Recorder
+	// Replayer replaces the connection of the stream when set
+	Replayer *streamrec.Replayer
 }
 
 func newWsConfig(endpoint string, proxy *string) *WsConfig {
@@ -27,7 +34,50 @@ func newWsConfig(endpoint string, proxy *string) *WsConfig {
 	}
 }
 
+// wsConfig init the configuration of a stream of the client, recorded or replayed when
+// StreamRecorder or StreamReplayer is set
+func (c *Client) wsConfig(endpoint string) *WsConfig {
+	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg.Recorder = c.StreamRecorder
+	cfg.Replayer = c.StreamReplayer
+	return cfg
+}
+
+var (
+	// WebsocketAutoReconnect makes the market and user data streams reconnect with backoff instead
+	// of closing doneC when their connection ends. The error handler then receives a
+	// commonws.DisconnectedEvent and a commonws.ReconnectedEvent, after which consumers keeping
+	// a state from the stream should resync.
+	WebsocketAutoReconnect = false
+	// WebsocketMaxConnectionAge is the age after which the connection of a stream is replaced
+	// if WebsocketAutoReconnect is enabled, before the server closes it after 24 hours
+	WebsocketMaxConnectionAge = commonws.MaxConnectionAge
+)
+
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	if cfg.Replayer != nil {
+		return cfg.Replayer.Serve(cfg.Endpoint, handler, errHandler)
+	}
+	if WebsocketAutoReconnect {
+		serve := func(connErrHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return wsServeWithConnHandler(cfg, handler, connErrHandler, nil)
+		}
+		return commonws.ServeResilient(serve, errHandler, WebsocketMaxConnectionAge)
+	}
+	return wsServeWithConnHandler(cfg, handler, errHandler, nil)
+}
+
+// ConnHandler is called with the connection before its messages are read
+type ConnHandler func(context.Context, *websocket.Conn)
+
+// wsServeWithConnHandler serves websocket with a custom connection handler
+var wsServeWithConnHandler = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
+	if cfg.Replayer != nil {
+		return cfg.Replayer.Serve(cfg.Endpoint, handler, errHandler)
+	}
+	if cfg.Recorder != nil {
+		handler = cfg.Recorder.Handler(cfg.Endpoint, handler)
+	}
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
 		u, err := url.Parse(*cfg.Proxy)
@@ -57,6 +107,11 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 		if WebsocketKeepalive {
 			keepAlive(c, WebsocketTimeout)
 		}
+		if connHandler != nil {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			connHandler(ctx, c)
+		}
 		// Wait for the stopC channel to be closed.  We do that in a
 		// separate goroutine because ReadMessage is a blocking
 		// operation.
@@ -0,0 +1,59 @@
+package futures
+
+import (
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// EnableWsApiConnection open a websocket API connection shared by the websocket API
+// services created from the client afterwards, so that all the requests are sent over
+// one connection. The responses of SyncDo are routed to their request by id, so services can
+// call it in parallel, and the responses of Do are read from the read channel of the returned
+// client. Close the returned client to close the connection.
+func (c *Client) EnableWsApiConnection() (websocket.Client, error) {
+	client, err := c.newWsApiConn()
+	if err != nil {
+		return nil, err
+	}
+	c.WsApiClient = client
+	return client, nil
+}
+
+// EnableWsApiSession authenticate the shared websocket API connection with 'session.logon',
+// opening it if EnableWsApiConnection was not called. The requests of the websocket API
+// services created from the client afterwards are then sent without signature. It requires
+// an Ed25519 key, the session logs on again after the connection was restored.
+func (c *Client) EnableWsApiSession() (*websocket.Session, error) {
+	if session, ok := c.WsApiClient.(*websocket.Session); ok {
+		return session, nil
+	}
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+	session, err := websocket.NewSession(client, c.APIKey, c.SecretKey, c.KeyType, c.timeOffset)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := session.Logon(); err != nil {
+		return nil, err
+	}
+	c.WsApiClient = session
+	return session, nil
+}
+
+// newWsApiClient return the shared websocket API connection if any, or open a new one
+func (c *Client) newWsApiClient() (websocket.Client, error) {
+	if c.WsApiClient != nil {
+		return c.WsApiClient, nil
+	}
+	return c.newWsApiConn()
+}
+
+func (c *Client) newWsApiConn() (websocket.Client, error) {
+	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	return websocket.NewClient(conn)
+}
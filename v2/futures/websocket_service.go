@@ -62,7 +62,7 @@ type WsAggTradeHandler func(event *WsAggTradeEvent)
 // WsAggTradeServe serve websocket that push trade information that is aggregated for a single taker order.
 func (c *Client) WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@aggTrade", c.getWsMarketEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, &event)
@@ -82,7 +82,7 @@ func (c *Client) WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHan
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -126,7 +126,7 @@ type WsMarkPriceEvent struct {
 type WsMarkPriceHandler func(event *WsMarkPriceEvent)
 
 func (c *Client) wsMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarkPriceEvent)
 		err := json.Unmarshal(message, &event)
@@ -161,7 +161,7 @@ func (c *Client) WsMarkPriceServeWithRate(symbol string, rate time.Duration, han
 }
 
 func (c *Client) wsCombinedMarkPriceServe(endpoint string, handler WsMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -225,7 +225,7 @@ type WsAllMarkPriceEvent []*WsMarkPriceEvent
 type WsAllMarkPriceHandler func(event WsAllMarkPriceEvent)
 
 func (c *Client) wsAllMarkPriceServe(endpoint string, handler WsAllMarkPriceHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarkPriceEvent
 		err := json.Unmarshal(message, &event)
@@ -293,7 +293,7 @@ type WsKlineHandler func(event *WsKlineEvent)
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
 func (c *Client) WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@kline_%s", c.getWsMarketEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -313,7 +313,7 @@ func (c *Client) WsCombinedKlineServe(symbolIntervalPair map[string]string, hand
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -350,7 +350,7 @@ func (c *Client) WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]
 		}
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -422,7 +422,7 @@ func (c *Client) WsContinuousKlineServe(subscribeArgs *WsContinuousKlineSubscrib
 ) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s_%s@continuousKline_%s", c.getWsMarketEndpoint(), strings.ToLower(subscribeArgs.Pair),
 		strings.ToLower(subscribeArgs.ContractType), subscribeArgs.Interval)
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsContinuousKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -445,7 +445,7 @@ func (c *Client) WsCombinedContinuousKlineServe(subscribeArgsList []*WsContinuou
 			strings.ToLower(val.ContractType), val.Interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -488,7 +488,7 @@ type WsMiniMarketTickerHandler func(event *WsMiniMarketTickerEvent)
 // WsMiniMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
 func (c *Client) WsMiniMarketTickerServe(symbol string, handler WsMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@miniTicker", c.getWsMarketEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMiniMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -510,7 +510,7 @@ type WsAllMiniMarketTickerHandler func(event WsAllMiniMarketTickerEvent)
 // WsAllMiniMarketTickerServe serve websocket that pushes price and funding rate for all markets.
 func (c *Client) WsAllMiniMarketTickerServe(handler WsAllMiniMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!miniTicker@arr", c.getWsMarketEndpoint())
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -551,7 +551,7 @@ type WsMarketTickerHandler func(event *WsMarketTickerEvent)
 // WsMarketTickerServe serve websocket that pushes 24hr rolling window mini-ticker statistics for a single symbol.
 func (c *Client) WsMarketTickerServe(symbol string, handler WsMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@ticker", c.getWsMarketEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsMarketTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -573,7 +573,7 @@ type WsAllMarketTickerHandler func(event WsAllMarketTickerEvent)
 // WsAllMarketTickerServe serve websocket that pushes price and funding rate for all markets.
 func (c *Client) WsAllMarketTickerServe(handler WsAllMarketTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!ticker@arr", c.getWsMarketEndpoint())
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketTickerEvent
 		err := json.Unmarshal(message, &event)
@@ -610,7 +610,7 @@ type WsBookTickerHandler func(event *WsBookTickerEvent)
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
 func (c *Client) WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@bookTicker", c.getWsPublicEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -629,7 +629,7 @@ func (c *Client) WsCombinedBookTickerServe(symbols []string, handler WsBookTicke
 		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedBookTickerEvent)
 		err := json.Unmarshal(message, event)
@@ -645,7 +645,7 @@ func (c *Client) WsCombinedBookTickerServe(symbols []string, handler WsBookTicke
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
 func (c *Client) WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!bookTicker", c.getWsPublicEndpoint())
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -686,7 +686,7 @@ type WsLiquidationOrderHandler func(event *WsLiquidationOrderEvent)
 // WsLiquidationOrderServe serve websocket that pushes force liquidation order information for specific symbol.
 func (c *Client) WsLiquidationOrderServe(symbol string, handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@forceOrder", c.getWsMarketEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -702,7 +702,7 @@ func (c *Client) WsLiquidationOrderServe(symbol string, handler WsLiquidationOrd
 // WsAllLiquidationOrderServe serve websocket that pushes force liquidation order information for all symbols.
 func (c *Client) WsAllLiquidationOrderServe(handler WsLiquidationOrderHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!forceOrder@arr", c.getWsMarketEndpoint())
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsLiquidationOrderEvent)
 		err := json.Unmarshal(message, &event)
@@ -761,7 +761,7 @@ func (c *Client) WsCombinedDepthServe(symbolLevels map[string]string, handler Ws
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -807,7 +807,7 @@ func (c *Client) WsCombinedDiffDepthServe(symbols []string, handler WsDepthHandl
 		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -866,44 +866,53 @@ func (c *Client) wsDepthServe(symbol string, levels string, rate *time.Duration,
 		}
 	}
 	endpoint := fmt.Sprintf("%s/%s@depth%s%s", c.getWsPublicEndpoint(), strings.ToLower(symbol), levels, rateStr)
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
+		event, err := parseWsDepthEvent(message)
 		if err != nil {
 			errHandler(err)
 			return
 		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.TransactionTime = j.Get("T").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.PrevLastUpdateID = j.Get("pu").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
 		handler(event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// parseWsDepthEvent parse the message of a depth stream
+func parseWsDepthEvent(message []byte) (*WsDepthEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.TransactionTime = j.Get("T").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.PrevLastUpdateID = j.Get("pu").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return event, nil
+}
+
 // WsBLVTInfoEvent define websocket BLVT info event
 type WsBLVTInfoEvent struct {
 	Event          string         `json:"e"`
@@ -929,7 +938,7 @@ type WsBLVTInfoHandler func(event *WsBLVTInfoEvent)
 // WsBLVTInfoServe serve BLVT info stream
 func (c *Client) WsBLVTInfoServe(name string, handler WsBLVTInfoHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@tokenNav", c.getWsPublicEndpoint(), strings.ToUpper(name))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTInfoEvent)
 		err := json.Unmarshal(message, &event)
@@ -972,7 +981,7 @@ type WsBLVTKlineHandler func(event *WsBLVTKlineEvent)
 // WsBLVTKlineServe serve BLVT kline stream
 func (c *Client) WsBLVTKlineServe(name string, interval string, handler WsBLVTKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@nav_Kline_%s", c.getWsPublicEndpoint(), strings.ToUpper(name), interval)
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBLVTKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -1007,7 +1016,7 @@ type WsCompositeIndexHandler func(event *WsCompositeIndexEvent)
 // WsCompositiveIndexServe serve composite index information for index symbols
 func (c *Client) WsCompositiveIndexServe(symbol string, handler WsCompositeIndexHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@compositeIndex", c.getWsMarketEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCompositeIndexEvent)
 		err := json.Unmarshal(message, event)
@@ -1315,7 +1324,7 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 // WsUserDataServe serve user data handler with listen key
 func (c *Client) WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", c.getWsPrivateEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
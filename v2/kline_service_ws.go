@@ -0,0 +1,215 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// KlinesWsApiService queries klines of a symbol
+type KlinesWsApiService struct {
+	c websocket.Client
+}
+
+// NewKlinesWsApiService init KlinesWsApiService
+func (c *Client) NewKlinesWsApiService() (*KlinesWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &KlinesWsApiService{
+		c: client,
+	}, nil
+}
+
+// KlinesWsRequest parameters for 'klines' websocket API
+type KlinesWsRequest struct {
+	symbol    string
+	interval  string
+	startTime *int64
+	endTime   *int64
+	timeZone  *string
+	limit     *int
+}
+
+// NewKlinesWsRequest init KlinesWsRequest
+func NewKlinesWsRequest() *KlinesWsRequest {
+	return &KlinesWsRequest{}
+}
+
+func (s *KlinesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *KlinesWsRequest) buildParams() params {
+	m := params{
+		"symbol":   s.symbol,
+		"interval": s.interval,
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.timeZone != nil {
+		m["timeZone"] = *s.timeZone
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'klines' request
+func (s *KlinesWsApiService) Do(requestID string, request *KlinesWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(
+		requestID,
+		websocket.KlinesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'klines' request and receives response
+func (s *KlinesWsApiService) SyncDo(requestID string, request *KlinesWsRequest) (*KlinesWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(
+		requestID,
+		websocket.KlinesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	klinesWsResponse := &KlinesWsResponse{}
+	if err := json.Unmarshal(response, klinesWsResponse); err != nil {
+		return nil, err
+	}
+
+	return klinesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *KlinesWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *KlinesWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *KlinesWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *KlinesWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *KlinesWsRequest) Symbol(symbol string) *KlinesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *KlinesWsRequest) Interval(interval string) *KlinesWsRequest {
+	s.interval = interval
+	return s
+}
+
+// StartTime set startTime
+func (s *KlinesWsRequest) StartTime(startTime int64) *KlinesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *KlinesWsRequest) EndTime(endTime int64) *KlinesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// TimeZone set timeZone
+func (s *KlinesWsRequest) TimeZone(timeZone string) *KlinesWsRequest {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Limit set limit
+func (s *KlinesWsRequest) Limit(limit int) *KlinesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// KlinesWsResponse define 'klines' websocket API response
+type KlinesWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Kline `json:"-"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the klines of the result which are arrays
+func (r *KlinesWsResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Id     string            `json:"id"`
+		Status int               `json:"status"`
+		Result []json.RawMessage `json:"result"`
+		Error  *common.APIError  `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id = raw.Id
+	r.Status = raw.Status
+	r.Error = raw.Error
+	r.Result = make([]*Kline, len(raw.Result))
+	for i, item := range raw.Result {
+		j, err := newJSON(item)
+		if err != nil {
+			return err
+		}
+		if len(j.MustArray()) < 11 {
+			return fmt.Errorf("invalid kline response")
+		}
+		r.Result[i] = &Kline{
+			OpenTime:                 j.GetIndex(0).MustInt64(),
+			Open:                     j.GetIndex(1).MustString(),
+			High:                     j.GetIndex(2).MustString(),
+			Low:                      j.GetIndex(3).MustString(),
+			Close:                    j.GetIndex(4).MustString(),
+			Volume:                   j.GetIndex(5).MustString(),
+			CloseTime:                j.GetIndex(6).MustInt64(),
+			QuoteAssetVolume:         j.GetIndex(7).MustString(),
+			TradeNum:                 j.GetIndex(8).MustInt64(),
+			TakerBuyBaseAssetVolume:  j.GetIndex(9).MustString(),
+			TakerBuyQuoteAssetVolume: j.GetIndex(10).MustString(),
+		}
+	}
+	return nil
+}
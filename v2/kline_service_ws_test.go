@@ -0,0 +1,105 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type klinesServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *KlinesWsApiService
+	request   *KlinesWsRequest
+}
+
+func TestKlinesServiceWs(t *testing.T) {
+	suite.Run(t, new(klinesServiceWsTestSuite))
+}
+
+func (s *klinesServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &KlinesWsApiService{
+		c: s.client,
+	}
+
+	s.request = NewKlinesWsRequest().
+		Symbol("BNBBTC").
+		Interval("1h").
+		Limit(1)
+}
+
+func (s *klinesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *klinesServiceWsTestSuite) TestKlines() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"klines"`)
+		s.Contains(string(data), `"interval":"1h"`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *klinesServiceWsTestSuite) TestKlines_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *klinesServiceWsTestSuite) TestKlinesSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": [
+			[
+				1655971200000,
+				"0.01086000",
+				"0.01086600",
+				"0.01083600",
+				"0.01083800",
+				"2290.53800000",
+				1655974799999,
+				"24.85074442",
+				2283,
+				"1171.64000000",
+				"12.71225884",
+				"0"
+			]
+		]
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Require().Len(response.Result, 1)
+	s.Equal(&Kline{
+		OpenTime:                 1655971200000,
+		Open:                     "0.01086000",
+		High:                     "0.01086600",
+		Low:                      "0.01083600",
+		Close:                    "0.01083800",
+		Volume:                   "2290.53800000",
+		CloseTime:                1655974799999,
+		QuoteAssetVolume:         "24.85074442",
+		TradeNum:                 2283,
+		TakerBuyBaseAssetVolume:  "1171.64000000",
+		TakerBuyQuoteAssetVolume: "12.71225884",
+	}, response.Result[0])
+}
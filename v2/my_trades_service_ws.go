@@ -0,0 +1,208 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// MyTradesWsApiService queries trades of a symbol
+type MyTradesWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewMyTradesWsApiService init MyTradesWsApiService
+func (c *Client) NewMyTradesWsApiService() (*MyTradesWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MyTradesWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// MyTradesWsRequest parameters for 'myTrades' websocket API
+type MyTradesWsRequest struct {
+	symbol     string
+	orderID    *int64
+	startTime  *int64
+	endTime    *int64
+	fromID     *int64
+	limit      *int
+	recvWindow *uint16
+}
+
+// NewMyTradesWsRequest init MyTradesWsRequest
+func NewMyTradesWsRequest() *MyTradesWsRequest {
+	return &MyTradesWsRequest{}
+}
+
+func (s *MyTradesWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *MyTradesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.fromID != nil {
+		m["fromId"] = *s.fromID
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'myTrades' request
+func (s *MyTradesWsApiService) Do(requestID string, request *MyTradesWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.MyTradesSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'myTrades' request and receives response
+func (s *MyTradesWsApiService) SyncDo(requestID string, request *MyTradesWsRequest) (*MyTradesWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.MyTradesSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	myTradesWsResponse := &MyTradesWsResponse{}
+	if err := json.Unmarshal(response, myTradesWsResponse); err != nil {
+		return nil, err
+	}
+
+	return myTradesWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *MyTradesWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *MyTradesWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *MyTradesWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *MyTradesWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *MyTradesWsRequest) Symbol(symbol string) *MyTradesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *MyTradesWsRequest) OrderID(orderID int64) *MyTradesWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *MyTradesWsRequest) StartTime(startTime int64) *MyTradesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MyTradesWsRequest) EndTime(endTime int64) *MyTradesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// FromID set fromID
+func (s *MyTradesWsRequest) FromID(fromID int64) *MyTradesWsRequest {
+	s.fromID = &fromID
+	return s
+}
+
+// Limit set limit
+func (s *MyTradesWsRequest) Limit(limit int) *MyTradesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *MyTradesWsRequest) RecvWindow(recvWindow uint16) *MyTradesWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// MyTradesWsResponse define 'myTrades' websocket API response
+type MyTradesWsResponse struct {
+	Id     string     `json:"id"`
+	Status int        `json:"status"`
+	Result []*TradeV3 `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type myTradesServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *MyTradesWsApiService
+	request   *MyTradesWsRequest
+}
+
+func TestMyTradesServiceWs(t *testing.T) {
+	suite.Run(t, new(myTradesServiceWsTestSuite))
+}
+
+func (s *myTradesServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &MyTradesWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewMyTradesWsRequest().
+		Symbol("BTCUSDT").
+		FromID(1650).
+		Limit(2)
+}
+
+func (s *myTradesServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTrades() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"myTrades"`)
+		s.Contains(string(data), `"fromId":1650`)
+		s.Contains(string(data), `"limit":2`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTrades_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTrades_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *myTradesServiceWsTestSuite) TestMyTradesSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": [
+			{
+				"symbol": "BTCUSDT",
+				"id": 1650,
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"price": "23416.50000000",
+				"qty": "0.00212000",
+				"quoteQty": "49.64298000",
+				"commission": "0.00000000",
+				"commissionAsset": "BNB",
+				"time": 1660801715793,
+				"isBuyer": false,
+				"isMaker": true,
+				"isBestMatch": true
+			}
+		]
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Require().Len(response.Result, 1)
+	s.Equal(int64(1650), response.Result[0].ID)
+	s.Equal("0.00212000", response.Result[0].Quantity)
+	s.True(response.Result[0].IsMaker)
+}
@@ -0,0 +1,192 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OpenOrdersCancelAllWsApiService cancels all open orders of a symbol, including order lists
+type OpenOrdersCancelAllWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewOpenOrdersCancelAllWsApiService init OpenOrdersCancelAllWsApiService
+func (c *Client) NewOpenOrdersCancelAllWsApiService() (*OpenOrdersCancelAllWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenOrdersCancelAllWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// OpenOrdersCancelAllWsRequest parameters for 'openOrders.cancelAll' websocket API
+type OpenOrdersCancelAllWsRequest struct {
+	symbol     string
+	recvWindow *uint16
+}
+
+// NewOpenOrdersCancelAllWsRequest init OpenOrdersCancelAllWsRequest
+func NewOpenOrdersCancelAllWsRequest() *OpenOrdersCancelAllWsRequest {
+	return &OpenOrdersCancelAllWsRequest{}
+}
+
+func (s *OpenOrdersCancelAllWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OpenOrdersCancelAllWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'openOrders.cancelAll' request
+func (s *OpenOrdersCancelAllWsApiService) Do(requestID string, request *OpenOrdersCancelAllWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.OpenOrdersCancelAllSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'openOrders.cancelAll' request and receives response
+func (s *OpenOrdersCancelAllWsApiService) SyncDo(requestID string, request *OpenOrdersCancelAllWsRequest) (*CancelOpenOrdersWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OpenOrdersCancelAllSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cancelOpenOrdersWsResponse := &CancelOpenOrdersWsResponse{}
+	if err := json.Unmarshal(response, cancelOpenOrdersWsResponse); err != nil {
+		return nil, err
+	}
+
+	return cancelOpenOrdersWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OpenOrdersCancelAllWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OpenOrdersCancelAllWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OpenOrdersCancelAllWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OpenOrdersCancelAllWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OpenOrdersCancelAllWsRequest) Symbol(symbol string) *OpenOrdersCancelAllWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OpenOrdersCancelAllWsRequest) RecvWindow(recvWindow uint16) *OpenOrdersCancelAllWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// CancelOpenOrdersWsResponse define 'openOrders.cancelAll' websocket API response
+type CancelOpenOrdersWsResponse struct {
+	Id     string                   `json:"id"`
+	Status int                      `json:"status"`
+	Result CancelOpenOrdersResponse `json:"-"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON split the canceled orders and order lists of the result
+func (r *CancelOpenOrdersWsResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Id     string            `json:"id"`
+		Status int               `json:"status"`
+		Result []json.RawMessage `json:"result"`
+		Error  *common.APIError  `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id = raw.Id
+	r.Status = raw.Status
+	r.Error = raw.Error
+	r.Result = CancelOpenOrdersResponse{}
+	for _, j := range raw.Result {
+		o := new(CancelOrderResponse)
+		if err := json.Unmarshal(j, o); err != nil {
+			return err
+		}
+		// Non-OCO orders guaranteed to have order list ID of -1
+		if o.OrderListID == -1 {
+			r.Result.Orders = append(r.Result.Orders, o)
+			continue
+		}
+		oco := new(CancelOCOResponse)
+		if err := json.Unmarshal(j, oco); err != nil {
+			return err
+		}
+		r.Result.OCOOrders = append(r.Result.OCOOrders, oco)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type openOrdersCancelAllServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *OpenOrdersCancelAllWsApiService
+	request   *OpenOrdersCancelAllWsRequest
+}
+
+func TestOpenOrdersCancelAllServiceWs(t *testing.T) {
+	suite.Run(t, new(openOrdersCancelAllServiceWsTestSuite))
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OpenOrdersCancelAllWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOpenOrdersCancelAllWsRequest().
+		Symbol("BTCUSDT")
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAll() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"openOrders.cancelAll"`)
+		s.Contains(string(data), `"symbol":"BTCUSDT"`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAll_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAll_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAllSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": [
+			{
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "4d96324ff9d44481926157",
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"clientOrderId": "91fe37ce9e69c90d6358c0",
+				"price": "23416.10000000",
+				"origQty": "0.00847000",
+				"executedQty": "0.00001000",
+				"status": "CANCELED",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL"
+			},
+			{
+				"orderListId": 19431,
+				"contingencyType": "OCO",
+				"listStatusType": "ALL_DONE",
+				"listOrderStatus": "ALL_DONE",
+				"listClientOrderId": "iuVNVJYYrByz6C4yGOPPK0",
+				"transactionTime": 1660803702431,
+				"symbol": "BTCUSDT",
+				"orders": [
+					{"symbol": "BTCUSDT", "orderId": 12569099458, "clientOrderId": "bX5wROblo6YeDwa9iTLeyY"},
+					{"symbol": "BTCUSDT", "orderId": 12569099459, "clientOrderId": "Tnu2IP0J5Y4mxw3IATBfmW"}
+				]
+			}
+		]
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Require().Len(response.Result.Orders, 1)
+	s.Equal(int64(12569099453), response.Result.Orders[0].OrderID)
+	s.Require().Len(response.Result.OCOOrders, 1)
+	s.Equal(int64(19431), response.Result.OCOOrders[0].OrderListID)
+	s.Len(response.Result.OCOOrders[0].Orders, 2)
+}
+
+func (s *openOrdersCancelAllServiceWsTestSuite) TestOpenOrdersCancelAllSyncError() {
+	rawResponseData := []byte(`{"id":"e2a85d9f-07a5-4f94-8d5f-789dc3deb097","status":400,"error":{"code":-2011,"msg":"Unknown order sent."}}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(400, response.Status)
+	s.Require().NotNil(response.Error)
+	s.Equal(int64(-2011), response.Error.Code)
+	s.Empty(response.Result.Orders)
+}
@@ -0,0 +1,159 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OpenOrdersStatusWsApiService queries open orders
+type OpenOrdersStatusWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewOpenOrdersStatusWsApiService init OpenOrdersStatusWsApiService
+func (c *Client) NewOpenOrdersStatusWsApiService() (*OpenOrdersStatusWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenOrdersStatusWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// OpenOrdersStatusWsRequest parameters for 'openOrders.status' websocket API
+type OpenOrdersStatusWsRequest struct {
+	symbol     *string
+	recvWindow *uint16
+}
+
+// NewOpenOrdersStatusWsRequest init OpenOrdersStatusWsRequest
+func NewOpenOrdersStatusWsRequest() *OpenOrdersStatusWsRequest {
+	return &OpenOrdersStatusWsRequest{}
+}
+
+func (s *OpenOrdersStatusWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OpenOrdersStatusWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'openOrders.status' request
+func (s *OpenOrdersStatusWsApiService) Do(requestID string, request *OpenOrdersStatusWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.OpenOrdersStatusSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'openOrders.status' request and receives response
+func (s *OpenOrdersStatusWsApiService) SyncDo(requestID string, request *OpenOrdersStatusWsRequest) (*OpenOrdersStatusWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OpenOrdersStatusSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	openOrdersStatusWsResponse := &OpenOrdersStatusWsResponse{}
+	if err := json.Unmarshal(response, openOrdersStatusWsResponse); err != nil {
+		return nil, err
+	}
+
+	return openOrdersStatusWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OpenOrdersStatusWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OpenOrdersStatusWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OpenOrdersStatusWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OpenOrdersStatusWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OpenOrdersStatusWsRequest) Symbol(symbol string) *OpenOrdersStatusWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OpenOrdersStatusWsRequest) RecvWindow(recvWindow uint16) *OpenOrdersStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// OpenOrdersStatusWsResponse define 'openOrders.status' websocket API response
+type OpenOrdersStatusWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Order `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
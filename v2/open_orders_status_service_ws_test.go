@@ -0,0 +1,102 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type openOrdersStatusServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *OpenOrdersStatusWsApiService
+	request   *OpenOrdersStatusWsRequest
+}
+
+func TestOpenOrdersStatusServiceWs(t *testing.T) {
+	suite.Run(t, new(openOrdersStatusServiceWsTestSuite))
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OpenOrdersStatusWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOpenOrdersStatusWsRequest().
+		Symbol("BTCUSDT")
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatus() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"openOrders.status"`)
+		s.Contains(string(data), `"symbol":"BTCUSDT"`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatus_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatus_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *openOrdersStatusServiceWsTestSuite) TestOpenOrdersStatusSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": [
+			{
+				"symbol": "BTCUSDT",
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"clientOrderId": "4d96324ff9d44481926157",
+				"price": "23416.10000000",
+				"origQty": "0.00847000",
+				"executedQty": "0.00720000",
+				"status": "PARTIALLY_FILLED",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL"
+			}
+		]
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Require().Len(response.Result, 1)
+	s.Equal(int64(12569099453), response.Result[0].OrderID)
+	s.Equal(OrderStatusTypePartiallyFilled, response.Result[0].Status)
+}
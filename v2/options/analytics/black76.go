@@ -0,0 +1,190 @@
+package analytics
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrPriceOutOfBounds is returned when no volatility gives the price of an option
+	ErrPriceOutOfBounds = errors.New("analytics: option price out of bounds")
+	// ErrNoConvergence is returned when the implied volatility can't be solved in IVMaxIterations
+	ErrNoConvergence = errors.New("analytics: implied volatility did not converge")
+	// ErrInvalidInput is returned when the forward, strike or time to expiry are not positive
+	ErrInvalidInput = errors.New("analytics: invalid pricing input")
+)
+
+var (
+	// IVTolerance is the price tolerance of the implied volatility solver
+	IVTolerance = 1e-8
+	// IVMaxIterations is the maximum number of iterations of the implied volatility solver
+	IVMaxIterations = 100
+	// IVMax is the upper bound of the implied volatilities searched by the solver
+	IVMax = 10.0
+)
+
+// Greeks define the sensitivities of an option price. Vega and Rho are for a change of
+// 1% of the volatility and rate, Theta is for one calendar day.
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Vega  float64
+	Theta float64
+	Rho   float64
+}
+
+// Add add the Greeks of o scaled by quantity to g
+func (g *Greeks) Add(o Greeks, quantity float64) {
+	g.Delta += o.Delta * quantity
+	g.Gamma += o.Gamma * quantity
+	g.Vega += o.Vega * quantity
+	g.Theta += o.Theta * quantity
+	g.Rho += o.Rho * quantity
+}
+
+// Price return the Black-76 price of an option, forward is the forward price of the
+// underlying, t the time to expiry in years, vol the annual volatility and rate the risk-free rate.
+// The discounted intrinsic value is returned once expired or for a null volatility.
+func Price(optionType OptionType, forward, strike, t, vol, rate float64) float64 {
+	df := math.Exp(-rate * t)
+	if t <= 0 || vol <= 0 {
+		return df * intrinsic(optionType, forward, strike)
+	}
+	d1, d2 := d1d2(forward, strike, t, vol)
+	if optionType == OptionTypePut {
+		return df * (strike*normCDF(-d2) - forward*normCDF(-d1))
+	}
+	return df * (forward*normCDF(d1) - strike*normCDF(d2))
+}
+
+// ComputeGreeks return the Black-76 Greeks of an option, the inputs are the ones of Price
+func ComputeGreeks(optionType OptionType, forward, strike, t, vol, rate float64) Greeks {
+	df := math.Exp(-rate * t)
+	if t <= 0 || vol <= 0 {
+		g := Greeks{}
+		switch {
+		case optionType == OptionTypeCall && forward > strike:
+			g.Delta = df
+		case optionType == OptionTypePut && forward < strike:
+			g.Delta = -df
+		}
+		return g
+	}
+	d1, _ := d1d2(forward, strike, t, vol)
+	sqrtT := math.Sqrt(t)
+	pdf := normPDF(d1)
+	price := Price(optionType, forward, strike, t, vol, rate)
+
+	g := Greeks{
+		Gamma: df * pdf / (forward * vol * sqrtT),
+		Vega:  df * forward * pdf * sqrtT / 100,
+		Theta: (-df*forward*pdf*vol/(2*sqrtT) + rate*price) / 365,
+		Rho:   -t * price / 100,
+	}
+	if optionType == OptionTypePut {
+		g.Delta = -df * normCDF(-d1)
+	} else {
+		g.Delta = df * normCDF(d1)
+	}
+	return g
+}
+
+// ImpliedVol solve the volatility giving price with the Black-76 model, using Newton's method
+// safeguarded by bisection
+func ImpliedVol(optionType OptionType, price, forward, strike, t, rate float64) (float64, error) {
+	if forward <= 0 || strike <= 0 || t <= 0 {
+		return 0, ErrInvalidInput
+	}
+	df := math.Exp(-rate * t)
+	lower := df * intrinsic(optionType, forward, strike)
+	upper := df * forward
+	if optionType == OptionTypePut {
+		upper = df * strike
+	}
+	if price < lower-IVTolerance || price >= upper {
+		return 0, ErrPriceOutOfBounds
+	}
+	if price <= lower {
+		return 0, nil
+	}
+
+	lo, hi := 0.0, IVMax
+	if Price(optionType, forward, strike, t, hi, rate) < price {
+		return 0, ErrPriceOutOfBounds
+	}
+	// start from the Brenner-Subrahmanyam approximation
+	vol := math.Sqrt(2*math.Pi/t) * price / (df * forward)
+	if vol <= lo || vol >= hi {
+		vol = 0.5
+	}
+	for i := 0; i < IVMaxIterations; i++ {
+		diff := Price(optionType, forward, strike, t, vol, rate) - price
+		if math.Abs(diff) < IVTolerance {
+			return vol, nil
+		}
+		if diff > 0 {
+			hi = vol
+		} else {
+			lo = vol
+		}
+		vega := ComputeGreeks(optionType, forward, strike, t, vol, rate).Vega * 100
+		next := vol - diff/vega
+		if vega <= 0 || math.IsNaN(next) || next <= lo || next >= hi {
+			next = (lo + hi) / 2
+		}
+		vol = next
+	}
+	return 0, ErrNoConvergence
+}
+
+// QuoteVols define the implied volatilities of the bid, ask and mid prices of an option,
+// a volatility is 0 when the price is missing or can't be solved
+type QuoteVols struct {
+	Bid float64
+	Ask float64
+	Mid float64
+}
+
+// ImpliedVolsFromQuote solve the implied volatilities of the bid, ask and mid prices,
+// a null price means that there is no order on that side
+func ImpliedVolsFromQuote(optionType OptionType, bid, ask, forward, strike, t, rate float64) QuoteVols {
+	solve := func(price float64) float64 {
+		if price <= 0 {
+			return 0
+		}
+		vol, err := ImpliedVol(optionType, price, forward, strike, t, rate)
+		if err != nil {
+			return 0
+		}
+		return vol
+	}
+	q := QuoteVols{
+		Bid: solve(bid),
+		Ask: solve(ask),
+	}
+	if bid > 0 && ask > 0 {
+		q.Mid = solve((bid + ask) / 2)
+	}
+	return q
+}
+
+func intrinsic(optionType OptionType, forward, strike float64) float64 {
+	if optionType == OptionTypePut {
+		return math.Max(strike-forward, 0)
+	}
+	return math.Max(forward-strike, 0)
+}
+
+func d1d2(forward, strike, t, vol float64) (float64, float64) {
+	volSqrtT := vol * math.Sqrt(t)
+	d1 := (math.Log(forward/strike) + vol*vol*t/2) / volSqrtT
+	return d1, d1 - volSqrtT
+}
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
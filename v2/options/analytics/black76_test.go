@@ -0,0 +1,91 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrice(t *testing.T) {
+	// at the money, no rate: F * (2N(sigma*sqrt(t)/2) - 1)
+	expected := 100 * (2*normCDF(0.2/2) - 1)
+	assert.InDelta(t, expected, Price(OptionTypeCall, 100, 100, 1, 0.2, 0), 1e-12)
+	assert.InDelta(t, 7.965567, Price(OptionTypeCall, 100, 100, 1, 0.2, 0), 1e-6)
+
+	// put-call parity: C - P = df * (F - K)
+	for _, strike := range []float64{80, 100, 125} {
+		call := Price(OptionTypeCall, 100, strike, 0.5, 0.6, 0.05)
+		put := Price(OptionTypePut, 100, strike, 0.5, 0.6, 0.05)
+		assert.InDelta(t, math.Exp(-0.05*0.5)*(100-strike), call-put, 1e-10)
+	}
+
+	// expired options are worth their intrinsic value
+	assert.Equal(t, 20.0, Price(OptionTypeCall, 120, 100, 0, 0.5, 0.05))
+	assert.Equal(t, 0.0, Price(OptionTypePut, 120, 100, 0, 0.5, 0.05))
+}
+
+func TestComputeGreeks(t *testing.T) {
+	const forward, strike, expiry, vol, rate = 100.0, 110.0, 0.25, 0.5, 0.03
+	for _, optionType := range []OptionType{OptionTypeCall, OptionTypePut} {
+		g := ComputeGreeks(optionType, forward, strike, expiry, vol, rate)
+		price := func(f, t, v, r float64) float64 {
+			return Price(optionType, f, strike, t, v, r)
+		}
+		const h = 1e-4
+		delta := (price(forward+h, expiry, vol, rate) - price(forward-h, expiry, vol, rate)) / (2 * h)
+		gamma := (price(forward+h, expiry, vol, rate) - 2*price(forward, expiry, vol, rate) + price(forward-h, expiry, vol, rate)) / (h * h)
+		vega := (price(forward, expiry, vol+h, rate) - price(forward, expiry, vol-h, rate)) / (2 * h) / 100
+		theta := -(price(forward, expiry+h, vol, rate) - price(forward, expiry-h, vol, rate)) / (2 * h) / 365
+		rho := (price(forward, expiry, vol, rate+h) - price(forward, expiry, vol, rate-h)) / (2 * h) / 100
+		assert.InDelta(t, delta, g.Delta, 1e-6, optionType)
+		assert.InDelta(t, gamma, g.Gamma, 1e-4, optionType)
+		assert.InDelta(t, vega, g.Vega, 1e-6, optionType)
+		assert.InDelta(t, theta, g.Theta, 1e-6, optionType)
+		assert.InDelta(t, rho, g.Rho, 1e-6, optionType)
+	}
+}
+
+func TestGreeksAdd(t *testing.T) {
+	g := Greeks{Delta: 1}
+	g.Add(Greeks{Delta: 0.5, Gamma: 0.1, Vega: 2, Theta: -1, Rho: 0.2}, -2)
+	assert.Equal(t, Greeks{Delta: 0, Gamma: -0.2, Vega: -4, Theta: 2, Rho: -0.4}, g)
+}
+
+func TestImpliedVol(t *testing.T) {
+	for _, vol := range []float64{0.05, 0.3, 0.8, 2.5} {
+		for _, strike := range []float64{50, 95, 100, 105, 200} {
+			for _, optionType := range []OptionType{OptionTypeCall, OptionTypePut} {
+				price := Price(optionType, 100, strike, 0.1, vol, 0.02)
+				// the volatility can't be recovered without time value
+				if price-math.Exp(-0.02*0.1)*intrinsic(optionType, 100, strike) < 1e-6 {
+					continue
+				}
+				iv, err := ImpliedVol(optionType, price, 100, strike, 0.1, 0.02)
+				assert.NoError(t, err)
+				assert.InDelta(t, vol, iv, 1e-5, "%s %v %v", optionType, strike, vol)
+			}
+		}
+	}
+
+	_, err := ImpliedVol(OptionTypeCall, 150, 100, 100, 1, 0)
+	assert.ErrorIs(t, err, ErrPriceOutOfBounds)
+	_, err = ImpliedVol(OptionTypeCall, 5, 100, 80, 1, 0)
+	assert.ErrorIs(t, err, ErrPriceOutOfBounds)
+	_, err = ImpliedVol(OptionTypeCall, 5, 100, 100, 0, 0)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestImpliedVolsFromQuote(t *testing.T) {
+	bid := Price(OptionTypeCall, 100, 100, 0.5, 0.4, 0)
+	ask := Price(OptionTypeCall, 100, 100, 0.5, 0.5, 0)
+	q := ImpliedVolsFromQuote(OptionTypeCall, bid, ask, 100, 100, 0.5, 0)
+	assert.InDelta(t, 0.4, q.Bid, 1e-6)
+	assert.InDelta(t, 0.5, q.Ask, 1e-6)
+	assert.True(t, q.Bid < q.Mid && q.Mid < q.Ask)
+
+	q = ImpliedVolsFromQuote(OptionTypeCall, 0, ask, 100, 100, 0.5, 0)
+	assert.Equal(t, 0.0, q.Bid)
+	assert.Equal(t, 0.0, q.Mid)
+	assert.InDelta(t, 0.5, q.Ask, 1e-6)
+}
@@ -0,0 +1,132 @@
+// Package analytics prices European options with the Black-76 model, solves implied
+// volatilities, builds volatility surfaces from tickers and aggregates the Greeks of positions.
+// It does not send any request, the data are taken from the options package responses and events.
+package analytics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// OptionType define the type of an option
+type OptionType string
+
+const (
+	OptionTypeCall OptionType = "CALL"
+	OptionTypePut  OptionType = "PUT"
+)
+
+// ExpiryHour is the UTC hour at which the options expire
+const ExpiryHour = 8
+
+// Contract define an option contract
+type Contract struct {
+	Symbol     string
+	Underlying string
+	Expiry     time.Time
+	Strike     float64
+	Type       OptionType
+	// Unit is the quantity of underlying of one contract
+	Unit int64
+}
+
+// ParseSymbol parse an option symbol like BTC-240628-60000-C. The underlying is the base
+// asset quoted in USDT and the contract expires at ExpiryHour UTC of the expiry date.
+func ParseSymbol(symbol string) (*Contract, error) {
+	parts := strings.Split(symbol, "-")
+	if len(parts) != 4 || parts[0] == "" {
+		return nil, fmt.Errorf("analytics: invalid option symbol %q", symbol)
+	}
+	date, err := time.Parse("060102", parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("analytics: invalid expiry of option symbol %q: %w", symbol, err)
+	}
+	strike, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil || strike <= 0 {
+		return nil, fmt.Errorf("analytics: invalid strike of option symbol %q", symbol)
+	}
+	var optionType OptionType
+	switch parts[3] {
+	case "C":
+		optionType = OptionTypeCall
+	case "P":
+		optionType = OptionTypePut
+	default:
+		return nil, fmt.Errorf("analytics: invalid type of option symbol %q", symbol)
+	}
+	return &Contract{
+		Symbol:     symbol,
+		Underlying: parts[0] + "USDT",
+		Expiry:     date.Add(ExpiryHour * time.Hour),
+		Strike:     strike,
+		Type:       optionType,
+		Unit:       1,
+	}, nil
+}
+
+// NewContract init Contract from a symbol of the exchange info
+func NewContract(s *options.OptionSymbol) (*Contract, error) {
+	strike, err := strconv.ParseFloat(s.StrikePrice, 64)
+	if err != nil {
+		return nil, fmt.Errorf("analytics: invalid strike of option symbol %q: %w", s.Symbol, err)
+	}
+	var optionType OptionType
+	switch strings.ToUpper(s.Side) {
+	case string(OptionTypeCall):
+		optionType = OptionTypeCall
+	case string(OptionTypePut):
+		optionType = OptionTypePut
+	default:
+		return nil, fmt.Errorf("analytics: invalid side %q of option symbol %q", s.Side, s.Symbol)
+	}
+	return &Contract{
+		Symbol:     s.Symbol,
+		Underlying: s.Underlying,
+		Expiry:     time.UnixMilli(s.ExpiryDate).UTC(),
+		Strike:     strike,
+		Type:       optionType,
+		Unit:       s.Unit,
+	}, nil
+}
+
+// Contracts define the option contracts by symbol
+type Contracts map[string]*Contract
+
+// NewContracts init Contracts from the symbols of the exchange info
+func NewContracts(info *options.ExchangeInfo) (Contracts, error) {
+	contracts := make(Contracts, len(info.OptionSymbols))
+	for i := range info.OptionSymbols {
+		c, err := NewContract(&info.OptionSymbols[i])
+		if err != nil {
+			return nil, err
+		}
+		contracts[c.Symbol] = c
+	}
+	return contracts, nil
+}
+
+// Get return the contract of symbol, parsing the symbol if it is not in the exchange info
+func (c Contracts) Get(symbol string) (*Contract, error) {
+	if contract, ok := c[symbol]; ok {
+		return contract, nil
+	}
+	return ParseSymbol(symbol)
+}
+
+// TimeToExpiry return the time to the expiry in years of 365 days, 0 once expired
+func (c *Contract) TimeToExpiry(now time.Time) float64 {
+	return YearFraction(now, c.Expiry)
+}
+
+// YearFraction return the duration from start to end in years of 365 days, 0 if end is before start
+func YearFraction(start, end time.Time) float64 {
+	d := end.Sub(start)
+	if d <= 0 {
+		return 0
+	}
+	return d.Seconds() / (365 * 24 * 3600)
+}
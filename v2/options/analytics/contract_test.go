@@ -0,0 +1,69 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSymbol(t *testing.T) {
+	c, err := ParseSymbol("BTC-240628-60000-C")
+	assert.NoError(t, err)
+	assert.Equal(t, &Contract{
+		Symbol:     "BTC-240628-60000-C",
+		Underlying: "BTCUSDT",
+		Expiry:     time.Date(2024, 6, 28, 8, 0, 0, 0, time.UTC),
+		Strike:     60000,
+		Type:       OptionTypeCall,
+		Unit:       1,
+	}, c)
+
+	c, err = ParseSymbol("ETH-241227-3500.5-P")
+	assert.NoError(t, err)
+	assert.Equal(t, OptionTypePut, c.Type)
+	assert.Equal(t, 3500.5, c.Strike)
+
+	for _, symbol := range []string{"BTCUSDT", "BTC-2406-60000-C", "BTC-240628-x-C", "BTC-240628-60000-X", "-240628-60000-C"} {
+		_, err = ParseSymbol(symbol)
+		assert.Error(t, err, symbol)
+	}
+}
+
+func TestNewContracts(t *testing.T) {
+	contracts, err := NewContracts(&options.ExchangeInfo{
+		OptionSymbols: []options.OptionSymbol{
+			{
+				Symbol:      "BTC-240628-60000-P",
+				Side:        "PUT",
+				StrikePrice: "60000.000",
+				Underlying:  "BTCUSDT",
+				Unit:        1,
+				ExpiryDate:  1719561600000,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	c, err := contracts.Get("BTC-240628-60000-P")
+	assert.NoError(t, err)
+	assert.Equal(t, OptionTypePut, c.Type)
+	assert.Equal(t, 60000.0, c.Strike)
+	assert.Equal(t, time.Date(2024, 6, 28, 8, 0, 0, 0, time.UTC), c.Expiry)
+
+	// symbols missing from the exchange info are parsed
+	c, err = contracts.Get("ETH-240628-3000-C")
+	assert.NoError(t, err)
+	assert.Equal(t, "ETHUSDT", c.Underlying)
+
+	_, err = NewContracts(&options.ExchangeInfo{
+		OptionSymbols: []options.OptionSymbol{{Symbol: "BTC-240628-60000-P", Side: "X", StrikePrice: "1"}},
+	})
+	assert.Error(t, err)
+}
+
+func TestTimeToExpiry(t *testing.T) {
+	c, _ := ParseSymbol("BTC-240628-60000-C")
+	assert.InDelta(t, 1.0/365, c.TimeToExpiry(c.Expiry.Add(-24*time.Hour)), 1e-12)
+	assert.Equal(t, 0.0, c.TimeToExpiry(c.Expiry.Add(time.Second)))
+}
@@ -0,0 +1,90 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// PositionGreeks define the Greeks of a position, the Greeks are the ones of one contract
+// multiplied by the signed quantity of underlying
+type PositionGreeks struct {
+	Symbol     string
+	Underlying string
+	// Quantity is the quantity of underlying of the position, negative when short
+	Quantity float64
+	Vol      float64
+	Greeks   Greeks
+}
+
+// PositionGreeks compute the Greeks of the positions returned by PositionService. The forwards
+// and volatilities are taken from the surface, the volatility is solved from the mark price
+// of the position when the surface has no quote for its expiry.
+func (s *Surface) PositionGreeks(positions []*options.Position, now time.Time) ([]*PositionGreeks, error) {
+	res := make([]*PositionGreeks, 0, len(positions))
+	for _, p := range positions {
+		g, err := s.positionGreeks(p, now)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, g)
+	}
+	return res, nil
+}
+
+func (s *Surface) positionGreeks(p *options.Position, now time.Time) (*PositionGreeks, error) {
+	contract, err := s.contracts.Get(p.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	quantity, err := strconv.ParseFloat(p.Quantity, 64)
+	if err != nil {
+		return nil, fmt.Errorf("analytics: invalid quantity %q of position %s: %w", p.Quantity, p.Symbol, err)
+	}
+	if p.Side == "SHORT" {
+		quantity = -math.Abs(quantity)
+	}
+	quantity *= float64(contract.Unit)
+
+	index, ok := s.Index(contract.Underlying)
+	if !ok {
+		return nil, fmt.Errorf("analytics: no index price of %s", contract.Underlying)
+	}
+	t := contract.TimeToExpiry(now)
+	forward := index * math.Exp(s.rate*t)
+
+	vol, ok := s.IV(contract.Underlying, contract.Expiry, contract.Strike)
+	if !ok && t > 0 {
+		markPrice, err := strconv.ParseFloat(p.MarkPrice, 64)
+		if err != nil {
+			return nil, fmt.Errorf("analytics: invalid mark price %q of position %s: %w", p.MarkPrice, p.Symbol, err)
+		}
+		vol, err = ImpliedVol(contract.Type, markPrice, forward, contract.Strike, t, s.rate)
+		if err != nil {
+			return nil, fmt.Errorf("analytics: implied volatility of position %s: %w", p.Symbol, err)
+		}
+	}
+
+	res := &PositionGreeks{
+		Symbol:     p.Symbol,
+		Underlying: contract.Underlying,
+		Quantity:   quantity,
+		Vol:        vol,
+	}
+	res.Greeks.Add(ComputeGreeks(contract.Type, forward, contract.Strike, t, vol, s.rate), quantity)
+	return res, nil
+}
+
+// AggregateGreeks sum the Greeks of the positions by underlying
+func AggregateGreeks(positions []*PositionGreeks) map[string]Greeks {
+	res := make(map[string]Greeks)
+	for _, p := range positions {
+		g := res[p.Underlying]
+		g.Add(p.Greeks, 1)
+		res[p.Underlying] = g
+	}
+	return res
+}
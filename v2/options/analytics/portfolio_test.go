@@ -0,0 +1,45 @@
+package analytics
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionGreeks(t *testing.T) {
+	s := newTestSurface(t)
+
+	// the May expiry has a single quote, June positions use the interpolated smile
+	c, _ := ParseSymbol("BTC-240628-62500-P")
+	markPrice := Price(OptionTypePut, 60000, 62500, c.TimeToExpiry(testNow), 0.625, 0)
+	positions := []*options.Position{
+		{Symbol: "BTC-240628-62500-P", Side: "SHORT", Quantity: "-2", MarkPrice: "1"},
+		{Symbol: "BTC-240531-60000-C", Side: "LONG", Quantity: "1", MarkPrice: "1"},
+		// not on the surface, the volatility is solved from the mark price
+		{Symbol: "BTC-240726-62500-P", Side: "LONG", Quantity: "0.5",
+			MarkPrice: strconv.FormatFloat(markPrice*1.2, 'f', 8, 64)},
+	}
+	res, err := s.PositionGreeks(positions, testNow)
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+
+	assert.Equal(t, "BTCUSDT", res[0].Underlying)
+	assert.Equal(t, -2.0, res[0].Quantity)
+	assert.InDelta(t, 0.625, res[0].Vol, 1e-3)
+	g := ComputeGreeks(OptionTypePut, 60000, 62500, c.TimeToExpiry(testNow), res[0].Vol, 0)
+	assert.InDelta(t, -2*g.Delta, res[0].Greeks.Delta, 1e-9)
+	assert.InDelta(t, -2*g.Vega, res[0].Greeks.Vega, 1e-9)
+	assert.True(t, res[2].Vol > 0)
+
+	total := AggregateGreeks(res)
+	assert.Len(t, total, 1)
+	assert.InDelta(t, res[0].Greeks.Delta+res[1].Greeks.Delta+res[2].Greeks.Delta, total["BTCUSDT"].Delta, 1e-9)
+	assert.InDelta(t, res[0].Greeks.Gamma+res[1].Greeks.Gamma+res[2].Greeks.Gamma, total["BTCUSDT"].Gamma, 1e-12)
+
+	_, err = s.PositionGreeks([]*options.Position{{Symbol: "ETH-240628-3000-C", Side: "LONG", Quantity: "1"}}, testNow)
+	assert.Error(t, err)
+	_, err = s.PositionGreeks([]*options.Position{{Symbol: "BTC-240628-60000-C", Side: "LONG", Quantity: "x"}}, testNow)
+	assert.Error(t, err)
+}
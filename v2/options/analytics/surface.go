@@ -0,0 +1,182 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// SurfacePoint define the implied volatilities of an option of the surface
+type SurfacePoint struct {
+	Symbol  string
+	Strike  float64
+	Type    OptionType
+	Forward float64
+	Vols    QuoteVols
+	// Time is the time of the ticker in ms
+	Time int64
+}
+
+// Surface define the volatility surfaces of the underlyings by expiry, built from the tickers
+// of the options. The forward of an expiry is the index price of the underlying capitalized at
+// the risk-free rate. It is safe for concurrent use.
+type Surface struct {
+	mu        sync.RWMutex
+	contracts Contracts
+	rate      float64
+	indexes   map[string]float64
+	points    map[string]map[int64]map[string]*SurfacePoint
+}
+
+// NewSurface init Surface, rate is the risk-free rate used when the tickers don't provide it
+func NewSurface(contracts Contracts, rate float64) *Surface {
+	return &Surface{
+		contracts: contracts,
+		rate:      rate,
+		indexes:   make(map[string]float64),
+		points:    make(map[string]map[int64]map[string]*SurfacePoint),
+	}
+}
+
+// UpdateTicker update the surface with a ticker of the REST API received at now
+func (s *Surface) UpdateTicker(t *options.Ticker, now time.Time) error {
+	return s.update(t.Symbol, t.BidPrice, t.AskPrice, t.ExercisePrice, "", now)
+}
+
+// UpdateWsTicker update the surface with a ticker event of the websocket streams
+func (s *Surface) UpdateWsTicker(e *options.WsTickerEvent) error {
+	return s.update(e.Symbol, e.BidOpenPrice, e.AskOpenPrice, e.ExercisPrice, e.RiskFreeInterest, time.UnixMilli(e.Time))
+}
+
+func (s *Surface) update(symbol, bidPrice, askPrice, indexPrice, ratePrice string, now time.Time) error {
+	contract, err := s.contracts.Get(symbol)
+	if err != nil {
+		return err
+	}
+	index, err := strconv.ParseFloat(indexPrice, 64)
+	if err != nil || index <= 0 {
+		return fmt.Errorf("analytics: invalid index price %q of %s", indexPrice, symbol)
+	}
+	bid, _ := strconv.ParseFloat(bidPrice, 64)
+	ask, _ := strconv.ParseFloat(askPrice, 64)
+	rate := s.rate
+	if r, err := strconv.ParseFloat(ratePrice, 64); err == nil {
+		rate = r
+	}
+
+	t := contract.TimeToExpiry(now)
+	forward := index * math.Exp(rate*t)
+	point := &SurfacePoint{
+		Symbol:  symbol,
+		Strike:  contract.Strike,
+		Type:    contract.Type,
+		Forward: forward,
+		Time:    now.UnixMilli(),
+	}
+	if t > 0 {
+		point.Vols = ImpliedVolsFromQuote(contract.Type, bid, ask, forward, contract.Strike, t, rate)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.indexes[contract.Underlying] = index
+	expiries, ok := s.points[contract.Underlying]
+	if !ok {
+		expiries = make(map[int64]map[string]*SurfacePoint)
+		s.points[contract.Underlying] = expiries
+	}
+	expiry := contract.Expiry.UnixMilli()
+	if _, ok := expiries[expiry]; !ok {
+		expiries[expiry] = make(map[string]*SurfacePoint)
+	}
+	expiries[expiry][symbol] = point
+	return nil
+}
+
+// Index return the last index price of the underlying
+func (s *Surface) Index(underlying string) (float64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	index, ok := s.indexes[underlying]
+	return index, ok
+}
+
+// Expiries return the expiries of the underlying in the surface in ascending order
+func (s *Surface) Expiries(underlying string) []time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	expiries := make([]time.Time, 0, len(s.points[underlying]))
+	for expiry := range s.points[underlying] {
+		expiries = append(expiries, time.UnixMilli(expiry).UTC())
+	}
+	sort.Slice(expiries, func(i, j int) bool {
+		return expiries[i].Before(expiries[j])
+	})
+	return expiries
+}
+
+// Smile return the points of the underlying and expiry sorted by strike, calls first
+func (s *Surface) Smile(underlying string, expiry time.Time) []SurfacePoint {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	points := s.points[underlying][expiry.UnixMilli()]
+	smile := make([]SurfacePoint, 0, len(points))
+	for _, p := range points {
+		smile = append(smile, *p)
+	}
+	sort.Slice(smile, func(i, j int) bool {
+		if smile[i].Strike != smile[j].Strike {
+			return smile[i].Strike < smile[j].Strike
+		}
+		return smile[i].Type == OptionTypeCall && smile[j].Type == OptionTypePut
+	})
+	return smile
+}
+
+// IV return the mid implied volatility of the underlying and expiry at strike. The out of the
+// money option is used when both the call and the put of a strike are quoted, the volatility is
+// interpolated linearly between the strikes and flat outside of them.
+func (s *Surface) IV(underlying string, expiry time.Time, strike float64) (float64, bool) {
+	type strikeVol struct {
+		strike, vol float64
+	}
+	var vols []strikeVol
+	var current *strikeVol
+	var currentType OptionType
+	for _, p := range s.Smile(underlying, expiry) {
+		if p.Vols.Mid <= 0 {
+			continue
+		}
+		if current != nil && current.strike == p.Strike {
+			// prefer the put below the forward and the call above
+			otm := (p.Type == OptionTypePut) == (p.Strike < p.Forward)
+			if otm || currentType != OptionTypeCall && currentType != OptionTypePut {
+				current.vol = p.Vols.Mid
+				currentType = p.Type
+			}
+			continue
+		}
+		vols = append(vols, strikeVol{strike: p.Strike, vol: p.Vols.Mid})
+		current = &vols[len(vols)-1]
+		currentType = p.Type
+	}
+	if len(vols) == 0 {
+		return 0, false
+	}
+	if strike <= vols[0].strike {
+		return vols[0].vol, true
+	}
+	for i := 1; i < len(vols); i++ {
+		if strike <= vols[i].strike {
+			lo, hi := vols[i-1], vols[i]
+			w := (strike - lo.strike) / (hi.strike - lo.strike)
+			return lo.vol + w*(hi.vol-lo.vol), true
+		}
+	}
+	return vols[len(vols)-1].vol, true
+}
@@ -0,0 +1,116 @@
+package analytics
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testExpiry = time.Date(2024, 6, 28, 8, 0, 0, 0, time.UTC)
+	testNow    = testExpiry.Add(-30 * 24 * time.Hour)
+)
+
+// newTestTicker return the ticker of symbol quoted at vol +/- 1% with the index at 60000
+func newTestTicker(symbol string, vol float64) *options.Ticker {
+	c, _ := ParseSymbol(symbol)
+	t := c.TimeToExpiry(testNow)
+	format := func(v float64) string {
+		return strconv.FormatFloat(Price(c.Type, 60000, c.Strike, t, v, 0), 'f', 8, 64)
+	}
+	return &options.Ticker{
+		Symbol:        symbol,
+		BidPrice:      format(vol - 0.01),
+		AskPrice:      format(vol + 0.01),
+		ExercisePrice: "60000",
+	}
+}
+
+func newTestSurface(t *testing.T) *Surface {
+	s := NewSurface(Contracts{}, 0)
+	for symbol, vol := range map[string]float64{
+		"BTC-240628-55000-P": 0.7,
+		"BTC-240628-55000-C": 0.9,
+		"BTC-240628-60000-C": 0.6,
+		"BTC-240628-65000-C": 0.65,
+		"BTC-240628-65000-P": 0.8,
+		"BTC-240531-60000-C": 0.5,
+	} {
+		assert.NoError(t, s.UpdateTicker(newTestTicker(symbol, vol), testNow))
+	}
+	return s
+}
+
+func TestSurfaceSmile(t *testing.T) {
+	s := newTestSurface(t)
+
+	index, ok := s.Index("BTCUSDT")
+	assert.True(t, ok)
+	assert.Equal(t, 60000.0, index)
+	assert.Equal(t, []time.Time{time.Date(2024, 5, 31, 8, 0, 0, 0, time.UTC), testExpiry}, s.Expiries("BTCUSDT"))
+	assert.Empty(t, s.Expiries("ETHUSDT"))
+
+	smile := s.Smile("BTCUSDT", testExpiry)
+	assert.Len(t, smile, 5)
+	symbols := make([]string, 0, len(smile))
+	for _, p := range smile {
+		symbols = append(symbols, p.Symbol)
+	}
+	assert.Equal(t, []string{
+		"BTC-240628-55000-C", "BTC-240628-55000-P", "BTC-240628-60000-C",
+		"BTC-240628-65000-C", "BTC-240628-65000-P",
+	}, symbols)
+	assert.InDelta(t, 0.69, smile[1].Vols.Bid, 1e-6)
+	assert.InDelta(t, 0.71, smile[1].Vols.Ask, 1e-6)
+	assert.Equal(t, 60000.0, smile[1].Forward)
+	assert.Equal(t, testNow.UnixMilli(), smile[1].Time)
+}
+
+func TestSurfaceIV(t *testing.T) {
+	s := newTestSurface(t)
+
+	// the out of the money option is used at each strike
+	vol, ok := s.IV("BTCUSDT", testExpiry, 55000)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.7, vol, 1e-3)
+	vol, _ = s.IV("BTCUSDT", testExpiry, 65000)
+	assert.InDelta(t, 0.65, vol, 1e-3)
+	// interpolated between the strikes and flat outside
+	vol, _ = s.IV("BTCUSDT", testExpiry, 62500)
+	assert.InDelta(t, 0.625, vol, 1e-3)
+	vol, _ = s.IV("BTCUSDT", testExpiry, 40000)
+	assert.InDelta(t, 0.7, vol, 1e-3)
+	vol, _ = s.IV("BTCUSDT", testExpiry, 90000)
+	assert.InDelta(t, 0.65, vol, 1e-3)
+
+	_, ok = s.IV("BTCUSDT", testExpiry.Add(24*time.Hour), 60000)
+	assert.False(t, ok)
+}
+
+func TestSurfaceUpdateWsTicker(t *testing.T) {
+	s := NewSurface(Contracts{}, 0)
+	c, _ := ParseSymbol("BTC-240628-60000-C")
+	expiry := c.TimeToExpiry(testNow)
+	price := Price(OptionTypeCall, 60000*math.Exp(0.05*expiry), 60000, expiry, 0.5, 0.05)
+	err := s.UpdateWsTicker(&options.WsTickerEvent{
+		Time:             testNow.UnixMilli(),
+		Symbol:           "BTC-240628-60000-C",
+		BidOpenPrice:     strconv.FormatFloat(price, 'f', 8, 64),
+		AskOpenPrice:     strconv.FormatFloat(price, 'f', 8, 64),
+		ExercisPrice:     "60000",
+		RiskFreeInterest: "0.05",
+	})
+	assert.NoError(t, err)
+	smile := s.Smile("BTCUSDT", testExpiry)
+	assert.Len(t, smile, 1)
+	assert.InDelta(t, 0.5, smile[0].Vols.Mid, 1e-6)
+
+	err = s.UpdateWsTicker(&options.WsTickerEvent{Symbol: "BTC-240628-60000-C", ExercisPrice: ""})
+	assert.Error(t, err)
+	err = s.UpdateWsTicker(&options.WsTickerEvent{Symbol: "BTCUSDT", ExercisPrice: "60000"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,325 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// BlockTradeLeg define a leg of a block trade order
+type BlockTradeLeg struct {
+	Symbol   string   `json:"symbol"`
+	Side     SideType `json:"side"`
+	Quantity string   `json:"quantity"`
+	Price    string   `json:"price"`
+}
+
+// BlockTradeOrder define a block trade order
+type BlockTradeOrder struct {
+	BlockTradeSettlementKey string           `json:"blockTradeSettlementKey"`
+	ExpireTime              int64            `json:"expireTime"`
+	Liquidity               LiquidityType    `json:"liquidity"`
+	Status                  string           `json:"status"`
+	CreateTime              int64            `json:"createTime"`
+	UpdateTime              int64            `json:"updateTime"`
+	Legs                    []*BlockTradeLeg `json:"legs"`
+}
+
+// CreateBlockTradeOrderService create a block trade order, the returned settlement key is
+// shared with the counterparty which accepts it with AcceptBlockTradeOrderService
+type CreateBlockTradeOrderService struct {
+	c         *Client
+	liquidity LiquidityType
+	legs      []*BlockTradeLeg
+}
+
+// Liquidity set liquidity
+func (s *CreateBlockTradeOrderService) Liquidity(liquidity LiquidityType) *CreateBlockTradeOrderService {
+	s.liquidity = liquidity
+	return s
+}
+
+// Legs set legs
+func (s *CreateBlockTradeOrderService) Legs(legs ...*BlockTradeLeg) *CreateBlockTradeOrderService {
+	s.legs = legs
+	return s
+}
+
+// Do send request
+func (s *CreateBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	legs, err := json.Marshal(s.legs)
+	if err != nil {
+		return nil, err
+	}
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"liquidity": s.liquidity,
+		"legs":      string(legs),
+	})
+	return s.c.callBlockTradeOrder(ctx, r, opts...)
+}
+
+// ExtendBlockTradeOrderService extend the expire time of a block trade order by 30 minutes
+type ExtendBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *ExtendBlockTradeOrderService) BlockOrderMatchingKey(key string) *ExtendBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *ExtendBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.callBlockTradeOrder(ctx, r, opts...)
+}
+
+// CancelBlockTradeOrderService cancel a block trade order
+type CancelBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *CancelBlockTradeOrderService) BlockOrderMatchingKey(key string) *CancelBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *CancelBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) error {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	_, _, err := s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// ListBlockTradeOrdersService list the block trade orders created by the account
+type ListBlockTradeOrdersService struct {
+	c                     *Client
+	blockOrderMatchingKey *string
+	underlying            *string
+	startTime             *int64
+	endTime               *int64
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *ListBlockTradeOrdersService) BlockOrderMatchingKey(key string) *ListBlockTradeOrdersService {
+	s.blockOrderMatchingKey = &key
+	return s
+}
+
+// Underlying set underlying
+func (s *ListBlockTradeOrdersService) Underlying(underlying string) *ListBlockTradeOrdersService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ListBlockTradeOrdersService) StartTime(startTime int64) *ListBlockTradeOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListBlockTradeOrdersService) EndTime(endTime int64) *ListBlockTradeOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListBlockTradeOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/order/orders",
+		secType:  secTypeSigned,
+	}
+	m := params{}
+	if s.blockOrderMatchingKey != nil {
+		m["blockOrderMatchingKey"] = *s.blockOrderMatchingKey
+	}
+	if s.underlying != nil {
+		m["underlying"] = *s.underlying
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	r.setParams(m)
+
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*BlockTradeOrder{}, err
+	}
+	res = make([]*BlockTradeOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BlockTradeOrder{}, err
+	}
+	return res, nil
+}
+
+// AcceptBlockTradeOrderService accept a block trade order created by the counterparty
+type AcceptBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *AcceptBlockTradeOrderService) BlockOrderMatchingKey(key string) *AcceptBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *AcceptBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/block/order/execute",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.callBlockTradeOrder(ctx, r, opts...)
+}
+
+// GetBlockTradeOrderService query a block trade order by its matching key before accepting it
+type GetBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey
+func (s *GetBlockTradeOrderService) BlockOrderMatchingKey(key string) *GetBlockTradeOrderService {
+	s.blockOrderMatchingKey = key
+	return s
+}
+
+// Do send request
+func (s *GetBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/order/execute",
+		secType:  secTypeSigned,
+	}
+	r.setParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.callBlockTradeOrder(ctx, r, opts...)
+}
+
+func (c *Client) callBlockTradeOrder(ctx context.Context, r *request, opts ...RequestOption) (*BlockTradeOrder, error) {
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(BlockTradeOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BlockTrade define an executed block trade of the account
+type BlockTrade struct {
+	ParentOrderID           string                 `json:"parentOrderId"`
+	CrossType               string                 `json:"crossType"`
+	BlockTradeSettlementKey string                 `json:"blockTradeSettlementKey"`
+	Legs                    []*BlockTradeFilledLeg `json:"legs"`
+}
+
+// BlockTradeFilledLeg define an executed leg of a block trade, prices and quantities are numbers
+type BlockTradeFilledLeg struct {
+	CreateTime     int64         `json:"createTime"`
+	UpdateTime     int64         `json:"updateTime"`
+	Symbol         string        `json:"symbol"`
+	OrderID        int64         `json:"orderId"`
+	OrderPrice     float64       `json:"orderPrice"`
+	OrderQuantity  float64       `json:"orderQuantity"`
+	OrderStatus    string        `json:"orderStatus"`
+	ExecutedQty    float64       `json:"executedQty"`
+	ExecutedAmount float64       `json:"executedAmount"`
+	Fee            float64       `json:"fee"`
+	OrderType      OrderType     `json:"orderType"`
+	OrderSide      SideType      `json:"orderSide"`
+	ID             int64         `json:"id"`
+	TradeID        int64         `json:"tradeId"`
+	TradePrice     float64       `json:"tradePrice"`
+	TradeQty       float64       `json:"tradeQty"`
+	TradeTime      int64         `json:"tradeTime"`
+	Liquidity      LiquidityType `json:"liquidity"`
+	Commission     float64       `json:"commission"`
+}
+
+// ListBlockTradesService list the executed block trades of the account
+type ListBlockTradesService struct {
+	c          *Client
+	underlying *string
+	startTime  *int64
+	endTime    *int64
+}
+
+// Underlying set underlying
+func (s *ListBlockTradesService) Underlying(underlying string) *ListBlockTradesService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ListBlockTradesService) StartTime(startTime int64) *ListBlockTradesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListBlockTradesService) EndTime(endTime int64) *ListBlockTradesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListBlockTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*BlockTrade, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/user-trades",
+		secType:  secTypeSigned,
+	}
+	m := params{}
+	if s.underlying != nil {
+		m["underlying"] = *s.underlying
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	r.setParams(m)
+
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*BlockTrade{}, err
+	}
+	res = make([]*BlockTrade, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*BlockTrade{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,266 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type blockTradeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBlockTradeService(t *testing.T) {
+	suite.Run(t, new(blockTradeServiceTestSuite))
+}
+
+func (s *blockTradeServiceTestSuite) TestCreateBlockTradeOrder() {
+	data := []byte(`{
+		"blockTradeSettlementKey": "3668822b8-1baa-4a2a-ba0e-6dbe3f8e3b3e",
+		"expireTime": 1730171888109,
+		"liquidity": "TAKER",
+		"status": "RECEIVED",
+		"legs": [
+			{
+				"symbol": "BNB-241101-700-C",
+				"side": "BUY",
+				"quantity": "1.2",
+				"price": "2.8"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"liquidity": "TAKER",
+			"legs":      `[{"symbol":"BNB-241101-700-C","side":"BUY","quantity":"1.2","price":"2.8"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	leg := &BlockTradeLeg{
+		Symbol:   "BNB-241101-700-C",
+		Side:     SideTypeBuy,
+		Quantity: "1.2",
+		Price:    "2.8",
+	}
+	res, err := s.client.NewCreateBlockTradeOrderService().Liquidity(LiquidityTypeTaker).
+		Legs(leg).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&BlockTradeOrder{
+		BlockTradeSettlementKey: "3668822b8-1baa-4a2a-ba0e-6dbe3f8e3b3e",
+		ExpireTime:              1730171888109,
+		Liquidity:               LiquidityTypeTaker,
+		Status:                  "RECEIVED",
+		Legs:                    []*BlockTradeLeg{leg},
+	}, res)
+}
+
+func (s *blockTradeServiceTestSuite) TestExtendBlockTradeOrder() {
+	data := []byte(`{
+		"blockTradeSettlementKey": "12b96c28-ba05-8906-c89t-703215cfb2e6",
+		"expireTime": 1730172115801,
+		"liquidity": "MAKER",
+		"status": "RECEIVED",
+		"createTime": 1730170315803,
+		"legs": [
+			{
+				"symbol": "BNB-241101-700-C",
+				"side": "SELL",
+				"quantity": "1.66",
+				"price": "20"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"blockOrderMatchingKey": "12b96c28-ba05-8906-c89t-703215cfb2e6",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewExtendBlockTradeOrderService().
+		BlockOrderMatchingKey("12b96c28-ba05-8906-c89t-703215cfb2e6").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1730172115801), res.ExpireTime)
+	s.r().Equal(int64(1730170315803), res.CreateTime)
+	s.r().Equal(LiquidityTypeMaker, res.Liquidity)
+}
+
+func (s *blockTradeServiceTestSuite) TestCancelBlockTradeOrder() {
+	data := []byte(`{}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"blockOrderMatchingKey": "12b96c28-ba05-8906-c89t-703215cfb2e6",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	err := s.client.NewCancelBlockTradeOrderService().
+		BlockOrderMatchingKey("12b96c28-ba05-8906-c89t-703215cfb2e6").Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *blockTradeServiceTestSuite) TestListBlockTradeOrders() {
+	data := []byte(`[
+		{
+			"blockTradeSettlementKey": "1a8b6a7f-1d4c-4e9a-a9c3-0c24a8d3e0a1",
+			"expireTime": 1730172115801,
+			"liquidity": "TAKER",
+			"status": "RECEIVED",
+			"createTime": 1730170315803,
+			"updateTime": 1730170315803,
+			"legs": [
+				{
+					"symbol": "BNB-241101-700-C",
+					"side": "BUY",
+					"quantity": "1.2",
+					"price": "2.8"
+				}
+			]
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"underlying": "BNBUSDT",
+			"startTime":  1730170000000,
+			"endTime":    1730180000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListBlockTradeOrdersService().Underlying("BNBUSDT").
+		StartTime(1730170000000).EndTime(1730180000000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("1a8b6a7f-1d4c-4e9a-a9c3-0c24a8d3e0a1", res[0].BlockTradeSettlementKey)
+	s.r().Len(res[0].Legs, 1)
+	s.r().Equal(SideTypeBuy, res[0].Legs[0].Side)
+}
+
+func (s *blockTradeServiceTestSuite) TestAcceptBlockTradeOrder() {
+	data := []byte(`{
+		"blockTradeSettlementKey": "12b96c28-ba05-8906-c89t-703215cfb2e6",
+		"expireTime": 1730172115801,
+		"liquidity": "MAKER",
+		"status": "ACCEPTED",
+		"createTime": 1730170315803,
+		"legs": [
+			{
+				"symbol": "BNB-241101-700-C",
+				"side": "SELL",
+				"quantity": "1.2",
+				"price": "2.8"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"blockOrderMatchingKey": "12b96c28-ba05-8906-c89t-703215cfb2e6",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewAcceptBlockTradeOrderService().
+		BlockOrderMatchingKey("12b96c28-ba05-8906-c89t-703215cfb2e6").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("ACCEPTED", res.Status)
+}
+
+func (s *blockTradeServiceTestSuite) TestGetBlockTradeOrder() {
+	data := []byte(`{
+		"blockTradeSettlementKey": "12b96c28-ba05-8906-c89t-703215cfb2e6",
+		"expireTime": 1730172115801,
+		"liquidity": "MAKER",
+		"status": "RECEIVED",
+		"createTime": 1730170315803,
+		"legs": [
+			{
+				"symbol": "BNB-241101-700-C",
+				"side": "SELL",
+				"quantity": "1.2",
+				"price": "2.8"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"blockOrderMatchingKey": "12b96c28-ba05-8906-c89t-703215cfb2e6",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetBlockTradeOrderService().
+		BlockOrderMatchingKey("12b96c28-ba05-8906-c89t-703215cfb2e6").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("RECEIVED", res.Status)
+	s.r().Equal("2.8", res.Legs[0].Price)
+}
+
+func (s *blockTradeServiceTestSuite) TestListBlockTrades() {
+	data := []byte(`[
+		{
+			"parentOrderId": "4675011431944499201",
+			"crossType": "USER_BLOCK",
+			"legs": [
+				{
+					"createTime": 1730170445600,
+					"updateTime": 1730170445600,
+					"symbol": "BNB-241101-700-C",
+					"orderId": 4675011431944499203,
+					"orderPrice": 2.8,
+					"orderQuantity": 1.2,
+					"orderStatus": "FILLED",
+					"executedQty": 1.2,
+					"executedAmount": 3.36,
+					"fee": 0.336,
+					"orderType": "PREV_QUOTED",
+					"orderSide": "BUY",
+					"id": 1125899906900937837,
+					"tradeId": 1,
+					"tradePrice": 2.8,
+					"tradeQty": 1.2,
+					"tradeTime": 1730170445600,
+					"liquidity": "TAKER",
+					"commission": 0.336
+				}
+			],
+			"blockTradeSettlementKey": "a27fb7b2-3c7f-4b44-bd6b-bbc6a43a2bcb"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"underlying": "BNBUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListBlockTradesService().Underlying("BNBUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.r().Equal("4675011431944499201", res[0].ParentOrderID)
+	s.r().Len(res[0].Legs, 1)
+	s.r().Equal(int64(4675011431944499203), res[0].Legs[0].OrderID)
+	s.r().Equal(2.8, res[0].Legs[0].TradePrice)
+	s.r().Equal(0.336, res[0].Legs[0].Commission)
+}
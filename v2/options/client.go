@@ -71,6 +71,9 @@ type UserDataEventReasonType string
 // ForceOrderCloseType define reason type for force order
 type ForceOrderCloseType string
 
+// LiquidityType define the liquidity side of a block trade
+type LiquidityType string
+
 // Endpoints
 const (
 	baseApiMainUrl    = "https://eapi.binance.com"
@@ -177,6 +180,9 @@ const (
 	ForceOrderCloseTypeLiquidation ForceOrderCloseType = "LIQUIDATION"
 	ForceOrderCloseTypeADL         ForceOrderCloseType = "ADL"
 
+	LiquidityTypeTaker LiquidityType = "TAKER"
+	LiquidityTypeMaker LiquidityType = "MAKER"
+
 	timestampKey  = "timestamp"
 	signatureKey  = "signature"
 	recvWindowKey = "recvWindow"
@@ -248,15 +254,26 @@ type Client struct {
 	// UseDemo switch all the WS streams from production to the demo
 	UseDemo    bool
 	ProxyUrl string
+	// Endpoints overrides the endpoints selected by UseTestnet and UseDemo when set, see SetEndpoints
+	Endpoints *Endpoints
 
 	do doFunc
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+	// RateLimiter enables client-side rate limiting when set, see EnableRateLimiter
+	RateLimiter *common.RateLimiter
+	// TimeSync overrides TimeOffset with a synced offset when set, see EnableTimeSync
+	TimeSync *common.TimeSync
+	// RetryPolicy retries failed requests when set, it can be overridden with WithRetryPolicy
+	RetryPolicy *common.RetryPolicy
 }
 
 // getApiEndpoint return the base endpoint of the WS
 func (c *Client) getApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.API != "" {
+		return c.Endpoints.API
+	}
 	if c.UseTestnet {
 		return baseApiTestnetUrl
 	}
@@ -283,6 +300,9 @@ func (c *Client) getProxyUrl() *string {
 
 // getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getWsEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.Stream != "" {
+		return c.Endpoints.Stream
+	}
 	if c.UseTestnet {
 		return baseWsTestnetUrl
 	}
@@ -294,6 +314,9 @@ func (c *Client) getWsEndpoint() string {
 
 // getCombinedEndpoint return the base endpoint of the combined stream according the UseTestnet flag
 func (c *Client) getCombinedEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.CombinedStream != "" {
+		return c.Endpoints.CombinedStream
+	}
 	if c.UseTestnet {
 		return baseCombinedTestnetURL
 	}
@@ -324,7 +347,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -374,6 +397,55 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	// options are applied once so that retries send the same request
+	for _, opt := range opts {
+		opt(r)
+	}
+	policy := c.retryPolicy(r)
+	if policy != nil {
+		setClientOrderID(r)
+	}
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		data, header, err = c.doAPI(ctx, r)
+		if err == nil {
+			return data, header, nil
+		}
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			attempt--
+			continue
+		}
+		if ctx.Err() != nil || !policy.ShouldRetry(attempt, err) {
+			return data, header, err
+		}
+		switch {
+		case !common.IsUnknownStatusError(err):
+		case isOrderRequest(r):
+			// the order may have been placed, look it up before sending it again
+			orderData, orderHeader, found, qerr := c.queryPlacedOrder(ctx, r)
+			if qerr != nil {
+				return data, header, err
+			}
+			if found {
+				return orderData, orderHeader, nil
+			}
+		case r.method != http.MethodGet:
+			// e.g. a withdrawal or a transfer may have been executed, only the requests
+			// which never reached the server are resent
+			return data, header, err
+		}
+		if policy.Wait(ctx, attempt) != nil {
+			return data, header, err
+		}
+	}
+}
+
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	// wait before signing the request, so its timestamp is not stale once sent
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, &http.Header{}, err
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -396,6 +468,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	c.updateRateLimit(r, res)
 
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
@@ -422,6 +495,8 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
 		return nil, &res.Header, apiErr
 	}
 	return data, &res.Header, nil
@@ -569,6 +644,84 @@ func (c *Client) NewIncomeDownloadLinkService() *IncomeDownloadLinkService {
 	return &IncomeDownloadLinkService{c: c}
 }
 
+// NewSetMMPService init set market maker protection config service
+// POST /eapi/v1/mmpSet
+func (c *Client) NewSetMMPService() *SetMMPService {
+	return &SetMMPService{c: c}
+}
+
+// NewGetMMPService init get market maker protection config service
+// GET /eapi/v1/mmp
+func (c *Client) NewGetMMPService() *GetMMPService {
+	return &GetMMPService{c: c}
+}
+
+// NewResetMMPService init reset market maker protection service
+// POST /eapi/v1/mmpReset
+func (c *Client) NewResetMMPService() *ResetMMPService {
+	return &ResetMMPService{c: c}
+}
+
+// NewSetCountdownCancelAllService init set countdown cancel all service
+// POST /eapi/v1/countdownCancelAll
+func (c *Client) NewSetCountdownCancelAllService() *SetCountdownCancelAllService {
+	return &SetCountdownCancelAllService{c: c}
+}
+
+// NewGetCountdownCancelAllService init get countdown cancel all service
+// GET /eapi/v1/countdownCancelAll
+func (c *Client) NewGetCountdownCancelAllService() *GetCountdownCancelAllService {
+	return &GetCountdownCancelAllService{c: c}
+}
+
+// NewCountdownCancelAllHeartbeatService init countdown cancel all heartbeat service
+// POST /eapi/v1/countdownCancelAllHeartBeat
+func (c *Client) NewCountdownCancelAllHeartbeatService() *CountdownCancelAllHeartbeatService {
+	return &CountdownCancelAllHeartbeatService{c: c}
+}
+
+// NewCreateBlockTradeOrderService init create block trade order service
+// POST /eapi/v1/block/order/create
+func (c *Client) NewCreateBlockTradeOrderService() *CreateBlockTradeOrderService {
+	return &CreateBlockTradeOrderService{c: c}
+}
+
+// NewExtendBlockTradeOrderService init extend block trade order service
+// PUT /eapi/v1/block/order/create
+func (c *Client) NewExtendBlockTradeOrderService() *ExtendBlockTradeOrderService {
+	return &ExtendBlockTradeOrderService{c: c}
+}
+
+// NewCancelBlockTradeOrderService init cancel block trade order service
+// DELETE /eapi/v1/block/order/create
+func (c *Client) NewCancelBlockTradeOrderService() *CancelBlockTradeOrderService {
+	return &CancelBlockTradeOrderService{c: c}
+}
+
+// NewListBlockTradeOrdersService init list block trade orders service
+// GET /eapi/v1/block/order/orders
+func (c *Client) NewListBlockTradeOrdersService() *ListBlockTradeOrdersService {
+	return &ListBlockTradeOrdersService{c: c}
+}
+
+// NewAcceptBlockTradeOrderService init accept block trade order service
+// POST /eapi/v1/block/order/execute
+func (c *Client) NewAcceptBlockTradeOrderService() *AcceptBlockTradeOrderService {
+	return &AcceptBlockTradeOrderService{c: c}
+}
+
+// NewGetBlockTradeOrderService init get block trade order service
+// GET /eapi/v1/block/order/execute
+func (c *Client) NewGetBlockTradeOrderService() *GetBlockTradeOrderService {
+	return &GetBlockTradeOrderService{c: c}
+}
+
+// NewListBlockTradesService init list block trades service
+// GET /eapi/v1/block/user-trades
+func (c *Client) NewListBlockTradesService() *ListBlockTradesService {
+	return &ListBlockTradesService{c: c}
+}
+
 func (c *Client) NewStartUserStreamService() *StartUserStreamService {
 	return &StartUserStreamService{c: c}
 }
@@ -0,0 +1,164 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SetCountdownCancelAllService set the countdown after which all the open orders of an
+// underlying are cancelled, unless a heartbeat was sent in the meantime
+type SetCountdownCancelAllService struct {
+	c             *Client
+	underlying    string
+	countdownTime int64
+}
+
+// Underlying set underlying
+func (s *SetCountdownCancelAllService) Underlying(underlying string) *SetCountdownCancelAllService {
+	s.underlying = underlying
+	return s
+}
+
+// CountdownTime set countdownTime in milliseconds, at least 5000, 0 disables the countdown
+func (s *SetCountdownCancelAllService) CountdownTime(countdownTime int64) *SetCountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *SetCountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *SetCountdownCancelAllRsp, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":    s.underlying,
+		"countdownTime": s.countdownTime,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SetCountdownCancelAllRsp)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetCountdownCancelAllRsp define set countdown cancel all response
+type SetCountdownCancelAllRsp struct {
+	Code int64  `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// CountdownCancelAll define the countdown config of an underlying
+type CountdownCancelAll struct {
+	Underlying    string `json:"underlying"`
+	CountdownTime int64  `json:"countdownTime"`
+}
+
+// GetCountdownCancelAllService get the countdown config of an underlying
+type GetCountdownCancelAllService struct {
+	c          *Client
+	underlying *string
+}
+
+// Underlying set underlying
+func (s *GetCountdownCancelAllService) Underlying(underlying string) *GetCountdownCancelAllService {
+	s.underlying = &underlying
+	return s
+}
+
+// Do send request
+func (s *GetCountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAll)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllHeartbeat define the underlyings whose countdown was restarted by a heartbeat
+type CountdownCancelAllHeartbeat struct {
+	Underlyings []string `json:"underlyings"`
+}
+
+// CountdownCancelAllHeartbeatService restart the countdown of underlyings
+type CountdownCancelAllHeartbeatService struct {
+	c           *Client
+	underlyings []string
+}
+
+// Underlyings set underlyings
+func (s *CountdownCancelAllHeartbeatService) Underlyings(underlyings ...string) *CountdownCancelAllHeartbeatService {
+	s.underlyings = underlyings
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllHeartbeatService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAllHeartbeat, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAllHeartBeat",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("underlyings", strings.Join(s.underlyings, ","))
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAllHeartbeat)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// KeepCountdownCancelAllAlive send a countdown cancel-all heartbeat for underlyings now and then
+// every interval in background, interval should be well below the countdown time set with
+// SetCountdownCancelAllService. The failed heartbeats are reported to errHandler, doneC is closed
+// once ctx is done.
+func (c *Client) KeepCountdownCancelAllAlive(ctx context.Context, interval time.Duration, errHandler ErrHandler, underlyings ...string) (doneC chan struct{}) {
+	doneC = make(chan struct{})
+	heartbeat := func() {
+		_, err := c.NewCountdownCancelAllHeartbeatService().Underlyings(underlyings...).Do(ctx)
+		if err != nil && ctx.Err() == nil && errHandler != nil {
+			errHandler(err)
+		}
+	}
+	go func() {
+		defer close(doneC)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		heartbeat()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				heartbeat()
+			}
+		}
+	}()
+	return doneC
+}
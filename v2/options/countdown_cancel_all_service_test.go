@@ -0,0 +1,106 @@
+package options
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type countdownCancelAllServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCountdownCancelAllService(t *testing.T) {
+	suite.Run(t, new(countdownCancelAllServiceTestSuite))
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestSetCountdownCancelAll() {
+	data := []byte(`{"code": 200, "msg": "success"}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying":    "ETHUSDT",
+			"countdownTime": 30000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSetCountdownCancelAllService().Underlying("ETHUSDT").
+		CountdownTime(30000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("success", res.Msg)
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestGetCountdownCancelAll() {
+	data := []byte(`{"underlying": "ETHUSDT", "countdownTime": 100000}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"underlying": "ETHUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetCountdownCancelAllService().Underlying("ETHUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&CountdownCancelAll{Underlying: "ETHUSDT", CountdownTime: 100000}, res)
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestCountdownCancelAllHeartbeat() {
+	data := []byte(`{"underlyings": ["BTCUSDT", "ETHUSDT"]}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlyings": "BTCUSDT,ETHUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCountdownCancelAllHeartbeatService().
+		Underlyings("BTCUSDT", "ETHUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal([]string{"BTCUSDT", "ETHUSDT"}, res.Underlyings)
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestKeepCountdownCancelAllAlive() {
+	data := []byte(`{"code": -2015, "msg": "Invalid API-key, IP, or permissions for action."}`)
+	s.mockDo(data, nil, http.StatusUnauthorized)
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlyings": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	errC := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	doneC := s.client.KeepCountdownCancelAllAlive(ctx, time.Hour, func(err error) {
+		errC <- err
+	}, "BTCUSDT")
+
+	select {
+	case err := <-errC:
+		s.r().True(common.IsAPIError(err))
+	case <-time.After(time.Second):
+		s.FailNow("heartbeat not sent")
+	}
+	s.assertDo()
+
+	cancel()
+	select {
+	case <-doneC:
+	case <-time.After(time.Second):
+		s.FailNow("heartbeat not stopped")
+	}
+}
@@ -0,0 +1,36 @@
+package options
+
+// Endpoints define the base URLs used by a client, set it with Client.SetEndpoints to run clients
+// against different environments in one process. An empty URL falls back to the endpoint selected
+// by the UseTestnet and UseDemo flags.
+type Endpoints struct {
+	// API is the base URL of the REST API, e.g. https://eapi.binance.com
+	API string
+	// Stream is the base URL of the raw streams, e.g. wss://nbstream.binance.com/eoptions/ws
+	Stream string
+	// CombinedStream is the base URL of the combined streams, ending with "?streams="
+	CombinedStream string
+}
+
+// Endpoint profiles
+var (
+	// MainnetEndpoints is the production environment
+	MainnetEndpoints = Endpoints{
+		API:            "https://eapi.binance.com",
+		Stream:         "wss://nbstream.binance.com/eoptions/ws",
+		CombinedStream: "wss://nbstream.binance.com/eoptions/stream?streams=",
+	}
+)
+
+// WithAPI return a copy of the endpoints with the REST API base URL replaced, e.g. for a regional
+// proxy
+func (e Endpoints) WithAPI(url string) Endpoints {
+	e.API = url
+	return e
+}
+
+// SetEndpoints make the client use the endpoints instead of the ones selected by the UseTestnet
+// and UseDemo flags
+func (c *Client) SetEndpoints(endpoints Endpoints) {
+	c.Endpoints = &endpoints
+}
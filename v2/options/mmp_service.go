@@ -0,0 +1,139 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// MMPConfig define the market maker protection config of an underlying
+type MMPConfig struct {
+	UnderlyingID             int64  `json:"underlyingId"`
+	Underlying               string `json:"underlying"`
+	WindowTimeInMilliseconds int64  `json:"windowTimeInMilliseconds"`
+	FrozenTimeInMilliseconds int64  `json:"frozenTimeInMilliseconds"`
+	QtyLimit                 string `json:"qtyLimit"`
+	DeltaLimit               string `json:"deltaLimit"`
+	LastTriggerTime          int64  `json:"lastTriggerTime"`
+}
+
+// SetMMPService set the market maker protection config of an underlying,
+// the orders placed with isMmp are cancelled when the limits are exceeded in the window
+type SetMMPService struct {
+	c                        *Client
+	underlying               string
+	windowTimeInMilliseconds int64
+	frozenTimeInMilliseconds int64
+	qtyLimit                 string
+	deltaLimit               string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *SetMMPService) Underlying(underlying string) *SetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// WindowTimeInMilliseconds set the time window in which the executed quantity and delta are accumulated
+func (s *SetMMPService) WindowTimeInMilliseconds(windowTime int64) *SetMMPService {
+	s.windowTimeInMilliseconds = windowTime
+	return s
+}
+
+// FrozenTimeInMilliseconds set the time during which orders are rejected after MMP was triggered,
+// 0 freezes until MMP is reset
+func (s *SetMMPService) FrozenTimeInMilliseconds(frozenTime int64) *SetMMPService {
+	s.frozenTimeInMilliseconds = frozenTime
+	return s
+}
+
+// QtyLimit set qtyLimit
+func (s *SetMMPService) QtyLimit(qtyLimit string) *SetMMPService {
+	s.qtyLimit = qtyLimit
+	return s
+}
+
+// DeltaLimit set deltaLimit
+func (s *SetMMPService) DeltaLimit(deltaLimit string) *SetMMPService {
+	s.deltaLimit = deltaLimit
+	return s
+}
+
+// Do send request
+func (s *SetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMPConfig, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpSet",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":               s.underlying,
+		"windowTimeInMilliseconds": s.windowTimeInMilliseconds,
+		"frozenTimeInMilliseconds": s.frozenTimeInMilliseconds,
+		"qtyLimit":                 s.qtyLimit,
+		"deltaLimit":               s.deltaLimit,
+	})
+	return s.c.callMMP(ctx, r, opts...)
+}
+
+// GetMMPService get the market maker protection config of an underlying
+type GetMMPService struct {
+	c          *Client
+	underlying *string
+}
+
+// Underlying set underlying
+func (s *GetMMPService) Underlying(underlying string) *GetMMPService {
+	s.underlying = &underlying
+	return s
+}
+
+// Do send request
+func (s *GetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMPConfig, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/mmp",
+		secType:  secTypeSigned,
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	return s.c.callMMP(ctx, r, opts...)
+}
+
+// ResetMMPService reset the market maker protection of an underlying, so that orders are
+// accepted again before the end of the frozen time
+type ResetMMPService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying
+func (s *ResetMMPService) Underlying(underlying string) *ResetMMPService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *ResetMMPService) Do(ctx context.Context, opts ...RequestOption) (res *MMPConfig, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpReset",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("underlying", s.underlying)
+	return s.c.callMMP(ctx, r, opts...)
+}
+
+func (c *Client) callMMP(ctx context.Context, r *request, opts ...RequestOption) (*MMPConfig, error) {
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res := new(MMPConfig)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,105 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type mmpServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMMPService(t *testing.T) {
+	suite.Run(t, new(mmpServiceTestSuite))
+}
+
+func (s *mmpServiceTestSuite) TestSetMMP() {
+	data := []byte(`{
+		"underlyingId": 2,
+		"underlying": "BTCUSDT",
+		"windowTimeInMilliseconds": 3000,
+		"frozenTimeInMilliseconds": 300000,
+		"qtyLimit": "2",
+		"deltaLimit": "2.3",
+		"lastTriggerTime": 0
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying":               "BTCUSDT",
+			"windowTimeInMilliseconds": 3000,
+			"frozenTimeInMilliseconds": 300000,
+			"qtyLimit":                 "2",
+			"deltaLimit":               "2.3",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewSetMMPService().Underlying("BTCUSDT").
+		WindowTimeInMilliseconds(3000).FrozenTimeInMilliseconds(300000).
+		QtyLimit("2").DeltaLimit("2.3").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(&MMPConfig{
+		UnderlyingID:             2,
+		Underlying:               "BTCUSDT",
+		WindowTimeInMilliseconds: 3000,
+		FrozenTimeInMilliseconds: 300000,
+		QtyLimit:                 "2",
+		DeltaLimit:               "2.3",
+		LastTriggerTime:          0,
+	}, res)
+}
+
+func (s *mmpServiceTestSuite) TestGetMMP() {
+	data := []byte(`{
+		"underlyingId": 2,
+		"underlying": "BTCUSDT",
+		"windowTimeInMilliseconds": 3000,
+		"frozenTimeInMilliseconds": 300000,
+		"qtyLimit": "2",
+		"deltaLimit": "2.3",
+		"lastTriggerTime": 1677571200000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"underlying": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetMMPService().Underlying("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("BTCUSDT", res.Underlying)
+	s.r().Equal(int64(1677571200000), res.LastTriggerTime)
+}
+
+func (s *mmpServiceTestSuite) TestResetMMP() {
+	data := []byte(`{
+		"underlyingId": 2,
+		"underlying": "BTCUSDT",
+		"windowTimeInMilliseconds": 3000,
+		"frozenTimeInMilliseconds": 300000,
+		"qtyLimit": "2",
+		"deltaLimit": "2.3",
+		"lastTriggerTime": 0
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying": "BTCUSDT",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewResetMMPService().Underlying("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(0), res.LastTriggerTime)
+}
@@ -0,0 +1,147 @@
+package options
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the request weight of the endpoints with a fixed weight,
+// see https://developers.binance.com/docs/derivatives/option/general-info
+var requestWeights = map[string]int64{
+	"GET /eapi/v1/ping":                         1,
+	"GET /eapi/v1/time":                         1,
+	"GET /eapi/v1/exchangeInfo":                 1,
+	"GET /eapi/v1/index":                        1,
+	"GET /eapi/v1/mark":                         5,
+	"GET /eapi/v1/ticker":                       5,
+	"GET /eapi/v1/trades":                       5,
+	"GET /eapi/v1/historicalTrades":             20,
+	"GET /eapi/v1/klines":                       1,
+	"GET /eapi/v1/exerciseHistory":              3,
+	"GET /eapi/v1/openInterest":                 0,
+	"GET /eapi/v1/account":                      3,
+	"GET /eapi/v1/bill":                         1,
+	"POST /eapi/v1/order":                       0,
+	"POST /eapi/v1/batchOrders":                 5,
+	"GET /eapi/v1/order":                        1,
+	"DELETE /eapi/v1/order":                     1,
+	"DELETE /eapi/v1/batchOrders":               1,
+	"DELETE /eapi/v1/allOpenOrders":             1,
+	"DELETE /eapi/v1/allOpenOrdersByUnderlying": 1,
+	"GET /eapi/v1/historyOrders":                3,
+	"GET /eapi/v1/position":                     5,
+	"GET /eapi/v1/userTrades":                   5,
+	"GET /eapi/v1/exerciseRecord":               5,
+	"GET /eapi/v1/income/asyn":                  5,
+	"GET /eapi/v1/income/asyn/id":               5,
+	"POST /eapi/v1/mmpSet":                      1,
+	"GET /eapi/v1/mmp":                          1,
+	"POST /eapi/v1/mmpReset":                    1,
+	"POST /eapi/v1/countdownCancelAll":          10,
+	"GET /eapi/v1/countdownCancelAll":           1,
+	"POST /eapi/v1/countdownCancelAllHeartBeat": 10,
+	"POST /eapi/v1/block/order/create":          1,
+	"PUT /eapi/v1/block/order/create":           1,
+	"DELETE /eapi/v1/block/order/create":        1,
+	"GET /eapi/v1/block/order/orders":           5,
+	"POST /eapi/v1/block/order/execute":         1,
+	"GET /eapi/v1/block/order/execute":          5,
+	"GET /eapi/v1/block/user-trades":            5,
+}
+
+// orderEndpoints define the number of orders placed by the endpoints counted by the ORDERS rate limits
+var orderEndpoints = map[string]int64{
+	"POST /eapi/v1/order":       1,
+	"POST /eapi/v1/batchOrders": 5,
+}
+
+// param return the value of key from the query string or the form body
+func (r *request) param(key string) string {
+	if v := r.query.Get(key); v != "" {
+		return v
+	}
+	return r.form.Get(key)
+}
+
+// requestWeight return the request weight of r and the number of orders it places
+func requestWeight(r *request) (weight, orders int64) {
+	key := r.method + " " + r.endpoint
+	orders = orderEndpoints[key]
+	switch key {
+	case "GET /eapi/v1/depth":
+		limit, _ := strconv.Atoi(r.param("limit"))
+		switch {
+		case limit > 500:
+			return 20, orders
+		case limit > 100 || limit == 0:
+			return 10, orders
+		case limit > 50:
+			return 5, orders
+		default:
+			return 2, orders
+		}
+	case "GET /eapi/v1/openOrders":
+		if r.param("symbol") != "" {
+			return 1, orders
+		}
+		return 40, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
+
+// isRateLimited return true for the endpoints counted by the exchangeInfo rate limits
+func isRateLimited(r *request) bool {
+	return strings.HasPrefix(r.endpoint, "/eapi/")
+}
+
+// EnableRateLimiter fetch the exchange rate limits and enable the client-side rate limiter.
+// When failFast is true requests exceeding a limit fail with a *common.RateLimitError
+// instead of waiting for the next window.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool, opts ...RequestOption) (*common.RateLimiter, error) {
+	res, err := c.NewExchangeInfoService().Do(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]common.RateLimitRule, 0, len(res.RateLimits))
+	for _, l := range res.RateLimits {
+		rules = append(rules, common.RateLimitRule{
+			RateLimitType: l.RateLimitType,
+			Interval:      l.Interval,
+			IntervalNum:   l.IntervalNum,
+			Limit:         l.Limit,
+		})
+	}
+	limiter := common.NewRateLimiter(rules)
+	limiter.FailFast = failFast
+	c.RateLimiter = limiter
+	return limiter, nil
+}
+
+// waitRateLimit block until r fits the rate limits of the client
+func (c *Client) waitRateLimit(ctx context.Context, r *request) error {
+	if c.RateLimiter == nil || !isRateLimited(r) {
+		return nil
+	}
+	weight, orders := requestWeight(r)
+	return c.RateLimiter.Wait(ctx, weight, orders)
+}
+
+// updateRateLimit reconcile the rate limiter with the response, the 429 and 418 responses
+// of every endpoint pause the limiter
+func (c *Client) updateRateLimit(r *request, res *http.Response) {
+	if c.RateLimiter == nil {
+		return
+	}
+	if !isRateLimited(r) {
+		c.RateLimiter.UpdateRetryAfter(res.StatusCode, res.Header)
+		return
+	}
+	c.RateLimiter.Update(res.StatusCode, res.Header)
+}
@@ -0,0 +1,102 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	gorilla "github.com/gorilla/websocket"
+)
+
+// StreamManager multiplexes market streams over combined stream connections.
+// Streams are added and removed without reconnecting, spread over several
+// connections past 1024 streams, and subscribed again after a reconnect.
+type StreamManager struct {
+	*websocket.StreamManager
+	errHandler ErrHandler
+}
+
+// NewStreamManager init a StreamManager on the combined stream endpoint
+func (c *Client) NewStreamManager(errHandler ErrHandler) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	dial := func(streams []string, handler func(message []byte), errHandler func(err error), onConn func(conn *gorilla.Conn)) (doneC, stopC chan struct{}, err error) {
+		endpoint := c.getCombinedEndpoint() + strings.Join(streams, "/")
+		if len(streams) == 0 {
+			endpoint = strings.TrimSuffix(endpoint, "?streams=")
+		}
+		cfg := newWsConfig(endpoint, c.getProxyUrl())
+		return wsServeWithConnHandler(cfg, handler, errHandler, func(ctx context.Context, conn *gorilla.Conn) {
+			onConn(conn)
+		})
+	}
+	return &StreamManager{
+		StreamManager: websocket.NewStreamManager(dial, errHandler),
+		errHandler:    errHandler,
+	}
+}
+
+// symbolStreams build the stream names of symbols, the symbols of option streams are upper case
+func symbolStreams(symbols []string, format string) []string {
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		streams[i] = fmt.Sprintf(format, strings.ToUpper(symbol))
+	}
+	return streams
+}
+
+func (m *StreamManager) subscribe(streams []string, handler func(data []byte)) ([]string, error) {
+	err := m.Subscribe(func(stream string, data []byte) {
+		handler(data)
+	}, streams...)
+	return streams, err
+}
+
+// SubscribeTrade subscribe the trade streams of symbols or underlyings and return their names
+func (m *StreamManager) SubscribeTrade(symbols []string, handler WsTradeHandler) ([]string, error) {
+	return m.subscribe(symbolStreams(symbols, "%s@trade"), func(data []byte) {
+		wsTradeServeHandler(data, handler, m.errHandler)
+	})
+}
+
+// SubscribeIndex subscribe the index price streams of underlyings, e.g. ETHUSDT, and return their names
+func (m *StreamManager) SubscribeIndex(underlyings []string, handler WsIndexHandler) ([]string, error) {
+	return m.subscribe(symbolStreams(underlyings, "%s@index"), func(data []byte) {
+		wsIndexServeHandler(data, handler, m.errHandler)
+	})
+}
+
+// SubscribeMarkPrice subscribe the mark price streams of underlyings, e.g. ETH, and return their names
+func (m *StreamManager) SubscribeMarkPrice(underlyings []string, handler WsMarkPriceHandler) ([]string, error) {
+	return m.subscribe(symbolStreams(underlyings, "%s@markPrice"), func(data []byte) {
+		wsMarkPriceServeHandler(data, handler, m.errHandler)
+	})
+}
+
+// SubscribeKline subscribe the kline streams of symbols at interval and return their names
+func (m *StreamManager) SubscribeKline(symbols []string, interval string, handler WsKlineHandler) ([]string, error) {
+	return m.subscribe(symbolStreams(symbols, "%s@kline_"+interval), func(data []byte) {
+		wsKlineServeHandler(data, handler, m.errHandler)
+	})
+}
+
+// SubscribeTicker subscribe the 24hr ticker streams of symbols and return their names
+func (m *StreamManager) SubscribeTicker(symbols []string, handler WsTickerHandler) ([]string, error) {
+	return m.subscribe(symbolStreams(symbols, "%s@ticker"), func(data []byte) {
+		wsTickerServeHandler(data, handler, m.errHandler)
+	})
+}
+
+// SubscribeDepth subscribe the depth streams of symbols and return their names, levels is 10, 20, 50, 100 or 1000
+func (m *StreamManager) SubscribeDepth(symbols []string, levels string, handler WsDepthHandler) ([]string, error) {
+	switch levels {
+	case "10", "20", "50", "100", "1000":
+	default:
+		return nil, fmt.Errorf("invalid level %s", levels)
+	}
+	return m.subscribe(symbolStreams(symbols, "%s@depth"+levels), func(data []byte) {
+		wsDepthServeHandler(data, handler, m.errHandler)
+	})
+}
@@ -0,0 +1,57 @@
+package options
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// StreamTrades subscribe to the trade stream of symbol or underlying, see websocket.Subscription
+func (c *Client) StreamTrades(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsTradeEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsTradeEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamIndex subscribe to the index price stream of underlying, e.g. ETHUSDT
+func (c *Client) StreamIndex(ctx context.Context, underlying string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsIndexEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsIndexEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsIndexServe(underlying, handler, errHandler)
+	}, opts...)
+}
+
+// StreamMarkPrice subscribe to the mark price stream of the options of underlying, e.g. ETH
+func (c *Client) StreamMarkPrice(ctx context.Context, underlying string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[[]*WsMarkPriceEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func([]*WsMarkPriceEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsMarkPriceServe(underlying, handler, errHandler)
+	}, opts...)
+}
+
+// StreamKlines subscribe to the kline stream of symbol
+func (c *Client) StreamKlines(ctx context.Context, symbol string, interval string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsKlineEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsKlineEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// StreamTicker subscribe to the 24hr ticker stream of symbol
+func (c *Client) StreamTicker(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[[]*WsTickerEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func([]*WsTickerEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamDepth subscribe to the depth stream of symbol, see WsDepthServe for levels and rate
+func (c *Client) StreamDepth(ctx context.Context, symbol string, levels string, rate *time.Duration, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsDepthEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsDepthEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsDepthServe(symbol, levels, rate, handler, errHandler)
+	}, opts...)
+}
+
+// StreamUserData subscribe to the user data stream of listenKey
+func (c *Client) StreamUserData(ctx context.Context, listenKey string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsUserDataEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsUserDataEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
@@ -0,0 +1,44 @@
+package options
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// EnableTimeSync sync the client clock with the server and keep it synced every interval
+// in background. The offset is shared with the websocket API services created from the
+// client, and signed requests failing with -1021 are retried once after a re-sync.
+// Call Stop on the returned TimeSync to stop the background sync.
+func (c *Client) EnableTimeSync(ctx context.Context, interval time.Duration) (*common.TimeSync, error) {
+	ts := common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+	if err := ts.Sync(ctx); err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		ts.Start(interval)
+	}
+	c.TimeSync = ts
+	return ts, nil
+}
+
+// timeOffset return the offset applied to the timestamp of signed requests
+func (c *Client) timeOffset() int64 {
+	return c.TimeSync.OffsetOr(c.TimeOffset)
+}
+
+// resyncTime re-sync the clock when a signed request failed with -1021,
+// it returns true when the request should be retried
+func (c *Client) resyncTime(ctx context.Context, r *request, err error) bool {
+	if c.TimeSync == nil || r.secType != secTypeSigned || !common.IsInvalidTimestampError(err) {
+		return false
+	}
+	if serr := c.TimeSync.Sync(ctx); serr != nil {
+		c.debug("failed to sync server time: %s\n", serr)
+		return false
+	}
+	return true
+}
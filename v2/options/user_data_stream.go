@@ -0,0 +1,34 @@
+package options
+
+import (
+	"context"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// NewUserDataStream init a user data stream managing its listen key, see commonws.UserDataStream.
+// The events are delivered to handler once the stream is started.
+func (c *Client) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler, opts ...commonws.UserDataStreamOption) *commonws.UserDataStream {
+	listenKeys := commonws.ListenKeyService{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+	}
+	var stream *commonws.UserDataStream
+	serve := func(listenKey string, connErrHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsUserDataServe(listenKey, func(event *WsUserDataEvent) {
+			if event.Event == UserDataEventTypeListenKeyExpired {
+				stream.Expired()
+			}
+			handler(event)
+		}, connErrHandler)
+	}
+	stream = commonws.NewUserDataStream(listenKeys, serve, errHandler, opts...)
+	return stream
+}
@@ -1,10 +1,12 @@
 package options
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"time"
 
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 	"github.com/gorilla/websocket"
 )
 
@@ -27,7 +29,32 @@ func newWsConfig(endpoint string, proxy *string) *WsConfig {
 	}
 }
 
+var (
+	// WebsocketAutoReconnect makes the market and user data streams reconnect with backoff instead
+	// of closing doneC when their connection ends. The error handler then receives a
+	// commonws.DisconnectedEvent and a commonws.ReconnectedEvent, after which consumers keeping
+	// a state from the stream should resync.
+	WebsocketAutoReconnect = false
+	// WebsocketMaxConnectionAge is the age after which the connection of a stream is replaced
+	// if WebsocketAutoReconnect is enabled, before the server closes it after 24 hours
+	WebsocketMaxConnectionAge = commonws.MaxConnectionAge
+)
+
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	if WebsocketAutoReconnect {
+		serve := func(connErrHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return wsServeWithConnHandler(cfg, handler, connErrHandler, nil)
+		}
+		return commonws.ServeResilient(serve, errHandler, WebsocketMaxConnectionAge)
+	}
+	return wsServeWithConnHandler(cfg, handler, errHandler, nil)
+}
+
+// ConnHandler is called with the connection before its messages are read
+type ConnHandler func(context.Context, *websocket.Conn)
+
+// wsServeWithConnHandler serves websocket with a custom connection handler
+var wsServeWithConnHandler = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
 		u, err := url.Parse(*cfg.Proxy)
@@ -58,6 +85,11 @@ var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (don
 		if WebsocketKeepalive {
 			keepAlive(c, WebsocketTimeout)
 		}
+		if connHandler != nil {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			connHandler(ctx, c)
+		}
 		// Wait for the stopC channel to be closed.  We do that in a
 		// separate goroutine because ReadMessage is a blocking
 		// operation.
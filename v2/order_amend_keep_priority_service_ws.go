@@ -0,0 +1,226 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderAmendKeepPriorityWsApiService reduces the quantity of an existing open order without losing its priority
+type OrderAmendKeepPriorityWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewOrderAmendKeepPriorityWsApiService init OrderAmendKeepPriorityWsApiService
+func (c *Client) NewOrderAmendKeepPriorityWsApiService() (*OrderAmendKeepPriorityWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderAmendKeepPriorityWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// OrderAmendKeepPriorityWsRequest parameters for 'order.amend.keepPriority' websocket API
+type OrderAmendKeepPriorityWsRequest struct {
+	symbol            string
+	newQty            string
+	orderID           *int64
+	origClientOrderID *string
+	newClientOrderID  *string
+	recvWindow        *uint16
+}
+
+// NewOrderAmendKeepPriorityWsRequest init OrderAmendKeepPriorityWsRequest
+func NewOrderAmendKeepPriorityWsRequest() *OrderAmendKeepPriorityWsRequest {
+	return &OrderAmendKeepPriorityWsRequest{}
+}
+
+func (s *OrderAmendKeepPriorityWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OrderAmendKeepPriorityWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+		"newQty": s.newQty,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'order.amend.keepPriority' request
+func (s *OrderAmendKeepPriorityWsApiService) Do(requestID string, request *OrderAmendKeepPriorityWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.OrderAmendKeepPrioritySpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'order.amend.keepPriority' request and receives response
+func (s *OrderAmendKeepPriorityWsApiService) SyncDo(requestID string, request *OrderAmendKeepPriorityWsRequest) (*AmendOrderKeepPriorityWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderAmendKeepPrioritySpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	amendOrderKeepPriorityWsResponse := &AmendOrderKeepPriorityWsResponse{}
+	if err := json.Unmarshal(response, amendOrderKeepPriorityWsResponse); err != nil {
+		return nil, err
+	}
+
+	return amendOrderKeepPriorityWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OrderAmendKeepPriorityWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OrderAmendKeepPriorityWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OrderAmendKeepPriorityWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OrderAmendKeepPriorityWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OrderAmendKeepPriorityWsRequest) Symbol(symbol string) *OrderAmendKeepPriorityWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// NewQty set newQty
+func (s *OrderAmendKeepPriorityWsRequest) NewQty(newQty string) *OrderAmendKeepPriorityWsRequest {
+	s.newQty = newQty
+	return s
+}
+
+// OrderID set orderID
+func (s *OrderAmendKeepPriorityWsRequest) OrderID(orderID int64) *OrderAmendKeepPriorityWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *OrderAmendKeepPriorityWsRequest) OrigClientOrderID(origClientOrderID string) *OrderAmendKeepPriorityWsRequest {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *OrderAmendKeepPriorityWsRequest) NewClientOrderID(newClientOrderID string) *OrderAmendKeepPriorityWsRequest {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderAmendKeepPriorityWsRequest) RecvWindow(recvWindow uint16) *OrderAmendKeepPriorityWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// AmendedOrder define the order amended by 'order.amend.keepPriority'
+type AmendedOrder struct {
+	Symbol                  string                  `json:"symbol"`
+	OrderID                 int64                   `json:"orderId"`
+	OrderListID             int64                   `json:"orderListId"`
+	OrigClientOrderID       string                  `json:"origClientOrderId"`
+	ClientOrderID           string                  `json:"clientOrderId"`
+	Price                   string                  `json:"price"`
+	Quantity                string                  `json:"qty"`
+	ExecutedQuantity        string                  `json:"executedQty"`
+	PreventedQuantity       string                  `json:"preventedQty"`
+	QuoteOrderQuantity      string                  `json:"quoteOrderQty"`
+	CumulativeQuoteQuantity string                  `json:"cumulativeQuoteQty"`
+	Status                  OrderStatusType         `json:"status"`
+	TimeInForce             TimeInForceType         `json:"timeInForce"`
+	Type                    OrderType               `json:"type"`
+	Side                    SideType                `json:"side"`
+	WorkingTime             int64                   `json:"workingTime"`
+	SelfTradePreventionMode SelfTradePreventionMode `json:"selfTradePreventionMode"`
+}
+
+// AmendOrderKeepPriorityResult define order amendment result, ListStatus is set when the
+// order is part of an order list
+type AmendOrderKeepPriorityResult struct {
+	TransactTime int64              `json:"transactTime"`
+	ExecutionID  int64              `json:"executionId"`
+	AmendedOrder AmendedOrder       `json:"amendedOrder"`
+	ListStatus   *CancelOCOResponse `json:"listStatus,omitempty"`
+}
+
+// AmendOrderKeepPriorityWsResponse define 'order.amend.keepPriority' websocket API response
+type AmendOrderKeepPriorityWsResponse struct {
+	Id     string                       `json:"id"`
+	Status int                          `json:"status"`
+	Result AmendOrderKeepPriorityResult `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
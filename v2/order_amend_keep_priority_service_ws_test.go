@@ -0,0 +1,113 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderAmendKeepPriorityServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *OrderAmendKeepPriorityWsApiService
+	request   *OrderAmendKeepPriorityWsRequest
+}
+
+func TestOrderAmendKeepPriorityServiceWs(t *testing.T) {
+	suite.Run(t, new(orderAmendKeepPriorityServiceWsTestSuite))
+}
+
+func (s *orderAmendKeepPriorityServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OrderAmendKeepPriorityWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOrderAmendKeepPriorityWsRequest().
+		Symbol("BTCUSDT").
+		OrderID(12569099453).
+		NewQty("0.00500000")
+}
+
+func (s *orderAmendKeepPriorityServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *orderAmendKeepPriorityServiceWsTestSuite) TestOrderAmendKeepPriority() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"order.amend.keepPriority"`)
+		s.Contains(string(data), `"newQty":"0.00500000"`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *orderAmendKeepPriorityServiceWsTestSuite) TestOrderAmendKeepPriority_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderAmendKeepPriorityServiceWsTestSuite) TestOrderAmendKeepPriority_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderAmendKeepPriorityServiceWsTestSuite) TestOrderAmendKeepPrioritySync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {
+			"transactTime": 1741669661670,
+			"executionId": 22,
+			"amendedOrder": {
+				"symbol": "BTCUSDT",
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"origClientOrderId": "4d96324ff9d44481926157",
+				"clientOrderId": "pXLV6Hz6mprAcVYpVMTGgx",
+				"price": "23416.10000000",
+				"qty": "0.00500000",
+				"executedQty": "0.00000000",
+				"preventedQty": "0.00000000",
+				"quoteOrderQty": "0.00000000",
+				"cumulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL",
+				"workingTime": 1741669661670,
+				"selfTradePreventionMode": "NONE"
+			}
+		}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Equal(int64(22), response.Result.ExecutionID)
+	s.Equal("0.00500000", response.Result.AmendedOrder.Quantity)
+	s.Equal(OrderStatusTypeNew, response.Result.AmendedOrder.Status)
+	s.Nil(response.Result.ListStatus)
+}
@@ -0,0 +1,352 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderCancelReplaceWsApiService cancels an existing order and places a new order
+type OrderCancelReplaceWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewOrderCancelReplaceWsApiService init OrderCancelReplaceWsApiService
+func (c *Client) NewOrderCancelReplaceWsApiService() (*OrderCancelReplaceWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderCancelReplaceWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// OrderCancelReplaceWsRequest parameters for 'order.cancelReplace' websocket API
+type OrderCancelReplaceWsRequest struct {
+	symbol                     string
+	cancelReplaceMode          CancelReplaceMode
+	side                       SideType
+	orderType                  OrderType
+	timeInForce                *TimeInForceType
+	price                      *string
+	quantity                   *string
+	quoteOrderQty              *string
+	newClientOrderID           *string
+	newOrderRespType           *NewOrderRespType
+	stopPrice                  *string
+	trailingDelta              *int64
+	icebergQty                 *string
+	strategyId                 *int64
+	strategyType               *int64
+	selfTradePreventionMode    *SelfTradePreventionMode
+	cancelOrderID              *int64
+	cancelOrigClientOrderID    *string
+	cancelNewClientOrderID     *string
+	cancelRestrictions         *CancelRestrictions
+	orderRateLimitExceededMode *OrderRateLimitExceededMode
+	recvWindow                 *uint16
+}
+
+// NewOrderCancelReplaceWsRequest init OrderCancelReplaceWsRequest
+func NewOrderCancelReplaceWsRequest() *OrderCancelReplaceWsRequest {
+	return &OrderCancelReplaceWsRequest{}
+}
+
+func (s *OrderCancelReplaceWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OrderCancelReplaceWsRequest) buildParams() params {
+	m := params{
+		"symbol":            s.symbol,
+		"cancelReplaceMode": s.cancelReplaceMode,
+		"side":              s.side,
+		"type":              s.orderType,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
+	if s.icebergQty != nil {
+		m["icebergQty"] = *s.icebergQty
+	}
+	if s.strategyId != nil {
+		m["strategyId"] = *s.strategyId
+	}
+	if s.strategyType != nil {
+		m["strategyType"] = *s.strategyType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	if s.orderRateLimitExceededMode != nil {
+		m["orderRateLimitExceededMode"] = *s.orderRateLimitExceededMode
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'order.cancelReplace' request
+func (s *OrderCancelReplaceWsApiService) Do(requestID string, request *OrderCancelReplaceWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.OrderCancelReplaceSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'order.cancelReplace' request and receives response
+func (s *OrderCancelReplaceWsApiService) SyncDo(requestID string, request *OrderCancelReplaceWsRequest) (*CancelReplaceOrderWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderCancelReplaceSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cancelReplaceOrderWsResponse := &CancelReplaceOrderWsResponse{}
+	if err := json.Unmarshal(response, cancelReplaceOrderWsResponse); err != nil {
+		return nil, err
+	}
+
+	return cancelReplaceOrderWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OrderCancelReplaceWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OrderCancelReplaceWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OrderCancelReplaceWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OrderCancelReplaceWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OrderCancelReplaceWsRequest) Symbol(symbol string) *OrderCancelReplaceWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode
+func (s *OrderCancelReplaceWsRequest) CancelReplaceMode(cancelReplaceMode CancelReplaceMode) *OrderCancelReplaceWsRequest {
+	s.cancelReplaceMode = cancelReplaceMode
+	return s
+}
+
+// Side set side
+func (s *OrderCancelReplaceWsRequest) Side(side SideType) *OrderCancelReplaceWsRequest {
+	s.side = side
+	return s
+}
+
+// Type set orderType
+func (s *OrderCancelReplaceWsRequest) Type(orderType OrderType) *OrderCancelReplaceWsRequest {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *OrderCancelReplaceWsRequest) TimeInForce(timeInForce TimeInForceType) *OrderCancelReplaceWsRequest {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Price set price
+func (s *OrderCancelReplaceWsRequest) Price(price string) *OrderCancelReplaceWsRequest {
+	s.price = &price
+	return s
+}
+
+// Quantity set quantity
+func (s *OrderCancelReplaceWsRequest) Quantity(quantity string) *OrderCancelReplaceWsRequest {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *OrderCancelReplaceWsRequest) QuoteOrderQty(quoteOrderQty string) *OrderCancelReplaceWsRequest {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *OrderCancelReplaceWsRequest) NewClientOrderID(newClientOrderID string) *OrderCancelReplaceWsRequest {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *OrderCancelReplaceWsRequest) NewOrderRespType(newOrderRespType NewOrderRespType) *OrderCancelReplaceWsRequest {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *OrderCancelReplaceWsRequest) StopPrice(stopPrice string) *OrderCancelReplaceWsRequest {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *OrderCancelReplaceWsRequest) TrailingDelta(trailingDelta int64) *OrderCancelReplaceWsRequest {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
+// IcebergQty set icebergQty
+func (s *OrderCancelReplaceWsRequest) IcebergQty(icebergQty string) *OrderCancelReplaceWsRequest {
+	s.icebergQty = &icebergQty
+	return s
+}
+
+// StrategyId set strategyId
+func (s *OrderCancelReplaceWsRequest) StrategyId(strategyId int64) *OrderCancelReplaceWsRequest {
+	s.strategyId = &strategyId
+	return s
+}
+
+// StrategyType set strategyType
+func (s *OrderCancelReplaceWsRequest) StrategyType(strategyType int64) *OrderCancelReplaceWsRequest {
+	s.strategyType = &strategyType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *OrderCancelReplaceWsRequest) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *OrderCancelReplaceWsRequest {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// CancelOrderID set cancelOrderID
+func (s *OrderCancelReplaceWsRequest) CancelOrderID(cancelOrderID int64) *OrderCancelReplaceWsRequest {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderID
+func (s *OrderCancelReplaceWsRequest) CancelOrigClientOrderID(cancelOrigClientOrderID string) *OrderCancelReplaceWsRequest {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderID
+func (s *OrderCancelReplaceWsRequest) CancelNewClientOrderID(cancelNewClientOrderID string) *OrderCancelReplaceWsRequest {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions
+func (s *OrderCancelReplaceWsRequest) CancelRestrictions(cancelRestrictions CancelRestrictions) *OrderCancelReplaceWsRequest {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// OrderRateLimitExceededMode set orderRateLimitExceededMode
+func (s *OrderCancelReplaceWsRequest) OrderRateLimitExceededMode(orderRateLimitExceededMode OrderRateLimitExceededMode) *OrderCancelReplaceWsRequest {
+	s.orderRateLimitExceededMode = &orderRateLimitExceededMode
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderCancelReplaceWsRequest) RecvWindow(recvWindow uint16) *OrderCancelReplaceWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// CancelReplaceOrderWsResponse define 'order.cancelReplace' websocket API response
+type CancelReplaceOrderWsResponse struct {
+	Id     string                     `json:"id"`
+	Status int                        `json:"status"`
+	Result CancelReplaceOrderResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
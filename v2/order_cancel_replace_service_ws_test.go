@@ -0,0 +1,133 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderCancelReplaceServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *OrderCancelReplaceWsApiService
+	request   *OrderCancelReplaceWsRequest
+}
+
+func TestOrderCancelReplaceServiceWs(t *testing.T) {
+	suite.Run(t, new(orderCancelReplaceServiceWsTestSuite))
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OrderCancelReplaceWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOrderCancelReplaceWsRequest().
+		Symbol("BTCUSDT").
+		CancelReplaceMode(CancelReplaceModeAllowFailure).
+		Side(SideTypeSell).
+		Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).
+		Price("23416.10000000").
+		Quantity("0.00847000").
+		CancelOrderID(125690984230)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplace() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"order.cancelReplace"`)
+		s.Contains(string(data), `"cancelReplaceMode":"ALLOW_FAILURE"`)
+		s.Contains(string(data), `"type":"LIMIT"`)
+		s.Contains(string(data), `"cancelOrderId":125690984230`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplace_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplace_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderCancelReplaceServiceWsTestSuite) TestOrderCancelReplaceSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {
+			"cancelResult": "SUCCESS",
+			"newOrderResult": "SUCCESS",
+			"cancelResponse": {
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "4d96324ff9d44481926157",
+				"orderId": 125690984230,
+				"orderListId": -1,
+				"clientOrderId": "91fe37ce9e69c90d6358c0",
+				"price": "23450.00000000",
+				"origQty": "0.00847000",
+				"executedQty": "0.00001000",
+				"cummulativeQuoteQty": "0.23450000",
+				"status": "CANCELED",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL",
+				"selfTradePreventionMode": "NONE"
+			},
+			"newOrderResponse": {
+				"symbol": "BTCUSDT",
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"clientOrderId": "bX5wROblo6YeDwa9iTLeyY",
+				"transactTime": 1660813156959,
+				"price": "23416.10000000",
+				"origQty": "0.00847000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL"
+			}
+		}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Equal("SUCCESS", response.Result.CancelResult)
+	s.Require().NotNil(response.Result.CancelResponse)
+	s.Equal(int64(125690984230), response.Result.CancelResponse.OrderID)
+	s.Require().NotNil(response.Result.NewOrderResponse)
+	s.Equal(int64(12569099453), response.Result.NewOrderResponse.OrderID)
+}
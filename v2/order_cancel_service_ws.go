@@ -0,0 +1,198 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderCancelWsApiService cancels order
+type OrderCancelWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewOrderCancelWsApiService init OrderCancelWsApiService
+func (c *Client) NewOrderCancelWsApiService() (*OrderCancelWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderCancelWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// OrderCancelWsRequest parameters for 'order.cancel' websocket API
+type OrderCancelWsRequest struct {
+	symbol             string
+	orderID            *int64
+	origClientOrderID  *string
+	newClientOrderID   *string
+	cancelRestrictions *CancelRestrictions
+	recvWindow         *uint16
+}
+
+// NewOrderCancelWsRequest init OrderCancelWsRequest
+func NewOrderCancelWsRequest() *OrderCancelWsRequest {
+	return &OrderCancelWsRequest{}
+}
+
+func (s *OrderCancelWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OrderCancelWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'order.cancel' request
+func (s *OrderCancelWsApiService) Do(requestID string, request *OrderCancelWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.OrderCancelSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'order.cancel' request and receives response
+func (s *OrderCancelWsApiService) SyncDo(requestID string, request *OrderCancelWsRequest) (*CancelOrderWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderCancelSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cancelOrderWsResponse := &CancelOrderWsResponse{}
+	if err := json.Unmarshal(response, cancelOrderWsResponse); err != nil {
+		return nil, err
+	}
+
+	return cancelOrderWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OrderCancelWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OrderCancelWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OrderCancelWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OrderCancelWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OrderCancelWsRequest) Symbol(symbol string) *OrderCancelWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *OrderCancelWsRequest) OrderID(orderID int64) *OrderCancelWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *OrderCancelWsRequest) OrigClientOrderID(origClientOrderID string) *OrderCancelWsRequest {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *OrderCancelWsRequest) NewClientOrderID(newClientOrderID string) *OrderCancelWsRequest {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions
+func (s *OrderCancelWsRequest) CancelRestrictions(cancelRestrictions CancelRestrictions) *OrderCancelWsRequest {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderCancelWsRequest) RecvWindow(recvWindow uint16) *OrderCancelWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// CancelOrderWsResponse define 'order.cancel' websocket API response
+type CancelOrderWsResponse struct {
+	Id     string              `json:"id"`
+	Status int                 `json:"status"`
+	Result CancelOrderResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,120 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderCancelServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *OrderCancelWsApiService
+	request   *OrderCancelWsRequest
+}
+
+func TestOrderCancelServiceWs(t *testing.T) {
+	suite.Run(t, new(orderCancelServiceWsTestSuite))
+}
+
+func (s *orderCancelServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OrderCancelWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOrderCancelWsRequest().
+		Symbol("BTCUSDT").
+		OrderID(12569099453).
+		CancelRestrictions(CancelRestrictionsOnlyNew)
+}
+
+func (s *orderCancelServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"order.cancel"`)
+		s.Contains(string(data), `"orderId":12569099453`)
+		s.Contains(string(data), `"cancelRestrictions":"ONLY_NEW"`)
+		s.Contains(string(data), `"signature":`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancel_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "4d96324ff9d44481926157",
+			"orderId": 12569099453,
+			"orderListId": -1,
+			"clientOrderId": "91fe37ce9e69c90d6358c0",
+			"transactTime": 1684804350068,
+			"price": "23416.10000000",
+			"origQty": "0.00847000",
+			"executedQty": "0.00001000",
+			"cummulativeQuoteQty": "0.23416100",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"selfTradePreventionMode": "NONE"
+		}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Equal(int64(12569099453), response.Result.OrderID)
+	s.Equal(OrderStatusTypeCanceled, response.Result.Status)
+	s.Equal("0.00001000", response.Result.ExecutedQuantity)
+}
+
+func (s *orderCancelServiceWsTestSuite) TestSharedConnection() {
+	c := NewClient("dummyApiKey", "dummySecretKey")
+	c.WsApiClient = s.client
+
+	cancelService, err := c.NewOrderCancelWsApiService()
+	s.Require().NoError(err)
+	statusService, err := c.NewOrderStatusWsApiService()
+	s.Require().NoError(err)
+	s.Equal(s.client, cancelService.c)
+	s.Equal(s.client, statusService.c)
+}
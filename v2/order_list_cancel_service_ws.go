@@ -15,25 +15,24 @@ type OrderListCancelWsApiService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewOrderListCancelWsService init OrderListCancelWsService
 func (c *Client) NewOrderListCancelWsApiService() (*OrderListCancelWsApiService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
 
 	return &OrderListCancelWsApiService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -77,12 +76,13 @@ func (s *OrderListCancelWsRequest) buildParams() params {
 
 // Do - sends 'orderList.cancel' request
 func (s *OrderListCancelWsApiService) Do(requestID string, request *OrderListCancelWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		websocket.OrderListCancelSpotWsApiMethod,
@@ -101,22 +101,20 @@ func (s *OrderListCancelWsApiService) Do(requestID string, request *OrderListCan
 
 // SyncDo - sends 'orderList.cancel' request and receives response
 func (s *OrderListCancelWsApiService) SyncDo(requestID string, request *OrderListCancelWsRequest) (*CancelOrderListWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		websocket.OrderListCancelSpotWsApiMethod,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderListCancelSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
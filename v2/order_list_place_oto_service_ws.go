@@ -15,25 +15,24 @@ type OrderListPlaceOtoWsApiService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewOrderListPlaceOtoWsService init OrderListPlaceOtoWsService
 func (c *Client) NewOrderListPlaceOtoWsApiService() (*OrderListPlaceOtoWsApiService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
 
 	return &OrderListPlaceOtoWsApiService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -149,12 +148,13 @@ func (s *OrderListPlaceOtoWsRequest) buildParams() params {
 
 // Do - sends 'orderList.place.oto' request
 func (s *OrderListPlaceOtoWsApiService) Do(requestID string, request *OrderListPlaceOtoWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		websocket.OrderListPlaceOtoSpotWsApiMethod,
@@ -173,22 +173,20 @@ func (s *OrderListPlaceOtoWsApiService) Do(requestID string, request *OrderListP
 
 // SyncDo - sends 'orderList.place.oto' request and receives response
 func (s *OrderListPlaceOtoWsApiService) SyncDo(requestID string, request *OrderListPlaceOtoWsRequest) (*CreateOrderListWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		websocket.OrderListPlaceOtoSpotWsApiMethod,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderListPlaceOtoSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
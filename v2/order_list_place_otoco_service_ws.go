@@ -15,25 +15,24 @@ type OrderListPlaceOtocoWsApiService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewOrderListPlaceOtocoWsService init OrderListPlaceOtocoWsService
 func (c *Client) NewOrderListPlaceOtocoWsApiService() (*OrderListPlaceOtocoWsApiService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
 
 	return &OrderListPlaceOtocoWsApiService{
-		c:         client,
-		ApiKey:    c.APIKey,
-		SecretKey: c.SecretKey,
-		KeyType:   common.KeyTypeHmac,
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    common.KeyTypeHmac,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -187,12 +186,13 @@ func (s *OrderListPlaceOtocoWsRequest) buildParams() params {
 
 // Do - sends 'orderList.place.otoco' request
 func (s *OrderListPlaceOtocoWsApiService) Do(requestID string, request *OrderListPlaceOtocoWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		websocket.OrderListPlaceOtocoSpotWsApiMethod,
@@ -211,22 +211,20 @@ func (s *OrderListPlaceOtocoWsApiService) Do(requestID string, request *OrderLis
 
 // SyncDo - sends 'orderList.place.otoco' request and receives response
 func (s *OrderListPlaceOtocoWsApiService) SyncDo(requestID string, request *OrderListPlaceOtocoWsRequest) (*CreateOrderListWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		websocket.OrderListPlaceOtocoSpotWsApiMethod,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderListPlaceOtocoSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
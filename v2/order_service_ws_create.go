@@ -15,16 +15,13 @@ type OrderCreateWsApiService struct {
 	SecretKey  string
 	KeyType    string
 	TimeOffset int64
+
+	timeSync wsTimeSync
 }
 
 // NewOrderCreateWsService init OrderCreateWsService
 func (c *Client) NewOrderCreateWsApiService() (*OrderCreateWsApiService, error) {
-	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := websocket.NewClient(conn)
+	client, err := c.newWsApiClient()
 	if err != nil {
 		return nil, err
 	}
@@ -35,6 +32,7 @@ func (c *Client) NewOrderCreateWsApiService() (*OrderCreateWsApiService, error)
 		SecretKey:  c.SecretKey,
 		KeyType:    c.KeyType,
 		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
 	}, nil
 }
 
@@ -114,12 +112,13 @@ func (s *OrderCreateWsRequest) buildParams() params {
 
 // Do - sends 'order.place' request
 func (s *OrderCreateWsApiService) Do(requestID string, request *OrderCreateWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
 			s.SecretKey,
-			s.TimeOffset,
+			s.timeSync.offset(s.TimeOffset),
 			s.KeyType,
 		),
 		websocket.OrderPlaceSpotWsApiMethod,
@@ -138,22 +137,20 @@ func (s *OrderCreateWsApiService) Do(requestID string, request *OrderCreateWsReq
 
 // SyncDo - sends 'order.place' request and receives response
 func (s *OrderCreateWsApiService) SyncDo(requestID string, request *OrderCreateWsRequest) (*CreateOrderWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
-		websocket.NewRequestData(
-			requestID,
-			s.ApiKey,
-			s.SecretKey,
-			s.TimeOffset,
-			s.KeyType,
-		),
-		websocket.OrderPlaceSpotWsApiMethod,
-		request.buildParams(),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderPlaceSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,178 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderStatusWsApiService queries order
+type OrderStatusWsApiService struct {
+	c          websocket.Client
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+
+	timeSync wsTimeSync
+}
+
+// NewOrderStatusWsApiService init OrderStatusWsApiService
+func (c *Client) NewOrderStatusWsApiService() (*OrderStatusWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderStatusWsApiService{
+		c:          client,
+		ApiKey:     c.APIKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+		timeSync:   wsTimeSync{c},
+	}, nil
+}
+
+// OrderStatusWsRequest parameters for 'order.status' websocket API
+type OrderStatusWsRequest struct {
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	recvWindow        *uint16
+}
+
+// NewOrderStatusWsRequest init OrderStatusWsRequest
+func NewOrderStatusWsRequest() *OrderStatusWsRequest {
+	return &OrderStatusWsRequest{}
+}
+
+func (s *OrderStatusWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *OrderStatusWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'order.status' request
+func (s *OrderStatusWsApiService) Do(requestID string, request *OrderStatusWsRequest) error {
+	rawData, err := websocket.CreateClientRequest(
+		s.c,
+		websocket.NewRequestData(
+			requestID,
+			s.ApiKey,
+			s.SecretKey,
+			s.timeSync.offset(s.TimeOffset),
+			s.KeyType,
+		),
+		websocket.OrderStatusSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'order.status' request and receives response
+func (s *OrderStatusWsApiService) SyncDo(requestID string, request *OrderStatusWsRequest) (*OrderStatusWsResponse, error) {
+	response, err := s.timeSync.writeSync(s.c, requestID, func() ([]byte, error) {
+		return websocket.CreateClientRequest(
+			s.c,
+			websocket.NewRequestData(
+				requestID,
+				s.ApiKey,
+				s.SecretKey,
+				s.timeSync.offset(s.TimeOffset),
+				s.KeyType,
+			),
+			websocket.OrderStatusSpotWsApiMethod,
+			request.buildParams(),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orderStatusWsResponse := &OrderStatusWsResponse{}
+	if err := json.Unmarshal(response, orderStatusWsResponse); err != nil {
+		return nil, err
+	}
+
+	return orderStatusWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *OrderStatusWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *OrderStatusWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *OrderStatusWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *OrderStatusWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *OrderStatusWsRequest) Symbol(symbol string) *OrderStatusWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *OrderStatusWsRequest) OrderID(orderID int64) *OrderStatusWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *OrderStatusWsRequest) OrigClientOrderID(origClientOrderID string) *OrderStatusWsRequest {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderStatusWsRequest) RecvWindow(recvWindow uint16) *OrderStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// OrderStatusWsResponse define 'order.status' websocket API response
+type OrderStatusWsResponse struct {
+	Id     string `json:"id"`
+	Status int    `json:"status"`
+	Result Order  `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,110 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderStatusServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *OrderStatusWsApiService
+	request   *OrderStatusWsRequest
+}
+
+func TestOrderStatusServiceWs(t *testing.T) {
+	suite.Run(t, new(orderStatusServiceWsTestSuite))
+}
+
+func (s *orderStatusServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &OrderStatusWsApiService{
+		c:         s.client,
+		ApiKey:    "dummyApiKey",
+		SecretKey: "dummySecretKey",
+		KeyType:   "HMAC",
+	}
+
+	s.request = NewOrderStatusWsRequest().
+		Symbol("BTCUSDT").
+		OrigClientOrderID("4d96324ff9d44481926157")
+}
+
+func (s *orderStatusServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"order.status"`)
+		s.Contains(string(data), `"origClientOrderId":"4d96324ff9d44481926157"`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatus_EmptyApiKey() {
+	s.service.ApiKey = ""
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.ErrorIs(err, websocket.ErrorApiKeyIsNotSet)
+}
+
+func (s *orderStatusServiceWsTestSuite) TestOrderStatusSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {
+			"symbol": "BTCUSDT",
+			"orderId": 12569099453,
+			"orderListId": -1,
+			"clientOrderId": "4d96324ff9d44481926157",
+			"price": "23416.10000000",
+			"origQty": "0.00847000",
+			"executedQty": "0.00847000",
+			"cummulativeQuoteQty": "198.33521500",
+			"status": "FILLED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"stopPrice": "0.00000000",
+			"icebergQty": "0.00000000",
+			"time": 1660801715639,
+			"updateTime": 1660801717945,
+			"isWorking": true,
+			"workingTime": 1660801715639,
+			"origQuoteOrderQty": "0.00000000",
+			"selfTradePreventionMode": "NONE"
+		}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Equal(int64(12569099453), response.Result.OrderID)
+	s.Equal(OrderStatusTypeFilled, response.Result.Status)
+	s.Equal(int64(1660801717945), response.Result.UpdateTime)
+}
@@ -0,0 +1,299 @@
+package binance
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderParams define the parameters of an order checked by OrderValidator, an empty value is unset
+type OrderParams struct {
+	Symbol        string
+	Side          SideType
+	Type          OrderType
+	Quantity      string
+	QuoteOrderQty string
+	Price         string
+	StopPrice     string
+	IcebergQty    string
+	TrailingDelta int64
+}
+
+// OrderValidator check orders against the filters of the symbols of the exchange info before they
+// are sent: PRICE_FILTER, LOT_SIZE, MARKET_LOT_SIZE, ICEBERG_PARTS, TRAILING_DELTA, NOTIONAL and,
+// once the average price of the symbol is set, PERCENT_PRICE_BY_SIDE. The prices and quantities
+// are rounded to the tick and step sizes first, with PriceRounding and QuantityRounding.
+type OrderValidator struct {
+	// PriceRounding is the rounding of the prices, RoundingModeNearest by default
+	PriceRounding common.RoundingMode
+	// QuantityRounding is the rounding of the quantities, RoundingModeDown by default
+	QuantityRounding common.RoundingMode
+
+	symbols map[string]*Symbol
+	mu      sync.RWMutex
+	prices  map[string]decimal.Decimal
+}
+
+// NewOrderValidator init OrderValidator with the symbols of the exchange info
+func NewOrderValidator(info *ExchangeInfo) *OrderValidator {
+	v := &OrderValidator{
+		PriceRounding:    common.RoundingModeNearest,
+		QuantityRounding: common.RoundingModeDown,
+		symbols:          make(map[string]*Symbol, len(info.Symbols)),
+		prices:           make(map[string]decimal.Decimal),
+	}
+	for i := range info.Symbols {
+		v.symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+	}
+	return v
+}
+
+// SetAveragePrice set the average price of symbol, used by the PERCENT_PRICE_BY_SIDE filter and
+// the NOTIONAL filter of the market orders
+func (v *OrderValidator) SetAveragePrice(symbol, price string) error {
+	p, err := decimal.NewFromString(price)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.prices[symbol] = p
+	return nil
+}
+
+func (v *OrderValidator) averagePrice(symbol string) (decimal.Decimal, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	p, ok := v.prices[symbol]
+	return p, ok
+}
+
+// Validate round the prices and quantities of o and check them against the filters of its symbol.
+// o is updated only if it doesn't violate any filter, otherwise the violations are returned
+// as common.FilterViolations.
+func (v *OrderValidator) Validate(o *OrderParams) error {
+	symbol, ok := v.symbols[o.Symbol]
+	if !ok {
+		return fmt.Errorf("unknown symbol: %s", o.Symbol)
+	}
+	res := *o
+	var violations common.FilterViolations
+	var parseErr error
+	parse := func(field, value string) decimal.Decimal {
+		d, err := decimal.NewFromString(value)
+		if err != nil && parseErr == nil {
+			parseErr = fmt.Errorf("invalid %s %q: %w", field, value, err)
+		}
+		return d
+	}
+	apply := func(f common.StepFilter, value *string, mode common.RoundingMode) decimal.Decimal {
+		d := parse(f.Field, *value)
+		rounded, vs := f.Apply(d, mode)
+		violations = append(violations, vs...)
+		if !rounded.Equal(d) {
+			*value = rounded.String()
+		}
+		return rounded
+	}
+
+	var price, stopPrice, quantity decimal.Decimal
+	if f := symbol.PriceFilter(); f != nil {
+		filter := common.StepFilter{Filter: string(SymbolFilterTypePriceFilter), Min: f.MinPrice, Max: f.MaxPrice, Step: f.TickSize}
+		if res.Price != "" {
+			filter.Field = "price"
+			price = apply(filter, &res.Price, v.PriceRounding)
+		}
+		if res.StopPrice != "" {
+			filter.Field = "stopPrice"
+			stopPrice = apply(filter, &res.StopPrice, v.PriceRounding)
+		}
+	} else {
+		if res.Price != "" {
+			price = parse("price", res.Price)
+		}
+		if res.StopPrice != "" {
+			stopPrice = parse("stopPrice", res.StopPrice)
+		}
+	}
+
+	if res.Quantity != "" {
+		quantity = parse("quantity", res.Quantity)
+		if f := symbol.LotSizeFilter(); f != nil {
+			filter := common.StepFilter{Filter: string(SymbolFilterTypeLotSize), Field: "quantity", Min: f.MinQuantity, Max: f.MaxQuantity, Step: f.StepSize}
+			quantity = apply(filter, &res.Quantity, v.QuantityRounding)
+		}
+		if f := symbol.MarketLotSizeFilter(); f != nil && res.Type == OrderTypeMarket {
+			filter := common.StepFilter{Filter: string(SymbolFilterTypeMarketLotSize), Field: "quantity", Min: f.MinQuantity, Max: f.MaxQuantity, Step: f.StepSize}
+			quantity = apply(filter, &res.Quantity, v.QuantityRounding)
+		}
+	}
+
+	if res.IcebergQty != "" {
+		icebergQty := parse("icebergQty", res.IcebergQty)
+		if f := symbol.LotSizeFilter(); f != nil {
+			filter := common.StepFilter{Filter: string(SymbolFilterTypeLotSize), Field: "icebergQty", Min: f.MinQuantity, Max: f.MaxQuantity, Step: f.StepSize}
+			icebergQty = apply(filter, &res.IcebergQty, v.QuantityRounding)
+		}
+		if f := symbol.IcebergPartsFilter(); f != nil && f.Limit > 0 && icebergQty.IsPositive() {
+			parts := quantity.Div(icebergQty).Ceil()
+			if parts.GreaterThan(decimal.NewFromInt(int64(f.Limit))) {
+				violations = append(violations, common.FilterViolation{
+					Filter: string(SymbolFilterTypeIcebergParts),
+					Field:  "icebergQty",
+					Value:  res.IcebergQty,
+					Reason: fmt.Sprintf("splits the order in %s parts, more than %d", parts, f.Limit),
+				})
+			}
+		}
+	}
+
+	if f := symbol.TrailingDeltaFilter(); f != nil && res.TrailingDelta != 0 {
+		delta := decimal.NewFromInt(res.TrailingDelta)
+		minDelta, maxDelta := f.MinTrailingBelowDelta, f.MaxTrailingBelowDelta
+		if trailingAbove(res.Side, res.Type) {
+			minDelta, maxDelta = f.MinTrailingAboveDelta, f.MaxTrailingAboveDelta
+		}
+		violations = append(violations, common.CheckRange(string(SymbolFilterTypeTrailingDelta), "trailingDelta",
+			delta, decimal.NewFromInt(int64(minDelta)), decimal.NewFromInt(int64(maxDelta)))...)
+	}
+
+	avgPrice, hasAvgPrice := v.averagePrice(res.Symbol)
+	if f := symbol.PercentPriceBySideFilter(); f != nil && hasAvgPrice && res.Price != "" {
+		up, down := f.BidMultiplierUp, f.BidMultiplierDown
+		if res.Side == SideTypeSell {
+			up, down = f.AskMultiplierUp, f.AskMultiplierDown
+		}
+		violations = append(violations, common.CheckRange(string(SymbolFilterTypePercentPriceBySide), "price",
+			price, avgPrice.Mul(decimalOrZero(down)), avgPrice.Mul(decimalOrZero(up)))...)
+	}
+
+	if f := symbol.NotionalFilter(); f != nil {
+		isMarket := res.Type == OrderTypeMarket
+		var notional decimal.Decimal
+		var known bool
+		switch {
+		case res.QuoteOrderQty != "":
+			notional, known = parse("quoteOrderQty", res.QuoteOrderQty), true
+		case res.Quantity == "":
+		case res.Price != "" && !isMarket:
+			notional, known = price.Mul(quantity), true
+		case res.StopPrice != "" && !isMarket:
+			notional, known = stopPrice.Mul(quantity), true
+		case hasAvgPrice:
+			notional, known = avgPrice.Mul(quantity), true
+		}
+		if known {
+			minNotional, maxNotional := decimalOrZero(f.MinNotional), decimalOrZero(f.MaxNotional)
+			if isMarket && !f.ApplyMinToMarket {
+				minNotional = decimal.Zero
+			}
+			if isMarket && !f.ApplyMaxToMarket {
+				maxNotional = decimal.Zero
+			}
+			violations = append(violations, common.CheckRange(string(SymbolFilterTypeNotional), "notional",
+				notional, minNotional, maxNotional)...)
+		}
+	}
+
+	if parseErr != nil {
+		return parseErr
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+	*o = res
+	return nil
+}
+
+// trailingAbove return true if the trailing delta of the order is checked against the
+// TRAILING_DELTA above bounds, i.e. for buy stop-loss and sell take-profit orders
+func trailingAbove(side SideType, orderType OrderType) bool {
+	switch orderType {
+	case OrderTypeStopLoss, OrderTypeStopLossLimit:
+		return side == SideTypeBuy
+	case OrderTypeTakeProfit, OrderTypeTakeProfitLimit:
+		return side == SideTypeSell
+	}
+	return false
+}
+
+// ValidateCreateOrder validate the order of s with Validate and set its rounded prices and quantities
+func (v *OrderValidator) ValidateCreateOrder(s *CreateOrderService) error {
+	o := &OrderParams{
+		Symbol:        s.symbol,
+		Side:          s.side,
+		Type:          s.orderType,
+		Quantity:      stringOrEmpty(s.quantity),
+		QuoteOrderQty: stringOrEmpty(s.quoteOrderQty),
+		Price:         stringOrEmpty(s.price),
+		StopPrice:     stringOrEmpty(s.stopPrice),
+		IcebergQty:    stringOrEmpty(s.icebergQuantity),
+	}
+	if s.trailingDelta != nil {
+		delta, err := strconv.ParseInt(*s.trailingDelta, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid trailingDelta %q: %w", *s.trailingDelta, err)
+		}
+		o.TrailingDelta = delta
+	}
+	if err := v.Validate(o); err != nil {
+		return err
+	}
+	setIfPresent(&s.quantity, o.Quantity)
+	setIfPresent(&s.price, o.Price)
+	setIfPresent(&s.stopPrice, o.StopPrice)
+	setIfPresent(&s.icebergQuantity, o.IcebergQty)
+	return nil
+}
+
+// ValidateOrderCreateWsRequest validate the order of r with Validate and set its rounded prices
+// and quantities
+func (v *OrderValidator) ValidateOrderCreateWsRequest(r *OrderCreateWsRequest) error {
+	o := &OrderParams{
+		Symbol:        r.symbol,
+		Side:          r.side,
+		Type:          r.orderType,
+		Quantity:      r.quantity,
+		QuoteOrderQty: stringOrEmpty(r.quoteOrderQty),
+		Price:         stringOrEmpty(r.price),
+		StopPrice:     stringOrEmpty(r.stopPrice),
+		IcebergQty:    stringOrEmpty(r.icebergQty),
+	}
+	if r.trailingDelta != nil {
+		o.TrailingDelta = *r.trailingDelta
+	}
+	if err := v.Validate(o); err != nil {
+		return err
+	}
+	r.quantity = o.Quantity
+	setIfPresent(&r.price, o.Price)
+	setIfPresent(&r.stopPrice, o.StopPrice)
+	setIfPresent(&r.icebergQty, o.IcebergQty)
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// setIfPresent replace the value of the optional parameter p if it is set
+func setIfPresent(p **string, value string) {
+	if *p != nil {
+		*p = &value
+	}
+}
+
+func decimalOrZero(v string) decimal.Decimal {
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
@@ -0,0 +1,114 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func newTestOrderValidator(t *testing.T) *OrderValidator {
+	data := []byte(`{
+		"symbols": [{
+			"symbol": "BTCUSDT",
+			"status": "TRADING",
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"},
+				{"filterType": "LOT_SIZE", "minQty": "0.00001000", "maxQty": "9000.00000000", "stepSize": "0.00001000"},
+				{"filterType": "ICEBERG_PARTS", "limit": 10},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00000000", "maxQty": "100.00000000", "stepSize": "0.00000000"},
+				{"filterType": "TRAILING_DELTA", "minTrailingAboveDelta": 10, "maxTrailingAboveDelta": 2000, "minTrailingBelowDelta": 10, "maxTrailingBelowDelta": 2000},
+				{"filterType": "PERCENT_PRICE_BY_SIDE", "bidMultiplierUp": "5", "bidMultiplierDown": "0.2", "askMultiplierUp": "5", "askMultiplierDown": "0.2", "avgPriceMins": 5},
+				{"filterType": "NOTIONAL", "minNotional": "5.00000000", "applyMinToMarket": true, "maxNotional": "9000000.00000000", "applyMaxToMarket": false, "avgPriceMins": 5}
+			]
+		}]
+	}`)
+	info := new(ExchangeInfo)
+	require.NoError(t, json.Unmarshal(data, info))
+	return NewOrderValidator(info)
+}
+
+func TestOrderValidatorRounding(t *testing.T) {
+	v := newTestOrderValidator(t)
+	o := &OrderParams{
+		Symbol:   "BTCUSDT",
+		Side:     SideTypeBuy,
+		Type:     OrderTypeLimit,
+		Quantity: "0.123456",
+		Price:    "60000.126",
+	}
+	assert.NoError(t, v.Validate(o))
+	assert.Equal(t, "0.12345", o.Quantity)
+	assert.Equal(t, "60000.13", o.Price)
+
+	v.PriceRounding = common.RoundingModeDown
+	o.Price = "60000.129"
+	assert.NoError(t, v.Validate(o))
+	assert.Equal(t, "60000.12", o.Price)
+
+	v.PriceRounding = common.RoundingModeNone
+	o.Price = "60000.129"
+	err := v.Validate(o)
+	assert.IsType(t, common.FilterViolations{}, err)
+	assert.True(t, err.(common.FilterViolations).Has(string(SymbolFilterTypePriceFilter)))
+	// the order is not modified when it violates a filter
+	assert.Equal(t, "60000.129", o.Price)
+}
+
+func TestOrderValidatorViolations(t *testing.T) {
+	v := newTestOrderValidator(t)
+	o := &OrderParams{
+		Symbol:     "BTCUSDT",
+		Side:       SideTypeBuy,
+		Type:       OrderTypeLimit,
+		Quantity:   "0.00011",
+		Price:      "10000",
+		IcebergQty: "0.00001",
+	}
+	require.NoError(t, v.SetAveragePrice("BTCUSDT", "60000"))
+	err := v.Validate(o)
+	violations, ok := err.(common.FilterViolations)
+	require.True(t, ok, err)
+	assert.True(t, violations.Has(string(SymbolFilterTypeIcebergParts)))
+	assert.True(t, violations.Has(string(SymbolFilterTypePercentPriceBySide)))
+	assert.True(t, violations.Has(string(SymbolFilterTypeNotional)))
+	assert.Len(t, violations, 3)
+
+	// the minimum notional of the market orders is checked with the average price
+	o = &OrderParams{Symbol: "BTCUSDT", Side: SideTypeSell, Type: OrderTypeMarket, Quantity: "0.00005"}
+	err = v.Validate(o)
+	assert.EqualError(t, err, "order violates symbol filters: NOTIONAL: notional 3 is below minimum 5")
+	o = &OrderParams{Symbol: "BTCUSDT", Side: SideTypeSell, Type: OrderTypeMarket, Quantity: "200"}
+	err = v.Validate(o)
+	assert.True(t, err.(common.FilterViolations).Has(string(SymbolFilterTypeMarketLotSize)))
+
+	o = &OrderParams{Symbol: "BTCUSDT", Side: SideTypeBuy, Type: OrderTypeStopLoss, Quantity: "1", TrailingDelta: 5000}
+	err = v.Validate(o)
+	assert.True(t, err.(common.FilterViolations).Has(string(SymbolFilterTypeTrailingDelta)))
+
+	assert.Error(t, v.Validate(&OrderParams{Symbol: "ETHUSDT"}))
+	err = v.Validate(&OrderParams{Symbol: "BTCUSDT", Quantity: "x"})
+	assert.EqualError(t, err, `invalid quantity "x": can't convert x to decimal`)
+}
+
+func TestOrderValidatorServices(t *testing.T) {
+	v := newTestOrderValidator(t)
+	s := (&CreateOrderService{}).Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		Quantity("0.123456").Price("60000.126").TimeInForce(TimeInForceTypeGTC)
+	assert.NoError(t, v.ValidateCreateOrder(s))
+	assert.Equal(t, "0.12345", *s.quantity)
+	assert.Equal(t, "60000.13", *s.price)
+	assert.Nil(t, s.stopPrice)
+
+	r := NewOrderCreateWsRequest().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+		Quantity("0.00001").Price("60000")
+	err := v.ValidateOrderCreateWsRequest(r)
+	assert.True(t, err.(common.FilterViolations).Has(string(SymbolFilterTypeNotional)))
+
+	r.Quantity("1.000001")
+	assert.NoError(t, v.ValidateOrderCreateWsRequest(r))
+	assert.Equal(t, "1", r.quantity)
+}
@@ -320,15 +320,27 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	ProxyUrl   string
+	// Endpoints overrides the default endpoints when set, see SetEndpoints
+	Endpoints *Endpoints
 
 	do doFunc
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+	// RateLimiter enables client-side rate limiting when set, see EnableRateLimiter
+	RateLimiter *common.RateLimiter
+	// TimeSync overrides TimeOffset with a synced offset when set, a TimeSync enabled on another
+	// client can be shared
+	TimeSync *common.TimeSync
+	// RetryPolicy retries failed requests when set, it can be overridden with WithRetryPolicy
+	RetryPolicy *common.RetryPolicy
 }
 
 // getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.API != "" {
+		return c.Endpoints.API
+	}
 	return BaseApiMainUrl
 }
 
@@ -341,6 +353,9 @@ func (c *Client) getProxyUrl() *string {
 
 // getWsEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getWsEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.Stream != "" {
+		return c.Endpoints.Stream
+	}
 	return BaseWsMainUrl
 }
 
@@ -365,7 +380,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -415,6 +430,55 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	// options are applied once so that retries send the same request
+	for _, opt := range opts {
+		opt(r)
+	}
+	policy := c.retryPolicy(r)
+	if policy != nil {
+		setClientOrderID(r)
+	}
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		data, header, err = c.doAPI(ctx, r)
+		if err == nil {
+			return data, header, nil
+		}
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			attempt--
+			continue
+		}
+		if ctx.Err() != nil || !policy.ShouldRetry(attempt, err) {
+			return data, header, err
+		}
+		switch {
+		case !common.IsUnknownStatusError(err):
+		case isOrderRequest(r):
+			// the order may have been placed, look it up before sending it again
+			orderData, orderHeader, found, qerr := c.queryPlacedOrder(ctx, r)
+			if qerr != nil {
+				return data, header, err
+			}
+			if found {
+				return orderData, orderHeader, nil
+			}
+		case r.method != http.MethodGet:
+			// e.g. a withdrawal or a transfer may have been executed, only the requests
+			// which never reached the server are resent
+			return data, header, err
+		}
+		if policy.Wait(ctx, attempt) != nil {
+			return data, header, err
+		}
+	}
+}
+
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	// wait before signing the request, so its timestamp is not stale once sent
+	if err = c.waitRateLimit(ctx, r); err != nil {
+		return []byte{}, &http.Header{}, err
+	}
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -436,6 +500,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	}
 	c.UsedWeight.UpdateByHeader(res.Header)
 	c.OrderCount.UpdateByHeader(res.Header)
+	c.updateRateLimit(r, res)
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -462,7 +527,10 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			return nil, &res.Header, NewErrorFromResponse(int64(res.StatusCode), res.Status, data)
 		}
 		// Return the parsed error with the raw response included
-		return nil, &res.Header, NewErrorFromResponse(apiErr.Code, apiErr.Message, data)
+		portfolioErr := NewErrorFromResponse(apiErr.Code, apiErr.Message, data)
+		portfolioErr.StatusCode = res.StatusCode
+		portfolioErr.Header = res.Header
+		return nil, &res.Header, portfolioErr
 	}
 	return data, &res.Header, nil
 }
@@ -0,0 +1,32 @@
+package portfolio
+
+// Endpoints define the base URLs used by a client, set it with Client.SetEndpoints to run clients
+// against different environments in one process. An empty URL falls back to the production
+// endpoint.
+type Endpoints struct {
+	// API is the base URL of the REST API, e.g. https://papi.binance.com
+	API string
+	// Stream is the base URL of the user data stream, e.g. wss://fstream.binance.com/pm
+	Stream string
+}
+
+// Endpoint profiles
+var (
+	// MainnetEndpoints is the production environment
+	MainnetEndpoints = Endpoints{
+		API:    "https://papi.binance.com",
+		Stream: "wss://fstream.binance.com/pm",
+	}
+)
+
+// WithAPI return a copy of the endpoints with the REST API base URL replaced, e.g. for a regional
+// proxy
+func (e Endpoints) WithAPI(url string) Endpoints {
+	e.API = url
+	return e
+}
+
+// SetEndpoints make the client use the endpoints instead of the production ones
+func (c *Client) SetEndpoints(endpoints Endpoints) {
+	c.Endpoints = &endpoints
+}
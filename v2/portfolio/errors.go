@@ -1,6 +1,10 @@
 package portfolio
 
-import "github.com/adshao/go-binance/v2/common"
+import (
+	"errors"
+
+	"github.com/adshao/go-binance/v2/common"
+)
 
 // Error represents a portfolio error extending the common APIError
 type Error struct {
@@ -33,10 +37,15 @@ func (e Error) Error() string {
 	return e.APIError.Error()
 }
 
-// IsPortfolioError check if e is a Portfolio error
+// Unwrap return the underlying APIError so that errors.As matches *common.APIError
+func (e *Error) Unwrap() error {
+	return &e.APIError
+}
+
+// IsPortfolioError check if e is a Portfolio error, or wraps one
 func IsPortfolioError(e error) bool {
-	_, ok := e.(*Error)
-	return ok
+	var err *Error
+	return errors.As(e, &err)
 }
 
 // 10xx - General Server or Network issues
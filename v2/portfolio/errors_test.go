@@ -0,0 +1,88 @@
+package portfolio
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorUnwrap(t *testing.T) {
+	err := error(NewError(ErrInvalidTimestamp, "Timestamp for this request is outside of the recvWindow."))
+	assert.True(t, IsPortfolioError(err))
+	assert.True(t, common.IsAPIError(err))
+	assert.True(t, errors.Is(err, common.ErrInvalidTimestamp))
+	assert.True(t, common.IsInvalidTimestampError(err))
+
+	wrapped := fmt.Errorf("place order: %w", err)
+	assert.True(t, IsPortfolioError(wrapped))
+	assert.False(t, IsPortfolioError(errors.New("plain")))
+}
+
+func TestErrorCodesMatchCommon(t *testing.T) {
+	codes := []struct {
+		code, common int64
+	}{
+		{ErrUnknown, common.ErrCodeUnknown},
+		{ErrDisconnected, common.ErrCodeDisconnected},
+		{ErrUnauthorized, common.ErrCodeUnauthorized},
+		{ErrTooManyRequests, common.ErrCodeTooManyRequests},
+		{ErrUnexpectedResp, common.ErrCodeUnexpectedResp},
+		{ErrTimeout, common.ErrCodeTimeout},
+		{ErrInvalidMessage, common.ErrCodeInvalidMessage},
+		{ErrUnknownOrderCompose, common.ErrCodeUnknownOrderComp},
+		{ErrTooManyOrders, common.ErrCodeTooManyOrders},
+		{ErrServiceShuttingDown, common.ErrCodeServiceShuttingDown},
+		{ErrUnsupportedOperation, common.ErrCodeUnsupportedOp},
+		{ErrInvalidTimestamp, common.ErrCodeInvalidTimestamp},
+		{ErrInvalidSignature, common.ErrCodeInvalidSignature},
+		{ErrIllegalChars, common.ErrCodeIllegalChars},
+		{ErrTooManyParameters, common.ErrCodeTooManyParameters},
+		{ErrMandatoryParamEmptyOrMalformed, common.ErrCodeMandatoryParam},
+		{ErrUnknownParam, common.ErrCodeUnknownParam},
+		{ErrUnreadParameters, common.ErrCodeUnreadParameters},
+		{ErrParamEmpty, common.ErrCodeParamEmpty},
+		{ErrParamNotRequired, common.ErrCodeParamNotRequired},
+		{ErrBadPrecision, common.ErrCodeBadPrecision},
+		{ErrNoDepth, common.ErrCodeNoDepth},
+		{ErrInvalidTIF, common.ErrCodeInvalidTIF},
+		{ErrInvalidOrderType, common.ErrCodeInvalidOrderType},
+		{ErrInvalidSide, common.ErrCodeInvalidSide},
+		{ErrBadInterval, common.ErrCodeBadInterval},
+		{ErrBadSymbol, common.ErrCodeBadSymbol},
+		{ErrInvalidListenKey, common.ErrCodeInvalidListenKey},
+		{ErrOptionalParamsBadCombo, common.ErrCodeOptionalParamCombo},
+		{ErrInvalidParameter, common.ErrCodeInvalidParameter},
+		{ErrNewOrderRejected, common.ErrCodeNewOrderRejected},
+		{ErrCancelRejected, common.ErrCodeCancelRejected},
+		{ErrNoSuchOrder, common.ErrCodeNoSuchOrder},
+		{ErrBadAPIKeyFmt, common.ErrCodeBadAPIKeyFmt},
+		{ErrRejectedMBXKey, common.ErrCodeRejectedMbxKey},
+		{ErrNoTradingWindow, common.ErrCodeNoTradingWindow},
+		{ErrBalanceNotSufficient, common.ErrCodeBalanceNotSufficient},
+		{ErrMarginNotSufficient, common.ErrCodeMarginNotSufficient},
+		{ErrUnableToFill, common.ErrCodeUnableToFill},
+		{ErrOrderWouldImmediatelyTrigger, common.ErrCodeOrderWouldTrigger},
+		{ErrReduceOnlyReject, common.ErrCodeReduceOnlyReject},
+		{ErrMaxOpenOrderExceeded, common.ErrCodeMaxOpenOrdersExceeded},
+		{ErrMaxLeverageRatio, common.ErrCodeMaxLeverageRatio},
+		{ErrMinLeverageRatio, common.ErrCodeMinLeverageRatio},
+		{ErrInvalidOrderStatus, common.ErrCodeInvalidOrderStatus},
+		{ErrPriceLessThanZero, common.ErrCodePriceLessThanZero},
+		{ErrPriceGreaterThanMaxPrice, common.ErrCodePriceGreaterThanMax},
+		{ErrQtyLessThanZero, common.ErrCodeQtyLessThanZero},
+		{ErrQtyLessThanMinQty, common.ErrCodeQtyLessThanMin},
+		{ErrQtyGreaterThanMaxQty, common.ErrCodeQtyGreaterThanMax},
+		{ErrPriceNotIncreasedByTickSize, common.ErrCodePriceNotIncreased},
+		{ErrPositionSideNotMatch, common.ErrCodePositionSideMismatch},
+		{ErrMarketOrderReject, common.ErrCodePercentPriceReject},
+		{ErrMinNotional, common.ErrCodeMinNotional},
+		{ErrFOKOrderReject, common.ErrCodeFOKRejected},
+		{ErrGTXOrderReject, common.ErrCodePostOnlyRejected},
+	}
+	for _, c := range codes {
+		assert.Equal(t, c.common, c.code)
+	}
+}
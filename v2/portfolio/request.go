@@ -5,6 +5,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type secType int
@@ -28,6 +30,8 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+
+	retryPolicy *common.RetryPolicy
 }
 
 // setParam set param with key/value to query string
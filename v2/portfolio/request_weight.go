@@ -0,0 +1,153 @@
+package portfolio
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultRequestWeightLimit is the IP request weight limit of the portfolio margin API
+var DefaultRequestWeightLimit = common.RateLimitRule{
+	RateLimitType: common.RateLimitTypeRequestWeight,
+	Interval:      "MINUTE",
+	IntervalNum:   1,
+	Limit:         6000,
+}
+
+// requestWeights define the request weight of the endpoints with a weight other than 1,
+// see https://developers.binance.com/docs/derivatives/portfolio-margin/general-info
+var requestWeights = map[string]int64{
+	"GET /papi/v1/balance":                                    20,
+	"GET /papi/v1/account":                                    20,
+	"GET /papi/v1/um/account":                                 5,
+	"GET /papi/v2/um/account":                                 5,
+	"GET /papi/v1/cm/account":                                 5,
+	"GET /papi/v1/um/positionRisk":                            5,
+	"GET /papi/v1/um/allOrders":                               5,
+	"GET /papi/v1/cm/allOrders":                               20,
+	"GET /papi/v1/um/userTrades":                              5,
+	"GET /papi/v1/cm/userTrades":                              20,
+	"GET /papi/v1/um/income":                                  30,
+	"GET /papi/v1/cm/income":                                  30,
+	"GET /papi/v1/um/adlQuantile":                             5,
+	"GET /papi/v1/cm/adlQuantile":                             5,
+	"GET /papi/v1/um/commissionRate":                          20,
+	"GET /papi/v1/cm/commissionRate":                          20,
+	"GET /papi/v1/margin/allOrders":                           100,
+	"GET /papi/v1/margin/myTrades":                            5,
+	"GET /papi/v1/margin/openOrders":                          5,
+	"GET /papi/v1/margin/allOrderList":                        100,
+	"GET /papi/v1/margin/openOrderList":                       5,
+	"GET /papi/v1/margin/orderList":                           5,
+	"GET /papi/v1/margin/order":                               5,
+	"GET /papi/v1/margin/maxBorrowable":                       5,
+	"GET /papi/v1/margin/maxWithdraw":                         5,
+	"GET /papi/v1/rateLimit/order":                            1,
+	"POST /papi/v1/auto-collection":                           750,
+	"POST /papi/v1/asset-collection":                          30,
+	"POST /papi/v1/bnb-transfer":                              750,
+	"POST /papi/v1/repay-futures-negative-balance":            750,
+	"POST /papi/v1/repay-futures-switch":                      750,
+	"GET /papi/v1/repay-futures-switch":                       30,
+	"GET /papi/v1/portfolio/interest-history":                 50,
+	"GET /papi/v1/portfolio/negative-balance-exchange-record": 100,
+	"GET /papi/v1/um/forceOrders":                             20,
+	"GET /papi/v1/cm/forceOrders":                             20,
+	"GET /papi/v1/margin/forceOrders":                         1,
+	"GET /papi/v1/um/positionSide/dual":                       30,
+	"GET /papi/v1/cm/positionSide/dual":                       30,
+	"POST /papi/v1/um/income/asyn":                            1500,
+	"POST /papi/v1/um/order/asyn":                             1500,
+	"POST /papi/v1/um/trade/asyn":                             1500,
+	"GET /papi/v1/um/income/asyn/id":                          10,
+	"GET /papi/v1/um/order/asyn/id":                           10,
+	"GET /papi/v1/um/trade/asyn/id":                           10,
+}
+
+// orderEndpoints define the endpoints counted by the ORDERS rate limits
+var orderEndpoints = map[string]bool{
+	"POST /papi/v1/um/order":             true,
+	"PUT /papi/v1/um/order":              true,
+	"POST /papi/v1/um/conditional/order": true,
+	"POST /papi/v1/cm/order":             true,
+	"PUT /papi/v1/cm/order":              true,
+	"POST /papi/v1/cm/conditional/order": true,
+	"POST /papi/v1/margin/order":         true,
+	"POST /papi/v1/margin/order/oco":     true,
+}
+
+// param return the value of key from the query string or the form body
+func (r *request) param(key string) string {
+	if v := r.query.Get(key); v != "" {
+		return v
+	}
+	return r.form.Get(key)
+}
+
+// requestWeight return the request weight of r and the number of orders it places
+func requestWeight(r *request) (weight, orders int64) {
+	key := r.method + " " + r.endpoint
+	if orderEndpoints[key] {
+		orders = 1
+	}
+	if strings.HasSuffix(key, "/openOrders") && r.param("symbol") == "" {
+		return 40, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
+
+// isRateLimited return true for the endpoints counted by the exchangeInfo rate limits
+func isRateLimited(r *request) bool {
+	return strings.HasPrefix(r.endpoint, "/papi/")
+}
+
+// EnableRateLimiter fetch the order rate limits of the account and enable the client-side rate limiter,
+// the request weight is limited by DefaultRequestWeightLimit per minute.
+// When failFast is true requests exceeding a limit fail with a *common.RateLimitError
+// instead of waiting for the next window.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool, opts ...RequestOption) (*common.RateLimiter, error) {
+	res, err := c.NewGetRateLimitService().Do(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rules := []common.RateLimitRule{DefaultRequestWeightLimit}
+	for _, l := range res {
+		rules = append(rules, common.RateLimitRule{
+			RateLimitType: l.RateLimitType,
+			Interval:      l.Interval,
+			IntervalNum:   l.IntervalNum,
+			Limit:         l.Limit,
+		})
+	}
+	limiter := common.NewRateLimiter(rules)
+	limiter.FailFast = failFast
+	c.RateLimiter = limiter
+	return limiter, nil
+}
+
+// waitRateLimit block until r fits the rate limits of the client
+func (c *Client) waitRateLimit(ctx context.Context, r *request) error {
+	if c.RateLimiter == nil || !isRateLimited(r) {
+		return nil
+	}
+	weight, orders := requestWeight(r)
+	return c.RateLimiter.Wait(ctx, weight, orders)
+}
+
+// updateRateLimit reconcile the rate limiter with the response, the 429 and 418 responses
+// of every endpoint pause the limiter
+func (c *Client) updateRateLimit(r *request, res *http.Response) {
+	if c.RateLimiter == nil {
+		return
+	}
+	if !isRateLimited(r) {
+		c.RateLimiter.UpdateRetryAfter(res.StatusCode, res.Header)
+		return
+	}
+	c.RateLimiter.Update(res.StatusCode, res.Header)
+}
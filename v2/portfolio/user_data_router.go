@@ -0,0 +1,328 @@
+package portfolio
+
+import (
+	"strconv"
+	"sync"
+)
+
+// BusinessUnit define the business unit of a futures event of the user data stream
+type BusinessUnit string
+
+const (
+	BusinessUnitUM BusinessUnit = "UM"
+	BusinessUnitCM BusinessUnit = "CM"
+)
+
+// FuturesEventHandlers define the handlers of the events of a futures business unit,
+// a nil handler ignores its events
+type FuturesEventHandlers struct {
+	OrderUpdate            func(*WsFuturesOrderUpdate)
+	AccountUpdate          func(*WsFuturesAccountUpdate)
+	AccountConfigUpdate    func(*WsFuturesAccountConfigUpdate)
+	ConditionalOrderUpdate func(*WsConditionalOrderTradeUpdate)
+}
+
+// MarginEventHandlers define the handlers of the events of the cross margin account,
+// a nil handler ignores its events
+type MarginEventHandlers struct {
+	OrderUpdate     func(*WsMarginOrderUpdate)
+	AccountUpdate   func(*WsMarginAccountUpdate)
+	BalanceUpdate   func(*WsMarginBalanceUpdate)
+	LiabilityUpdate func(*WsLiabilityUpdate)
+}
+
+// AccountEventHandlers define the handlers of the events of the whole portfolio margin account,
+// a nil handler ignores its events
+type AccountEventHandlers struct {
+	RiskLevelChange     func(*WsRiskLevelChange)
+	OpenOrderLoss       func(*WsOpenOrderLossUpdate)
+	ListenKeyExpired    func(*WsListenKeyExpired)
+	UnknownBusinessUnit func(unit BusinessUnit, event any)
+}
+
+// UserDataRouter is a WsUserDataHandler splitting the portfolio margin user data stream into the
+// UM futures, CM futures and margin sub-streams. The risk level, liability and open order loss
+// events also update Risk, the combined risk view of the account.
+//
+// Binance doesn't offer a WebSocket API for portfolio margin accounts, the orders are placed,
+// cancelled and queried with the REST services of the package.
+type UserDataRouter struct {
+	UM      FuturesEventHandlers
+	CM      FuturesEventHandlers
+	Margin  MarginEventHandlers
+	Account AccountEventHandlers
+	Risk    *RiskView
+}
+
+// NewUserDataRouter init UserDataRouter with an empty risk view
+func NewUserDataRouter() *UserDataRouter {
+	return &UserDataRouter{Risk: NewRiskView()}
+}
+
+func (r *UserDataRouter) futures(unit string, event any) *FuturesEventHandlers {
+	switch BusinessUnit(unit) {
+	case BusinessUnitUM:
+		return &r.UM
+	case BusinessUnitCM:
+		return &r.CM
+	}
+	if r.Account.UnknownBusinessUnit != nil {
+		r.Account.UnknownBusinessUnit(BusinessUnit(unit), event)
+	}
+	return nil
+}
+
+// HandleFuturesOrderUpdate route the order update to its business unit
+func (r *UserDataRouter) HandleFuturesOrderUpdate(event *WsFuturesOrderUpdate) {
+	if h := r.futures(event.BusinessUnit, event); h != nil && h.OrderUpdate != nil {
+		h.OrderUpdate(event)
+	}
+}
+
+// HandleFuturesAccountUpdate route the account update to its business unit
+func (r *UserDataRouter) HandleFuturesAccountUpdate(event *WsFuturesAccountUpdate) {
+	if h := r.futures(event.BusinessUnit, event); h != nil && h.AccountUpdate != nil {
+		h.AccountUpdate(event)
+	}
+}
+
+// HandleFuturesAccountConfigUpdate route the account config update to its business unit
+func (r *UserDataRouter) HandleFuturesAccountConfigUpdate(event *WsFuturesAccountConfigUpdate) {
+	if h := r.futures(event.BusinessUnit, event); h != nil && h.AccountConfigUpdate != nil {
+		h.AccountConfigUpdate(event)
+	}
+}
+
+// HandleConditionalOrderTradeUpdate route the conditional order update to its business unit
+func (r *UserDataRouter) HandleConditionalOrderTradeUpdate(event *WsConditionalOrderTradeUpdate) {
+	if h := r.futures(event.Business, event); h != nil && h.ConditionalOrderUpdate != nil {
+		h.ConditionalOrderUpdate(event)
+	}
+}
+
+// HandleMarginOrderUpdate route the order update to the margin handlers
+func (r *UserDataRouter) HandleMarginOrderUpdate(event *WsMarginOrderUpdate) {
+	if r.Margin.OrderUpdate != nil {
+		r.Margin.OrderUpdate(event)
+	}
+}
+
+// HandleMarginAccountUpdate route the account update to the margin handlers
+func (r *UserDataRouter) HandleMarginAccountUpdate(event *WsMarginAccountUpdate) {
+	if r.Margin.AccountUpdate != nil {
+		r.Margin.AccountUpdate(event)
+	}
+}
+
+// HandleMarginBalanceUpdate route the balance update to the margin handlers
+func (r *UserDataRouter) HandleMarginBalanceUpdate(event *WsMarginBalanceUpdate) {
+	if r.Margin.BalanceUpdate != nil {
+		r.Margin.BalanceUpdate(event)
+	}
+}
+
+// HandleLiabilityUpdate update the risk view and route the liability update to the margin handlers
+func (r *UserDataRouter) HandleLiabilityUpdate(event *WsLiabilityUpdate) {
+	if r.Risk != nil {
+		r.Risk.HandleLiabilityUpdate(event)
+	}
+	if r.Margin.LiabilityUpdate != nil {
+		r.Margin.LiabilityUpdate(event)
+	}
+}
+
+// HandleRiskLevelChange update the risk view and route the risk level change to the account handlers
+func (r *UserDataRouter) HandleRiskLevelChange(event *WsRiskLevelChange) {
+	if r.Risk != nil {
+		r.Risk.HandleRiskLevelChange(event)
+	}
+	if r.Account.RiskLevelChange != nil {
+		r.Account.RiskLevelChange(event)
+	}
+}
+
+// HandleOpenOrderLossUpdate update the risk view and route the open order loss to the account handlers
+func (r *UserDataRouter) HandleOpenOrderLossUpdate(event *WsOpenOrderLossUpdate) {
+	if r.Risk != nil {
+		r.Risk.HandleOpenOrderLossUpdate(event)
+	}
+	if r.Account.OpenOrderLoss != nil {
+		r.Account.OpenOrderLoss(event)
+	}
+}
+
+// HandleListenKeyExpired route the listen key expiration to the account handlers
+func (r *UserDataRouter) HandleListenKeyExpired(event *WsListenKeyExpired) {
+	if r.Account.ListenKeyExpired != nil {
+		r.Account.ListenKeyExpired(event)
+	}
+}
+
+// RiskLevel define the risk level of the portfolio margin account, Status is NORMAL or one of
+// the RiskStatus constants
+type RiskLevel struct {
+	UniMMR               string
+	Status               string
+	EquityUSD            string
+	ActualEquityUSD      string
+	MaintenanceMarginUSD string
+	UpdateTime           int64
+}
+
+// Liability define the liability of an asset of the margin account
+type Liability struct {
+	Asset          string
+	Principal      string
+	Interest       string
+	TotalLiability string
+	UpdateTime     int64
+}
+
+// RiskAlertHandler handle a risk alert, prev is the risk level before the change
+type RiskAlertHandler func(prev, current RiskLevel)
+
+type uniMMRAlert struct {
+	threshold float64
+	handler   RiskAlertHandler
+}
+
+// RiskView maintains the uniMMR and risk level, the liabilities and the open order losses of the
+// portfolio margin account. The user data stream only sends risk level changes, load the current
+// level with SetAccount from GetAccountService first. It is safe for concurrent use.
+type RiskView struct {
+	mu            sync.RWMutex
+	level         RiskLevel
+	liabilities   map[string]Liability
+	openOrderLoss map[string]string
+	onChange      []RiskAlertHandler
+	uniMMRAlerts  []uniMMRAlert
+}
+
+// NewRiskView init RiskView
+func NewRiskView() *RiskView {
+	return &RiskView{
+		liabilities:   make(map[string]Liability),
+		openOrderLoss: make(map[string]string),
+	}
+}
+
+// OnRiskLevelChange register handler to be called when the status of the risk level changes
+func (v *RiskView) OnRiskLevelChange(handler RiskAlertHandler) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.onChange = append(v.onChange, handler)
+}
+
+// OnUniMMRBelow register handler to be called when the uniMMR falls below threshold
+func (v *RiskView) OnUniMMRBelow(threshold float64, handler RiskAlertHandler) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.uniMMRAlerts = append(v.uniMMRAlerts, uniMMRAlert{threshold: threshold, handler: handler})
+}
+
+// SetAccount set the risk level from the account information
+func (v *RiskView) SetAccount(account *Account) {
+	v.setLevel(RiskLevel{
+		UniMMR:               account.UniMMR,
+		Status:               account.AccountStatus,
+		EquityUSD:            account.AccountEquity,
+		ActualEquityUSD:      account.ActualEquity,
+		MaintenanceMarginUSD: account.AccountMaintMargin,
+		UpdateTime:           account.UpdateTime,
+	})
+}
+
+// HandleRiskLevelChange update the risk level from the event
+func (v *RiskView) HandleRiskLevelChange(event *WsRiskLevelChange) {
+	v.setLevel(RiskLevel{
+		UniMMR:               event.UniMMRLevel,
+		Status:               event.Status,
+		EquityUSD:            event.EquityUSD,
+		ActualEquityUSD:      event.ActualEquityUSD,
+		MaintenanceMarginUSD: event.MaintenanceMarginUSD,
+		UpdateTime:           event.EventTime,
+	})
+}
+
+func (v *RiskView) setLevel(level RiskLevel) {
+	v.mu.Lock()
+	if level.UpdateTime < v.level.UpdateTime {
+		v.mu.Unlock()
+		return
+	}
+	prev := v.level
+	v.level = level
+	var handlers []RiskAlertHandler
+	if prev.Status != level.Status {
+		handlers = append(handlers, v.onChange...)
+	}
+	prevMMR, prevErr := strconv.ParseFloat(prev.UniMMR, 64)
+	mmr, err := strconv.ParseFloat(level.UniMMR, 64)
+	if err == nil {
+		for _, alert := range v.uniMMRAlerts {
+			if mmr < alert.threshold && (prevErr != nil || prevMMR >= alert.threshold) {
+				handlers = append(handlers, alert.handler)
+			}
+		}
+	}
+	v.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(prev, level)
+	}
+}
+
+// HandleLiabilityUpdate update the liability of the asset of the event
+func (v *RiskView) HandleLiabilityUpdate(event *WsLiabilityUpdate) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if l, ok := v.liabilities[event.Asset]; ok && event.EventTime < l.UpdateTime {
+		return
+	}
+	v.liabilities[event.Asset] = Liability{
+		Asset:          event.Asset,
+		Principal:      event.Principal,
+		Interest:       event.Interest,
+		TotalLiability: event.TotalLiability,
+		UpdateTime:     event.EventTime,
+	}
+}
+
+// HandleOpenOrderLossUpdate replace the open order losses by the ones of the event
+func (v *RiskView) HandleOpenOrderLossUpdate(event *WsOpenOrderLossUpdate) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.openOrderLoss = make(map[string]string, len(event.Orders))
+	for _, o := range event.Orders {
+		v.openOrderLoss[o.Asset] = o.Amount
+	}
+}
+
+// Level return the current risk level
+func (v *RiskView) Level() RiskLevel {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.level
+}
+
+// Liabilities return the liabilities by asset
+func (v *RiskView) Liabilities() map[string]Liability {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	res := make(map[string]Liability, len(v.liabilities))
+	for asset, l := range v.liabilities {
+		res[asset] = l
+	}
+	return res
+}
+
+// OpenOrderLoss return the losses of the open orders by asset
+func (v *RiskView) OpenOrderLoss() map[string]string {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	res := make(map[string]string, len(v.openOrderLoss))
+	for asset, amount := range v.openOrderLoss {
+		res[asset] = amount
+	}
+	return res
+}
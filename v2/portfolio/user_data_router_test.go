@@ -0,0 +1,91 @@
+package portfolio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserDataRouter(t *testing.T) {
+	router := NewUserDataRouter()
+	var umOrders, cmOrders, cmAccounts []string
+	var marginOrders []int64
+	var unknown []BusinessUnit
+	router.UM.OrderUpdate = func(e *WsFuturesOrderUpdate) {
+		umOrders = append(umOrders, e.Order.Symbol)
+	}
+	router.CM.OrderUpdate = func(e *WsFuturesOrderUpdate) {
+		cmOrders = append(cmOrders, e.Order.Symbol)
+	}
+	router.CM.AccountUpdate = func(e *WsFuturesAccountUpdate) {
+		cmAccounts = append(cmAccounts, e.AccountData.Balances[0].Asset)
+	}
+	router.Margin.OrderUpdate = func(e *WsMarginOrderUpdate) {
+		marginOrders = append(marginOrders, e.OrderID)
+	}
+	router.Account.UnknownBusinessUnit = func(unit BusinessUnit, event any) {
+		unknown = append(unknown, unit)
+	}
+
+	handle := wsUserDataHandler(router)
+	handle([]byte(`{"e":"ORDER_TRADE_UPDATE","fs":"UM","E":1,"T":1,"o":{"s":"BTCUSDT","i":1}}`))
+	handle([]byte(`{"e":"ORDER_TRADE_UPDATE","fs":"CM","E":2,"T":2,"o":{"s":"BTCUSD_PERP","i":2}}`))
+	handle([]byte(`{"e":"ACCOUNT_UPDATE","fs":"CM","E":3,"T":3,"a":{"m":"ORDER","B":[{"a":"BTC","wb":"1"}]}}`))
+	handle([]byte(`{"e":"ACCOUNT_UPDATE","fs":"UM","E":3,"T":3,"a":{"m":"ORDER","B":[{"a":"USDT","wb":"1"}]}}`))
+	handle([]byte(`{"e":"executionReport","E":4,"s":"BTCUSDT","i":42}`))
+	handle([]byte(`{"e":"ORDER_TRADE_UPDATE","fs":"XX","E":5,"T":5,"o":{"s":"BTCUSDT","i":3}}`))
+
+	assert.Equal(t, []string{"BTCUSDT"}, umOrders)
+	assert.Equal(t, []string{"BTCUSD_PERP"}, cmOrders)
+	assert.Equal(t, []string{"BTC"}, cmAccounts)
+	assert.Equal(t, []int64{42}, marginOrders)
+	assert.Equal(t, []BusinessUnit{"XX"}, unknown)
+}
+
+func TestUserDataRouterRiskView(t *testing.T) {
+	router := NewUserDataRouter()
+	var statuses []string
+	var alerts []string
+	router.Risk.SetAccount(&Account{
+		UniMMR:        "5.2",
+		AccountStatus: "NORMAL",
+		AccountEquity: "10000",
+		UpdateTime:    100,
+	})
+	router.Risk.OnRiskLevelChange(func(prev, current RiskLevel) {
+		statuses = append(statuses, prev.Status+"->"+current.Status)
+	})
+	router.Risk.OnUniMMRBelow(1.5, func(prev, current RiskLevel) {
+		alerts = append(alerts, current.UniMMR)
+	})
+	var events int
+	router.Account.RiskLevelChange = func(e *WsRiskLevelChange) {
+		events++
+	}
+
+	handle := wsUserDataHandler(router)
+	handle([]byte(`{"e":"riskLevelChange","E":200,"u":"1.40","s":"MARGIN_CALL","eq":"3000","ae":"3100","m":"2142"}`))
+	// the uniMMR stays below the threshold, no new alert
+	handle([]byte(`{"e":"riskLevelChange","E":300,"u":"1.10","s":"REDUCE_ONLY","eq":"2500","ae":"2600","m":"2272"}`))
+	// older event is ignored by the view
+	handle([]byte(`{"e":"riskLevelChange","E":250,"u":"2.00","s":"NORMAL","eq":"4000","ae":"4000","m":"2000"}`))
+
+	assert.Equal(t, 3, events)
+	assert.Equal(t, []string{"NORMAL->MARGIN_CALL", "MARGIN_CALL->REDUCE_ONLY"}, statuses)
+	assert.Equal(t, []string{"1.40"}, alerts)
+	assert.Equal(t, RiskLevel{
+		UniMMR:               "1.10",
+		Status:               RiskStatusReduceOnly,
+		EquityUSD:            "2500",
+		ActualEquityUSD:      "2600",
+		MaintenanceMarginUSD: "2272",
+		UpdateTime:           300,
+	}, router.Risk.Level())
+
+	handle([]byte(`{"e":"liabilityChange","E":400,"a":"USDT","t":"BORROW","T":1,"p":"100","i":"0.1","l":"100.1"}`))
+	handle([]byte(`{"e":"openOrderLoss","E":500,"O":[{"a":"BUSD","o":"-0.1"},{"a":"USDT","o":"-0.2"}]}`))
+	assert.Equal(t, map[string]Liability{
+		"USDT": {Asset: "USDT", Principal: "100", Interest: "0.1", TotalLiability: "100.1", UpdateTime: 400},
+	}, router.Risk.Liabilities())
+	assert.Equal(t, map[string]string{"BUSD": "-0.1", "USDT": "-0.2"}, router.Risk.OpenOrderLoss())
+}
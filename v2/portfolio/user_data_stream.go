@@ -0,0 +1,40 @@
+package portfolio
+
+import (
+	"context"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// NewUserDataStream init a user data stream managing its listen key, see commonws.UserDataStream.
+// The events are delivered to handler once the stream is started.
+func (c *Client) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler, opts ...commonws.UserDataStreamOption) *commonws.UserDataStream {
+	listenKeys := commonws.ListenKeyService{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+	}
+	streamHandler := &userDataStreamHandler{WsUserDataHandler: handler}
+	serve := func(listenKey string, connErrHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsUserDataServe(listenKey, streamHandler, connErrHandler)
+	}
+	streamHandler.stream = commonws.NewUserDataStream(listenKeys, serve, errHandler, opts...)
+	return streamHandler.stream
+}
+
+// userDataStreamHandler tell the stream that its listen key expired
+type userDataStreamHandler struct {
+	WsUserDataHandler
+	stream *commonws.UserDataStream
+}
+
+func (h *userDataStreamHandler) HandleListenKeyExpired(event *WsListenKeyExpired) {
+	h.stream.Expired()
+	h.WsUserDataHandler.HandleListenKeyExpired(event)
+}
@@ -85,14 +85,22 @@ type Client struct {
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
-	do         doFunc
+	// Endpoints overrides the default endpoints when set, see SetEndpoints
+	Endpoints *Endpoints
+	do        doFunc
 
 	UsedWeight common.UsedWeight
 	OrderCount common.OrderCount
+	// TimeSync overrides TimeOffset with a synced offset when set,
+	// a TimeSync enabled on another client can be shared
+	TimeSync *common.TimeSync
 }
 
 // getApiEndpoint return the base endpoint of the WS according the UseTestnet flag
 func (c *Client) getApiEndpoint() string {
+	if c.Endpoints != nil && c.Endpoints.API != "" {
+		return c.Endpoints.API
+	}
 	return BaseApiMainUrl
 }
 
@@ -117,7 +125,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	// @ is a safe character and does not require escape, So replace it back.
@@ -171,6 +179,14 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	data, err = c.doAPI(ctx, r, opts...)
+	if c.resyncTime(ctx, r, err) {
+		data, err = c.doAPI(ctx, r, opts...)
+	}
+	return data, err
+}
+
+func (c *Client) doAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -218,6 +234,8 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
+		apiErr.StatusCode = res.StatusCode
+		apiErr.Header = res.Header
 		return nil, apiErr
 	}
 	return data, nil
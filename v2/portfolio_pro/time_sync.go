@@ -0,0 +1,25 @@
+package portfolio_pro
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// timeOffset return the offset applied to the timestamp of signed requests
+func (c *Client) timeOffset() int64 {
+	return c.TimeSync.OffsetOr(c.TimeOffset)
+}
+
+// resyncTime re-sync the clock when a signed request failed with -1021,
+// it returns true when the request should be retried
+func (c *Client) resyncTime(ctx context.Context, r *request, err error) bool {
+	if c.TimeSync == nil || r.secType != secTypeSigned || !common.IsInvalidTimestampError(err) {
+		return false
+	}
+	if serr := c.TimeSync.Sync(ctx); serr != nil {
+		c.debug("failed to sync server time: %s\n", serr)
+		return false
+	}
+	return true
+}
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type secType int
@@ -30,6 +32,8 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+
+	retryPolicy *common.RetryPolicy
 }
 
 // addParam add param with key/value to query string
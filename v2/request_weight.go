@@ -0,0 +1,192 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the request weight of the /api endpoints with a fixed weight,
+// see https://developers.binance.com/docs/binance-spot-api-docs/rest-api
+var requestWeights = map[string]int64{
+	"GET /api/v3/ping":                       1,
+	"GET /api/v3/time":                       1,
+	"GET /api/v3/exchangeInfo":               20,
+	"GET /api/v3/trades":                     25,
+	"GET /api/v3/historicalTrades":           25,
+	"GET /api/v3/aggTrades":                  2,
+	"GET /api/v3/klines":                     2,
+	"GET /api/v3/uiKlines":                   2,
+	"GET /api/v3/avgPrice":                   2,
+	"POST /api/v3/order":                     1,
+	"POST /api/v3/order/test":                1,
+	"GET /api/v3/order":                      4,
+	"DELETE /api/v3/order":                   1,
+	"POST /api/v3/order/cancelReplace":       1,
+	"PUT /api/v3/order/amend/keepPriority":   4,
+	"DELETE /api/v3/openOrders":              1,
+	"GET /api/v3/allOrders":                  20,
+	"POST /api/v3/order/oco":                 1,
+	"POST /api/v3/orderList/oco":             1,
+	"POST /api/v3/orderList/oto":             1,
+	"POST /api/v3/orderList/otoco":           1,
+	"DELETE /api/v3/orderList":               1,
+	"GET /api/v3/orderList":                  4,
+	"GET /api/v3/allOrderList":               20,
+	"GET /api/v3/openOrderList":              6,
+	"POST /api/v3/sor/order":                 1,
+	"POST /api/v3/sor/order/test":            1,
+	"GET /api/v3/account":                    20,
+	"GET /api/v3/rateLimit/order":            40,
+	"GET /api/v3/myPreventedMatches":         2,
+	"GET /api/v3/myAllocations":              20,
+	"GET /api/v3/account/commission":         20,
+	"POST /api/v3/userDataStream":            2,
+	"PUT /api/v3/userDataStream":             2,
+	"DELETE /api/v3/userDataStream":          2,
+	"GET /api/v3/order/amendments":           4,
+	"GET /api/v3/ticker/tradingDay":          4,
+	"GET /api/v3/executionRules":             20,
+	"GET /api/v3/referencePrice":             2,
+	"GET /api/v3/referencePrice/calculation": 2,
+}
+
+// orderEndpoints define the endpoints counted by the ORDERS rate limits
+var orderEndpoints = map[string]bool{
+	"POST /api/v3/order":                   true,
+	"POST /api/v3/order/cancelReplace":     true,
+	"PUT /api/v3/order/amend/keepPriority": true,
+	"POST /api/v3/order/oco":               true,
+	"POST /api/v3/orderList/oco":           true,
+	"POST /api/v3/orderList/oto":           true,
+	"POST /api/v3/orderList/otoco":         true,
+	"POST /api/v3/sor/order":               true,
+}
+
+// param return the value of key from the query string or the form body
+func (r *request) param(key string) string {
+	if v := r.query.Get(key); v != "" {
+		return v
+	}
+	return r.form.Get(key)
+}
+
+// symbolCount return the number of symbols requested through the symbols param
+func (r *request) symbolCount() int {
+	symbols := r.param("symbols")
+	if symbols == "" {
+		return 0
+	}
+	return strings.Count(symbols, ",") + 1
+}
+
+// requestWeight return the request weight of r and the number of orders it places
+func requestWeight(r *request) (weight, orders int64) {
+	key := r.method + " " + r.endpoint
+	if orderEndpoints[key] {
+		orders = 1
+	}
+	switch key {
+	case "GET /api/v3/depth":
+		limit, _ := strconv.Atoi(r.param("limit"))
+		switch {
+		case limit > 1000:
+			return 250, orders
+		case limit > 500:
+			return 50, orders
+		case limit > 100:
+			return 25, orders
+		default:
+			return 5, orders
+		}
+	case "GET /api/v3/ticker/24hr":
+		n := r.symbolCount()
+		switch {
+		case r.param("symbol") != "" || (n > 0 && n <= 20):
+			return 2, orders
+		case n > 0 && n <= 100:
+			return 40, orders
+		default:
+			return 80, orders
+		}
+	case "GET /api/v3/ticker/price", "GET /api/v3/ticker/bookTicker":
+		if r.param("symbol") != "" {
+			return 2, orders
+		}
+		return 4, orders
+	case "GET /api/v3/ticker":
+		n := int64(r.symbolCount())
+		if n == 0 || n > 50 {
+			return 200, orders
+		}
+		return 4 * n, orders
+	case "GET /api/v3/openOrders":
+		if r.param("symbol") != "" {
+			return 6, orders
+		}
+		return 80, orders
+	case "GET /api/v3/myTrades":
+		if r.param("orderId") != "" {
+			return 5, orders
+		}
+		return 20, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
+
+// isRateLimited return true for the endpoints counted by the exchangeInfo rate limits,
+// the /sapi endpoints have their own limits and are not counted
+func isRateLimited(r *request) bool {
+	return strings.HasPrefix(r.endpoint, "/api/")
+}
+
+// EnableRateLimiter fetch the exchange rate limits and enable the client-side rate limiter.
+// When failFast is true requests exceeding a limit fail with a *common.RateLimitError
+// instead of waiting for the next window.
+func (c *Client) EnableRateLimiter(ctx context.Context, failFast bool, opts ...RequestOption) (*common.RateLimiter, error) {
+	res, err := c.NewExchangeInfoService().Do(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]common.RateLimitRule, 0, len(res.RateLimits))
+	for _, l := range res.RateLimits {
+		rules = append(rules, common.RateLimitRule{
+			RateLimitType: l.RateLimitType,
+			Interval:      l.Interval,
+			IntervalNum:   l.IntervalNum,
+			Limit:         l.Limit,
+		})
+	}
+	limiter := common.NewRateLimiter(rules)
+	limiter.FailFast = failFast
+	c.RateLimiter = limiter
+	return limiter, nil
+}
+
+// waitRateLimit block until r fits the rate limits of the client
+func (c *Client) waitRateLimit(ctx context.Context, r *request) error {
+	if c.RateLimiter == nil || !isRateLimited(r) {
+		return nil
+	}
+	weight, orders := requestWeight(r)
+	return c.RateLimiter.Wait(ctx, weight, orders)
+}
+
+// updateRateLimit reconcile the rate limiter with the response, the 429 and 418 responses
+// of every endpoint pause the limiter
+func (c *Client) updateRateLimit(r *request, res *http.Response) {
+	if c.RateLimiter == nil {
+		return
+	}
+	if !isRateLimited(r) {
+		c.RateLimiter.UpdateRetryAfter(res.StatusCode, res.Header)
+		return
+	}
+	c.RateLimiter.Update(res.StatusCode, res.Header)
+}
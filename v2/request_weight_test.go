@@ -0,0 +1,106 @@
+package binance
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type requestWeightTestSuite struct {
+	baseTestSuite
+}
+
+func TestRequestWeight(t *testing.T) {
+	suite.Run(t, new(requestWeightTestSuite))
+}
+
+func (s *requestWeightTestSuite) TestRequestWeight() {
+	tests := []struct {
+		method   string
+		endpoint string
+		params   params
+		weight   int64
+		orders   int64
+	}{
+		{http.MethodGet, "/api/v3/depth", params{"limit": 5000}, 250, 0},
+		{http.MethodGet, "/api/v3/depth", nil, 5, 0},
+		{http.MethodGet, "/api/v3/openOrders", params{"symbol": "BTCUSDT"}, 6, 0},
+		{http.MethodGet, "/api/v3/openOrders", nil, 80, 0},
+		{http.MethodGet, "/api/v3/ticker", params{"symbols": `["BTCUSDT","ETHUSDT"]`}, 8, 0},
+		{http.MethodPost, "/api/v3/order", params{"symbol": "BTCUSDT"}, 1, 1},
+		{http.MethodGet, "/api/v3/exchangeInfo", nil, 20, 0},
+		{http.MethodGet, "/api/v3/unknown", nil, 1, 0},
+	}
+	for _, t := range tests {
+		r := newRequest()
+		r.method = t.method
+		r.endpoint = t.endpoint
+		r.setParams(t.params)
+		weight, orders := requestWeight(r)
+		s.r().Equal(t.weight, weight, t.endpoint)
+		s.r().Equal(t.orders, orders, t.endpoint)
+	}
+}
+
+func (s *requestWeightTestSuite) TestEnableRateLimiter() {
+	data := []byte(`{
+		"timezone": "UTC",
+		"serverTime": 1508631584636,
+		"rateLimits": [
+			{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 20},
+			{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 100}
+		],
+		"exchangeFilters": [],
+		"symbols": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	limiter, err := s.client.EnableRateLimiter(newContext(), true)
+	s.r().NoError(err)
+	s.r().Equal(limiter, s.client.RateLimiter)
+
+	// the exchangeInfo weight consumes the whole minute
+	_, err = s.client.NewExchangeInfoService().Do(newContext())
+	s.r().False(common.IsRateLimitError(err))
+	_, err = s.client.NewExchangeInfoService().Do(newContext())
+	s.r().True(common.IsRateLimitError(err))
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+}
+
+func (s *requestWeightTestSuite) TestWaitRateLimitBeforeSigning() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	var timestamp int64
+	s.assertReq(func(r *request) {
+		timestamp, _ = strconv.ParseInt(r.query.Get(timestampKey), 10, 64)
+	})
+	s.client.RateLimiter = common.NewRateLimiter(nil)
+	start := time.Now()
+	s.client.RateLimiter.Pause(200 * time.Millisecond)
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	// the request is signed once the limiter let it through
+	s.r().GreaterOrEqual(timestamp, start.Add(200*time.Millisecond).UnixMilli())
+}
+
+func (s *requestWeightTestSuite) TestUpdateRateLimitUncountedEndpoint() {
+	s.mockDo([]byte(`{"code":-1003,"msg":"Too many requests"}`), nil, http.StatusTooManyRequests)
+	defer s.assertDo()
+
+	s.client.RateLimiter = common.NewRateLimiter(nil)
+	s.client.RateLimiter.FailFast = true
+	_, err := s.client.NewGetAccountSnapshotService().Type("SPOT").Do(newContext())
+	s.r().Error(err)
+
+	// the 429 of a /sapi endpoint pauses the /api endpoints too
+	_, err = s.client.NewExchangeInfoService().Do(newContext())
+	s.r().True(common.IsRateLimitError(err))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
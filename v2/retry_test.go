@@ -0,0 +1,158 @@
+package binance
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+	requests []*http.Request
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+type mockResponse struct {
+	data       string
+	statusCode int
+	err        error
+}
+
+// mockResponses answer each request with the next response
+func (s *retryTestSuite) mockResponses(responses ...mockResponse) {
+	s.requests = nil
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.Body != nil {
+			body, err := io.ReadAll(req.Body)
+			s.r().NoError(err)
+			req.Header.Set("X-Test-Body", string(body))
+		}
+		s.requests = append(s.requests, req)
+		s.r().LessOrEqual(len(s.requests), len(responses), "unexpected request %s", req.URL)
+		res := responses[len(s.requests)-1]
+		if res.err != nil {
+			return nil, res.err
+		}
+		return newHTTPResponse([]byte(res.data), res.statusCode), nil
+	}
+	s.client.RetryPolicy = &common.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+}
+
+func (s *retryTestSuite) TestRetryServerError() {
+	s.mockResponses(
+		mockResponse{data: `<html>bad gateway</html>`, statusCode: http.StatusBadGateway},
+		mockResponse{data: `{"code":-1001,"msg":"Internal error; unable to process your request. Please try again."}`, statusCode: http.StatusBadRequest},
+		mockResponse{data: `{"serverTime":1499827319559}`, statusCode: http.StatusOK},
+	)
+	serverTime, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1499827319559), serverTime)
+	s.r().Len(s.requests, 3)
+}
+
+func (s *retryTestSuite) TestRetryExhausted() {
+	s.mockResponses(
+		mockResponse{data: `{}`, statusCode: http.StatusServiceUnavailable},
+		mockResponse{data: `{}`, statusCode: http.StatusServiceUnavailable},
+		mockResponse{data: `{}`, statusCode: http.StatusServiceUnavailable},
+	)
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().Error(err)
+	s.r().Equal(http.StatusServiceUnavailable, err.(*common.APIError).StatusCode)
+	s.r().Len(s.requests, 3)
+}
+
+func (s *retryTestSuite) TestNoRetryRejected() {
+	s.mockResponses(
+		mockResponse{data: `{"code":-2010,"msg":"Account has insufficient balance for requested action."}`, statusCode: http.StatusBadRequest},
+	)
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().Error(err)
+	s.r().Len(s.requests, 1)
+}
+
+func (s *retryTestSuite) TestRequestRetryPolicy() {
+	s.mockResponses(
+		mockResponse{data: `{}`, statusCode: http.StatusBadGateway},
+	)
+	_, err := s.client.NewServerTimeService().Do(newContext(), WithRetryPolicy(&common.RetryPolicy{}))
+	s.r().Error(err)
+	s.r().Len(s.requests, 1)
+}
+
+func (s *retryTestSuite) TestOrderUnknownStatusFound() {
+	s.mockResponses(
+		mockResponse{data: `{"code":-1007,"msg":"Timeout waiting for response from backend server. Send status unknown; execution status unknown."}`, statusCode: http.StatusBadRequest},
+		mockResponse{data: `{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"myOrder1","status":"FILLED","time":1499827319559,"updateTime":1499827319560}`, statusCode: http.StatusOK},
+	)
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(28), res.OrderID)
+	s.r().Equal(OrderStatusTypeFilled, res.Status)
+	// the order query response has no fills
+	s.r().Equal(int64(1499827319559), res.TransactTime)
+	s.r().Empty(res.Fills)
+	s.r().Len(s.requests, 2)
+	query := s.requests[1]
+	s.r().Equal(http.MethodGet, query.Method)
+	s.r().Equal("/api/v3/order", query.URL.Path)
+	s.r().Equal("BTCUSDT", query.URL.Query().Get("symbol"))
+	s.r().Equal("myOrder1", query.URL.Query().Get("origClientOrderId"))
+}
+
+func (s *retryTestSuite) TestOrderUnknownStatusNotFound() {
+	s.mockResponses(
+		mockResponse{err: io.ErrUnexpectedEOF},
+		mockResponse{data: `{"code":-2013,"msg":"Order does not exist."}`, statusCode: http.StatusBadRequest},
+		mockResponse{data: `{"symbol":"BTCUSDT","orderId":29,"status":"NEW"}`, statusCode: http.StatusOK},
+	)
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(29), res.OrderID)
+	s.r().Len(s.requests, 3)
+	// the generated client order id is kept when the order is sent again
+	first := s.requests[0].Header.Get("X-Test-Body")
+	s.r().Contains(first, "newClientOrderId=")
+	s.r().Equal(first, s.requests[2].Header.Get("X-Test-Body"))
+}
+
+func (s *retryTestSuite) TestOrderUnknownStatusQueryFailed() {
+	s.mockResponses(
+		mockResponse{data: `{}`, statusCode: http.StatusServiceUnavailable},
+		mockResponse{data: `{}`, statusCode: http.StatusServiceUnavailable},
+	)
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().Error(err)
+	s.r().Len(s.requests, 2)
+}
+
+func (s *retryTestSuite) TestNoResendUnknownStatus() {
+	s.mockResponses(
+		mockResponse{data: `{"code":-1007,"msg":"Timeout waiting for response from backend server. Send status unknown; execution status unknown."}`, statusCode: http.StatusBadRequest},
+	)
+	_, err := s.client.NewCreateWithdrawService().Coin("USDT").Address("myAddress").Amount("1").Do(newContext())
+	s.r().Error(err)
+	s.r().Len(s.requests, 1)
+}
+
+func (s *retryTestSuite) TestResendNotProcessed() {
+	s.mockResponses(
+		mockResponse{data: `{"code":-1001,"msg":"Internal error; unable to process your request. Please try again."}`, statusCode: http.StatusBadRequest},
+		mockResponse{data: `{"id":"7213fea8e94b4a5593d507237e5a555b"}`, statusCode: http.StatusOK},
+	)
+	res, err := s.client.NewCreateWithdrawService().Coin("USDT").Address("myAddress").Amount("1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("7213fea8e94b4a5593d507237e5a555b", res.ID)
+	s.r().Len(s.requests, 2)
+}
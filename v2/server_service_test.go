@@ -75,6 +75,8 @@ func (s *serverServiceTestSuite) TestServerTimeBadRequest() {
 	_, err := s.client.NewServerTimeService().Do(newContext())
 	s.r().Error(err)
 	s.r().True(common.IsAPIError(err))
+	s.r().ErrorIs(err, common.ErrBadSymbol)
+	s.r().Equal(http.StatusBadRequest, err.(*common.APIError).StatusCode)
 }
 
 func (s *serverServiceTestSuite) TestInvalidResponseBody() {
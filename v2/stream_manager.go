@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	gorilla "github.com/gorilla/websocket"
+)
+
+// StreamManager multiplexes market streams over combined stream connections.
+// Streams are added and removed without reconnecting, spread over several
+// connections past 1024 streams, and subscribed again after a reconnect.
+type StreamManager struct {
+	*websocket.StreamManager
+	errHandler ErrHandler
+}
+
+// NewStreamManager init a StreamManager on the combined stream endpoint
+func (c *Client) NewStreamManager(errHandler ErrHandler) *StreamManager {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	dial := func(streams []string, handler func(message []byte), errHandler func(err error), onConn func(conn *gorilla.Conn)) (doneC, stopC chan struct{}, err error) {
+		endpoint := c.getCombinedEndpoint() + strings.Join(streams, "/")
+		if len(streams) == 0 {
+			endpoint = strings.TrimSuffix(endpoint, "?streams=")
+		}
+		cfg := c.wsConfig(endpoint)
+		return wsServeWithConnHandler(cfg, handler, errHandler, func(ctx context.Context, conn *gorilla.Conn) {
+			if WebsocketKeepalive {
+				keepAliveWithPong(ctx, conn, WebsocketTimeout)
+			}
+			onConn(conn)
+		})
+	}
+	return &StreamManager{
+		StreamManager: websocket.NewStreamManager(dial, errHandler),
+		errHandler:    errHandler,
+	}
+}
+
+// subscribeStreams decode the data of streams into a new T and pass it to handler
+func subscribeStreams[T any](m *StreamManager, streams []string, handler func(event *T)) ([]string, error) {
+	err := m.Subscribe(func(stream string, data []byte) {
+		event := new(T)
+		if err := json.Unmarshal(data, event); err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	}, streams...)
+	return streams, err
+}
+
+func symbolStreams(symbols []string, format string) []string {
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		streams[i] = fmt.Sprintf(format, strings.ToLower(symbol))
+	}
+	return streams
+}
+
+// SubscribeAggTrade subscribe the aggregate trade streams of symbols and return their names
+func (m *StreamManager) SubscribeAggTrade(symbols []string, handler WsAggTradeHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@aggTrade"), handler)
+}
+
+// SubscribeTrade subscribe the trade streams of symbols and return their names
+func (m *StreamManager) SubscribeTrade(symbols []string, handler WsTradeHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@trade"), handler)
+}
+
+// SubscribeKline subscribe the kline streams of symbols at interval and return their names
+func (m *StreamManager) SubscribeKline(symbols []string, interval string, handler WsKlineHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@kline_"+interval), handler)
+}
+
+// SubscribeMarketStat subscribe the 24hr ticker streams of symbols and return their names
+func (m *StreamManager) SubscribeMarketStat(symbols []string, handler WsMarketStatHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@ticker"), handler)
+}
+
+// SubscribeBookTicker subscribe the best bid and ask streams of symbols and return their names
+func (m *StreamManager) SubscribeBookTicker(symbols []string, handler WsBookTickerHandler) ([]string, error) {
+	return subscribeStreams(m, symbolStreams(symbols, "%s@bookTicker"), handler)
+}
+
+// SubscribeDepth subscribe the diff depth streams of symbols, using 1sec updates, and return their names
+func (m *StreamManager) SubscribeDepth(symbols []string, handler WsDepthHandler) ([]string, error) {
+	return m.subscribeDepth(symbolStreams(symbols, "%s@depth"), handler)
+}
+
+// SubscribeDepth100Ms subscribe the diff depth streams of symbols, using 100msec updates, and return their names
+func (m *StreamManager) SubscribeDepth100Ms(symbols []string, handler WsDepthHandler) ([]string, error) {
+	return m.subscribeDepth(symbolStreams(symbols, "%s@depth@100ms"), handler)
+}
+
+func (m *StreamManager) subscribeDepth(streams []string, handler WsDepthHandler) ([]string, error) {
+	err := m.Subscribe(func(stream string, data []byte) {
+		event, err := parseWsDepthEvent(data)
+		if err != nil {
+			m.errHandler(err)
+			return
+		}
+		handler(event)
+	}, streams...)
+	return streams, err
+}
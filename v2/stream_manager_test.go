@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type streamManagerTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler, ConnHandler) (chan struct{}, chan struct{}, error)
+	endpoints   []string
+	handler     WsHandler
+}
+
+func TestStreamManager(t *testing.T) {
+	suite.Run(t, new(streamManagerTestSuite))
+}
+
+func (s *streamManagerTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServeWithConnHandler
+	WebsocketKeepalive = false
+	wsServeWithConnHandler = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
+		s.endpoints = append(s.endpoints, cfg.Endpoint)
+		s.handler = handler
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		connHandler(context.Background(), nil)
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+}
+
+func (s *streamManagerTestSuite) TearDownTest() {
+	wsServeWithConnHandler = s.origWsServe
+	WebsocketKeepalive = true
+	s.endpoints = nil
+	s.handler = nil
+}
+
+func (s *streamManagerTestSuite) TestSubscribe() {
+	m := s.client.NewStreamManager(func(err error) {
+		s.r().FailNow(err.Error())
+	})
+	defer m.Close()
+
+	var klines []*WsKlineEvent
+	streams, err := m.SubscribeKline([]string{"BNBBTC", "ETHBTC"}, "1m", func(event *WsKlineEvent) {
+		klines = append(klines, event)
+	})
+	s.r().NoError(err)
+	s.r().Equal([]string{"bnbbtc@kline_1m", "ethbtc@kline_1m"}, streams)
+	s.r().Equal([]string{BaseCombinedMainURL + "bnbbtc@kline_1m/ethbtc@kline_1m"}, s.endpoints)
+
+	s.handler([]byte(`{"stream":"ethbtc@kline_1m","data":{"e":"kline","E":1499404907056,"s":"ETHBTC","k":{"t":1499404860000,"i":"1m","o":"0.10278577","c":"0.10278645"}}}`))
+	s.r().Len(klines, 1)
+	s.r().Equal("ETHBTC", klines[0].Symbol)
+	s.r().Equal("0.10278645", klines[0].Kline.Close)
+
+	// messages of unknown streams are dropped
+	s.handler([]byte(`{"stream":"bnbbtc@trade","data":{}}`))
+	s.r().Len(klines, 1)
+	s.r().Equal(streams, m.Streams())
+}
+
+func (s *streamManagerTestSuite) TestSubscribeDepth() {
+	m := s.client.NewStreamManager(nil)
+	defer m.Close()
+
+	var events []*WsDepthEvent
+	streams, err := m.SubscribeDepth100Ms([]string{"BNBBTC"}, func(event *WsDepthEvent) {
+		events = append(events, event)
+	})
+	s.r().NoError(err)
+	s.r().Equal([]string{"bnbbtc@depth@100ms"}, streams)
+
+	s.handler([]byte(`{"stream":"bnbbtc@depth@100ms","data":{"e":"depthUpdate","E":1499404630606,"s":"BNBBTC","U":157,"u":160,"b":[["0.0024","10"]],"a":[["0.0026","100"]]}}`))
+	s.r().Len(events, 1)
+	s.assertWsDepthEventEqual(&WsDepthEvent{
+		Event:         "depthUpdate",
+		Time:          1499404630606,
+		Symbol:        "BNBBTC",
+		FirstUpdateID: 157,
+		LastUpdateID:  160,
+		Bids:          []Bid{{Price: "0.0024", Quantity: "10"}},
+		Asks:          []Ask{{Price: "0.0026", Quantity: "100"}},
+	}, events[0])
+
+	s.r().NoError(m.Unsubscribe(streams...))
+	s.r().Empty(m.Streams())
+	s.r().Equal(0, m.Connections())
+}
+
+func (s *streamManagerTestSuite) assertWsDepthEventEqual(e, a *WsDepthEvent) {
+	r := s.r()
+	r.Equal(e.Event, a.Event, "Event")
+	r.Equal(e.Time, a.Time, "Time")
+	r.Equal(e.Symbol, a.Symbol, "Symbol")
+	r.Equal(e.FirstUpdateID, a.FirstUpdateID, "FirstUpdateID")
+	r.Equal(e.LastUpdateID, a.LastUpdateID, "LastUpdateID")
+	r.Equal(e.Bids, a.Bids, "Bids")
+	r.Equal(e.Asks, a.Asks, "Asks")
+}
@@ -0,0 +1,72 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common/streamrec"
+	gorilla "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamRecordReplay(t *testing.T) {
+	messages := []string{
+		`{"e":"trade","E":1,"s":"BTCUSDT","t":1,"p":"100.0","q":"1.0","T":1,"m":true,"M":true}`,
+		`{"e":"trade","E":2,"s":"BTCUSDT","t":2,"p":"101.0","q":"2.0","T":2,"m":false,"M":true}`,
+	}
+	upgrader := gorilla.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ws/btcusdt@trade", r.URL.Path)
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for _, m := range messages {
+			conn.WriteMessage(gorilla.TextMessage, []byte(m))
+		}
+		conn.WriteControl(gorilla.CloseMessage, gorilla.FormatCloseMessage(gorilla.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "")
+	client.SetEndpoints(NewLocalEndpoints(server.URL))
+	path := filepath.Join(t.TempDir(), "trades.jsonl.gz")
+	rec, err := streamrec.NewRecorder(path)
+	require.NoError(t, err)
+	client.StreamRecorder = rec
+	var live []*WsTradeEvent
+	doneC, _, err := client.WsTradeServe("BTCUSDT", func(event *WsTradeEvent) {
+		live = append(live, event)
+	}, func(err error) {})
+	require.NoError(t, err)
+	<-doneC
+	require.NoError(t, rec.Close())
+	require.Len(t, live, 2)
+
+	replayer := streamrec.NewReplayer(path, streamrec.SpeedMax)
+	client = NewClient("", "")
+	client.StreamReplayer = replayer
+	var replayed []*WsTradeEvent
+	var combined []*WsCombinedTradeEvent
+	errHandler := func(err error) { t.Error(err) }
+	_, _, err = client.WsTradeServe("BTCUSDT", func(event *WsTradeEvent) {
+		replayed = append(replayed, event)
+	}, errHandler)
+	require.NoError(t, err)
+	combinedDoneC, _, err := client.WsCombinedTradeServe([]string{"BTCUSDT", "ETHUSDT"}, func(event *WsCombinedTradeEvent) {
+		combined = append(combined, event)
+	}, errHandler)
+	require.NoError(t, err)
+	require.NoError(t, replayer.Run())
+	<-combinedDoneC
+
+	assert.Equal(t, live, replayed)
+	require.Len(t, combined, 2)
+	assert.Equal(t, "btcusdt@trade", combined[0].Stream)
+	assert.Equal(t, *live[1], combined[1].Data)
+}
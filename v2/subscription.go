@@ -0,0 +1,77 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// StreamKlines subscribe to the kline stream of symbol, see websocket.Subscription
+func (c *Client) StreamKlines(ctx context.Context, symbol string, interval string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsKlineEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsKlineEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// StreamAggTrades subscribe to the aggregate trade stream of symbol
+func (c *Client) StreamAggTrades(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsAggTradeEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsAggTradeEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsAggTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamTrades subscribe to the trade stream of symbol
+func (c *Client) StreamTrades(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsTradeEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsTradeEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamDepth subscribe to the diff depth stream of symbol, using 1sec updates
+func (c *Client) StreamDepth(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsDepthEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsDepthEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsDepthServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamDepth100Ms subscribe to the diff depth stream of symbol, using 100msec updates
+func (c *Client) StreamDepth100Ms(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsDepthEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsDepthEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsDepthServe100Ms(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamPartialDepth subscribe to the partial depth stream of symbol, levels is 5, 10 or 20
+func (c *Client) StreamPartialDepth(ctx context.Context, symbol string, levels string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsPartialDepthEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsPartialDepthEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, opts...)
+}
+
+// StreamBookTicker subscribe to the best bid and ask stream of symbol
+func (c *Client) StreamBookTicker(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsBookTickerEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsBookTickerEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsBookTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamMarketStat subscribe to the 24hr ticker stream of symbol
+func (c *Client) StreamMarketStat(ctx context.Context, symbol string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsMarketStatEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsMarketStatEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsMarketStatServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// StreamUserData subscribe to the user data stream of listenKey
+func (c *Client) StreamUserData(ctx context.Context, listenKey string, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsUserDataEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsUserDataEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
+
+// StreamUserDataSignature subscribe to the user data stream through the WebSocket API, see WsUserDataServeSignature
+func (c *Client) StreamUserDataSignature(ctx context.Context, opts ...websocket.SubscriptionOption) (*websocket.Subscription[*WsUserDataEvent], error) {
+	return websocket.NewSubscription(ctx, func(handler func(*WsUserDataEvent), errHandler func(error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsUserDataServeSignature(handler, errHandler)
+	}, opts...)
+}
@@ -0,0 +1,41 @@
+package binance
+
+import (
+	"context"
+	"errors"
+)
+
+func (s *websocketServiceTestSuite) TestStreamKlines() {
+	data := []byte(`{
+        "e": "kline",
+        "E": 1499404907056,
+        "s": "ETHBTC",
+        "k": {
+            "t": 1499404860000,
+            "T": 1499404919999,
+            "s": "ETHBTC",
+            "i": "1m",
+            "o": "0.10278577",
+            "c": "0.10278645"
+        }
+    }`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewClient("", "")
+	sub, err := c.StreamKlines(ctx, "ETHBTC", "1m")
+	s.r().NoError(err)
+
+	event := <-sub.Events()
+	s.r().Equal("ETHBTC", event.Symbol)
+	s.r().Equal("0.10278645", event.Kline.Close)
+	s.r().EqualError(<-sub.Errors(), fakeErrMsg)
+
+	cancel()
+	<-sub.Done()
+	_, ok := <-sub.Events()
+	s.r().False(ok)
+	s.r().ErrorIs(sub.Err(), context.Canceled)
+}
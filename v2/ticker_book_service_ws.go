@@ -0,0 +1,169 @@
+package binance
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// TickerBookWsApiService queries best price and quantity on the order book
+type TickerBookWsApiService struct {
+	c websocket.Client
+}
+
+// NewTickerBookWsApiService init TickerBookWsApiService
+func (c *Client) NewTickerBookWsApiService() (*TickerBookWsApiService, error) {
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TickerBookWsApiService{
+		c: client,
+	}, nil
+}
+
+// TickerBookWsRequest parameters for 'ticker.book' websocket API
+type TickerBookWsRequest struct {
+	symbol  *string
+	symbols []string
+}
+
+// NewTickerBookWsRequest init TickerBookWsRequest
+func NewTickerBookWsRequest() *TickerBookWsRequest {
+	return &TickerBookWsRequest{}
+}
+
+func (s *TickerBookWsRequest) GetParams() map[string]any {
+	return s.buildParams()
+}
+
+// buildParams builds params
+func (s *TickerBookWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) > 0 {
+		m["symbols"] = s.symbols
+	}
+	return m
+}
+
+// Do - sends 'ticker.book' request
+func (s *TickerBookWsApiService) Do(requestID string, request *TickerBookWsRequest) error {
+	rawData, err := websocket.CreateUnsignedRequest(
+		requestID,
+		websocket.TickerBookSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.c.Write(requestID, rawData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncDo - sends 'ticker.book' request and receives response
+func (s *TickerBookWsApiService) SyncDo(requestID string, request *TickerBookWsRequest) (*TickerBookWsResponse, error) {
+	rawData, err := websocket.CreateUnsignedRequest(
+		requestID,
+		websocket.TickerBookSpotWsApiMethod,
+		request.buildParams(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tickerBookWsResponse := &TickerBookWsResponse{}
+	if err := json.Unmarshal(response, tickerBookWsResponse); err != nil {
+		return nil, err
+	}
+
+	return tickerBookWsResponse, nil
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (s *TickerBookWsApiService) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	s.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (s *TickerBookWsApiService) GetReadChannel() <-chan []byte {
+	return s.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (s *TickerBookWsApiService) GetReadErrorChannel() <-chan error {
+	return s.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (s *TickerBookWsApiService) GetReconnectCount() int64 {
+	return s.c.GetReconnectCount()
+}
+
+// Symbol set symbol
+func (s *TickerBookWsRequest) Symbol(symbol string) *TickerBookWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *TickerBookWsRequest) Symbols(symbols []string) *TickerBookWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// TickerBookWsResponse define 'ticker.book' websocket API response, Result holds one
+// ticker when the request was made with Symbol
+type TickerBookWsResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result []*BookTicker `json:"-"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// UnmarshalJSON decode the result which is an object for one symbol and an array otherwise
+func (r *TickerBookWsResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Id     string           `json:"id"`
+		Status int              `json:"status"`
+		Result json.RawMessage  `json:"result"`
+		Error  *common.APIError `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Id = raw.Id
+	r.Status = raw.Status
+	r.Error = raw.Error
+	r.Result = nil
+	result := bytes.TrimSpace(raw.Result)
+	if len(result) == 0 || bytes.Equal(result, []byte("null")) {
+		return nil
+	}
+	if result[0] == '[' {
+		return json.Unmarshal(result, &r.Result)
+	}
+	ticker := new(BookTicker)
+	if err := json.Unmarshal(result, ticker); err != nil {
+		return err
+	}
+	r.Result = []*BookTicker{ticker}
+	return nil
+}
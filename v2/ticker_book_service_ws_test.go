@@ -0,0 +1,94 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type tickerBookServiceWsTestSuite struct {
+	suite.Suite
+	ctrl   *gomock.Controller
+	client *mock.MockClient
+
+	requestID string
+	service   *TickerBookWsApiService
+	request   *TickerBookWsRequest
+}
+
+func TestTickerBookServiceWs(t *testing.T) {
+	suite.Run(t, new(tickerBookServiceWsTestSuite))
+}
+
+func (s *tickerBookServiceWsTestSuite) SetupTest() {
+	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb097"
+
+	s.ctrl = gomock.NewController(s.T())
+	s.client = mock.NewMockClient(s.ctrl)
+
+	s.service = &TickerBookWsApiService{
+		c: s.client,
+	}
+
+	s.request = NewTickerBookWsRequest().
+		Symbols([]string{"BNBBTC", "BTCUSDT"})
+}
+
+func (s *tickerBookServiceWsTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *tickerBookServiceWsTestSuite) TestTickerBook() {
+	s.client.EXPECT().Write(s.requestID, gomock.Any()).DoAndReturn(func(id string, data []byte) error {
+		s.Contains(string(data), `"method":"ticker.book"`)
+		s.Contains(string(data), `"symbols":["BNBBTC","BTCUSDT"]`)
+		return nil
+	}).Times(1)
+
+	err := s.service.Do(s.requestID, s.request)
+	s.NoError(err)
+}
+
+func (s *tickerBookServiceWsTestSuite) TestTickerBook_EmptyRequestID() {
+	s.client.EXPECT().Write(gomock.Any(), gomock.Any()).Times(0)
+
+	err := s.service.Do("", s.request)
+	s.ErrorIs(err, websocket.ErrorRequestIDNotSet)
+}
+
+func (s *tickerBookServiceWsTestSuite) TestTickerBookSync() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": [
+			{"symbol": "BNBBTC", "bidPrice": "0.01358000", "bidQty": "12.53400000", "askPrice": "0.01358100", "askQty": "17.83700000"},
+			{"symbol": "BTCUSDT", "bidPrice": "23980.49000000", "bidQty": "0.01000000", "askPrice": "23981.31000000", "askQty": "0.01512000"}
+		]
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, s.request)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Id)
+	s.Equal(200, response.Status)
+	s.Require().Len(response.Result, 2)
+	s.Equal("BTCUSDT", response.Result[1].Symbol)
+	s.Equal("0.01512000", response.Result[1].AskQuantity)
+}
+
+func (s *tickerBookServiceWsTestSuite) TestTickerBookSyncSymbol() {
+	rawResponseData := []byte(`{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb097",
+		"status": 200,
+		"result": {"symbol": "BNBBTC", "bidPrice": "0.01358000", "bidQty": "12.53400000", "askPrice": "0.01358100", "askQty": "17.83700000"}
+	}`)
+	s.client.EXPECT().WriteSync(s.requestID, gomock.Any(), gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	response, err := s.service.SyncDo(s.requestID, NewTickerBookWsRequest().Symbol("BNBBTC"))
+	s.Require().NoError(err)
+	s.Require().Len(response.Result, 1)
+	s.Equal("0.01358000", response.Result[0].BidPrice)
+}
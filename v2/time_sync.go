@@ -0,0 +1,98 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// EnableTimeSync sync the client clock with the server and keep it synced every interval
+// in background. The offset is read by the websocket API services of the client when they
+// send a request, and the signed requests and websocket API SyncDo calls failing with -1021
+// are retried once after a re-sync.
+// Call Stop on the returned TimeSync to stop the background sync.
+func (c *Client) EnableTimeSync(ctx context.Context, interval time.Duration) (*common.TimeSync, error) {
+	ts := common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+	if err := ts.Sync(ctx); err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		ts.Start(interval)
+	}
+	c.TimeSync = ts
+	return ts, nil
+}
+
+// timeOffset return the offset applied to the timestamp of signed requests
+func (c *Client) timeOffset() int64 {
+	return c.TimeSync.OffsetOr(c.TimeOffset)
+}
+
+// resyncTime re-sync the clock when a signed request failed with -1021,
+// it returns true when the request should be retried
+func (c *Client) resyncTime(ctx context.Context, r *request, err error) bool {
+	if c.TimeSync == nil || r.secType != secTypeSigned || !common.IsInvalidTimestampError(err) {
+		return false
+	}
+	if serr := c.TimeSync.Sync(ctx); serr != nil {
+		c.debug("failed to sync server time: %s\n", serr)
+		return false
+	}
+	return true
+}
+
+// wsTimeSync read the time offset of the websocket API requests from the client when
+// they are sent, so a TimeSync enabled after the service was created is used
+type wsTimeSync struct {
+	client *Client
+}
+
+// offset return the synced offset of the client, or fallback when the client has no TimeSync
+func (t wsTimeSync) offset(fallback int64) int64 {
+	if t.client == nil {
+		return fallback
+	}
+	return t.client.TimeSync.OffsetOr(fallback)
+}
+
+// writeSync send the request built by build and return its response. When the response is
+// a -1021 error the clock is re-synced, and the request is built again and resent once.
+func (t wsTimeSync) writeSync(c websocket.Client, requestID string, build func() ([]byte, error)) ([]byte, error) {
+	for resynced := false; ; resynced = true {
+		rawData, err := build()
+		if err != nil {
+			return nil, err
+		}
+		response, err := c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+		if err != nil || resynced || !t.resync(response) {
+			return response, err
+		}
+	}
+}
+
+// resync re-sync the clock of the client when response is a -1021 error,
+// it returns true when the request should be sent again
+func (t wsTimeSync) resync(response []byte) bool {
+	if t.client == nil || t.client.TimeSync == nil {
+		return false
+	}
+	var res struct {
+		Error *common.APIError `json:"error"`
+	}
+	if err := json.Unmarshal(response, &res); err != nil || res.Error == nil || !common.IsInvalidTimestampError(res.Error) {
+		return false
+	}
+	// bounded like the request itself, a hung time request would block the connection
+	ctx, cancel := context.WithTimeout(context.Background(), websocket.WriteSyncWsTimeout)
+	defer cancel()
+	if err := t.client.TimeSync.Sync(ctx); err != nil {
+		t.client.debug("failed to sync server time: %s\n", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,141 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type timeSyncTestSuite struct {
+	baseTestSuite
+}
+
+func TestTimeSync(t *testing.T) {
+	suite.Run(t, new(timeSyncTestSuite))
+}
+
+// mockServer serve /api/v3/time with a server clock offset by offset ms and
+// fail the first failures signed requests with -1021
+func (s *timeSyncTestSuite) mockServer(offset *int64, failures int) (timestamps *[]int64) {
+	timestamps = new([]int64)
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/api/v3/time" {
+			data := fmt.Sprintf(`{"serverTime":%d}`, currentTimestamp()-*offset)
+			return newHTTPResponse([]byte(data), http.StatusOK), nil
+		}
+		ts, err := strconv.ParseInt(req.URL.Query().Get(timestampKey), 10, 64)
+		s.r().NoError(err)
+		*timestamps = append(*timestamps, ts)
+		if len(*timestamps) <= failures {
+			data := []byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`)
+			return newHTTPResponse(data, http.StatusBadRequest), nil
+		}
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+	return timestamps
+}
+
+func (s *timeSyncTestSuite) TestEnableTimeSync() {
+	offset := int64(5000)
+	timestamps := s.mockServer(&offset, 0)
+
+	ts, err := s.client.EnableTimeSync(newContext(), time.Hour)
+	s.r().NoError(err)
+	defer ts.Stop()
+	s.r().Equal(ts, s.client.TimeSync)
+	s.r().InDelta(5000, ts.Offset(), 100)
+
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(*timestamps, 1)
+	s.r().InDelta(currentTimestamp()-5000, (*timestamps)[0], 100)
+}
+
+func (s *timeSyncTestSuite) TestRetryInvalidTimestamp() {
+	offset := int64(0)
+	timestamps := s.mockServer(&offset, 1)
+
+	ts, err := s.client.EnableTimeSync(newContext(), 0)
+	s.r().NoError(err)
+	offset = 3000
+
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(*timestamps, 2)
+	s.r().InDelta(3000, ts.Offset(), 100)
+	s.r().InDelta((*timestamps)[0]-3000, (*timestamps)[1], 100)
+}
+
+func (s *timeSyncTestSuite) TestRetryInvalidTimestampOnce() {
+	offset := int64(0)
+	timestamps := s.mockServer(&offset, 2)
+
+	_, err := s.client.EnableTimeSync(newContext(), 0)
+	s.r().NoError(err)
+
+	_, err = s.client.NewGetAccountService().Do(newContext())
+	s.r().True(common.IsInvalidTimestampError(err))
+	s.r().Len(*timestamps, 2)
+}
+
+func (s *timeSyncTestSuite) TestNoRetryWithoutTimeSync() {
+	offset := int64(0)
+	timestamps := s.mockServer(&offset, 1)
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().True(common.IsInvalidTimestampError(err))
+	s.r().Len(*timestamps, 1)
+}
+
+func (s *timeSyncTestSuite) TestWsApiRetryInvalidTimestamp() {
+	offset := int64(5000)
+	s.mockServer(&offset, 0)
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	client := mock.NewMockClient(ctrl)
+	service := &OpenOrdersStatusWsApiService{
+		c:         client,
+		ApiKey:    s.apiKey,
+		SecretKey: s.secretKey,
+		KeyType:   common.KeyTypeHmac,
+		timeSync:  wsTimeSync{s.client.Client},
+	}
+
+	// the time sync enabled after the service was created is used
+	ts, err := s.client.EnableTimeSync(newContext(), 0)
+	s.r().NoError(err)
+
+	var timestamps []int64
+	client.EXPECT().WriteSync("1", gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(id string, data []byte, timeout time.Duration) ([]byte, error) {
+			var req struct {
+				Params struct {
+					Timestamp int64 `json:"timestamp"`
+				} `json:"params"`
+			}
+			s.r().NoError(json.Unmarshal(data, &req))
+			timestamps = append(timestamps, req.Params.Timestamp)
+			if len(timestamps) == 1 {
+				offset = 8000
+				return []byte(`{"id":"1","status":400,"error":{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}}`), nil
+			}
+			return []byte(`{"id":"1","status":200,"result":[]}`), nil
+		})
+
+	res, err := service.SyncDo("1", NewOpenOrdersStatusWsRequest())
+	s.r().NoError(err)
+	s.r().Nil(res.Error)
+	s.r().Len(timestamps, 2)
+	s.r().InDelta(currentTimestamp()-5000, timestamps[0], 100)
+	s.r().InDelta(currentTimestamp()-8000, timestamps[1], 100)
+	s.r().InDelta(8000, ts.Offset(), 100)
+}
@@ -0,0 +1,67 @@
+package binance
+
+import (
+	"context"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// NewUserDataStream init a spot user data stream managing its listen key, see commonws.UserDataStream.
+// The events are delivered to handler once the stream is started.
+func (c *Client) NewUserDataStream(handler WsUserDataHandler, errHandler ErrHandler, opts ...commonws.UserDataStreamOption) *commonws.UserDataStream {
+	return c.newUserDataStream(commonws.ListenKeyService{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+	}, handler, errHandler, opts...)
+}
+
+// NewMarginUserDataStream init a cross margin user data stream managing its listen key
+func (c *Client) NewMarginUserDataStream(handler WsUserDataHandler, errHandler ErrHandler, opts ...commonws.UserDataStreamOption) *commonws.UserDataStream {
+	return c.newUserDataStream(commonws.ListenKeyService{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartMarginUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+	}, handler, errHandler, opts...)
+}
+
+// NewIsolatedMarginUserDataStream init the user data stream of an isolated margin symbol managing its listen key
+func (c *Client) NewIsolatedMarginUserDataStream(symbol string, handler WsUserDataHandler, errHandler ErrHandler, opts ...commonws.UserDataStreamOption) *commonws.UserDataStream {
+	return c.newUserDataStream(commonws.ListenKeyService{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartIsolatedMarginUserStreamService().Symbol(symbol).Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveIsolatedMarginUserStreamService().Symbol(symbol).ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseIsolatedMarginUserStreamService().Symbol(symbol).ListenKey(listenKey).Do(ctx)
+		},
+	}, handler, errHandler, opts...)
+}
+
+func (c *Client) newUserDataStream(listenKeys commonws.ListenKeyService, handler WsUserDataHandler, errHandler ErrHandler, opts ...commonws.UserDataStreamOption) *commonws.UserDataStream {
+	var stream *commonws.UserDataStream
+	serve := func(listenKey string, connErrHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return c.WsUserDataServe(listenKey, func(event *WsUserDataEvent) {
+			if event.Event == UserDataEventTypeListenKeyExpired {
+				stream.Expired()
+			}
+			handler(event)
+		}, connErrHandler)
+	}
+	stream = commonws.NewUserDataStream(listenKeys, serve, errHandler, opts...)
+	return stream
+}
@@ -1,9 +1,14 @@
 package binance
 
 import (
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 )
 
 type userStreamServiceTestSuite struct {
@@ -57,3 +62,53 @@ func (s *userStreamServiceTestSuite) TestCloseUserStream() {
 	err := s.client.NewCloseUserStreamService().ListenKey(listenKey).Do(newContext())
 	s.r().NoError(err)
 }
+
+func (s *userStreamServiceTestSuite) TestUserDataStreamListenKeyExpired() {
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"listenKey":"listenKey1"}`), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{"listenKey":"listenKey2"}`), http.StatusOK), nil).Once()
+	s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(`{}`), http.StatusOK), nil)
+
+	origWsServe := wsServeWithConnHandler
+	defer func() { wsServeWithConnHandler = origWsServe }()
+	var endpoints []string
+	wsServeWithConnHandler = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
+		endpoints = append(endpoints, cfg.Endpoint)
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		if len(endpoints) == 1 {
+			handler([]byte(`{"e":"listenKeyExpired","E":1699596037418,"listenKey":"listenKey1"}`))
+		}
+		return doneC, stopC, nil
+	}
+
+	var events []*WsUserDataEvent
+	resyncC := make(chan error, 1)
+	stream := s.client.NewUserDataStream(func(event *WsUserDataEvent) {
+		events = append(events, event)
+	}, func(err error) {
+		s.r().NoError(err)
+	}, commonws.WithResyncHandler(func(reason error) {
+		resyncC <- reason
+	}))
+	s.r().NoError(stream.Start(newContext()))
+
+	select {
+	case reason := <-resyncC:
+		s.r().ErrorIs(reason, commonws.ErrListenKeyExpired)
+	case <-time.After(5 * time.Second):
+		s.r().FailNow("listen key not renewed")
+	}
+	stream.Stop()
+
+	s.r().Equal("listenKey2", stream.ListenKey())
+	s.r().Len(endpoints, 2)
+	s.r().True(strings.HasSuffix(endpoints[0], "/listenKey1"))
+	s.r().True(strings.HasSuffix(endpoints[1], "/listenKey2"))
+	s.r().Len(events, 1)
+	s.r().Equal(UserDataEventTypeListenKeyExpired, events[0].Event)
+}
@@ -7,6 +7,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/adshao/go-binance/v2/common/streamrec"
+	commonws "github.com/adshao/go-binance/v2/common/websocket"
 	"github.com/gorilla/websocket"
 )
 
@@ -21,6 +23,10 @@ type WsConfig struct {
 	Endpoint string
 	Header   http.Header
 	Proxy    *string
+	// Recorder records the messages of the stream when set
+	Recorder *streamrec.Recorder
+	// Replayer replaces the connection of the stream when set
+	Replayer *streamrec.Replayer
 }
 
 func newWsConfig(endpoint string, proxy *string) *WsConfig {
@@ -31,20 +37,56 @@ func newWsConfig(endpoint string, proxy *string) *WsConfig {
 	}
 }
 
+// wsConfig init the configuration of a stream of the client, recorded or replayed when
+// StreamRecorder or StreamReplayer is set
+func (c *Client) wsConfig(endpoint string) *WsConfig {
+	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg.Recorder = c.StreamRecorder
+	cfg.Replayer = c.StreamReplayer
+	return cfg
+}
+
+var (
+	// WebsocketAutoReconnect makes the market and user data streams reconnect with backoff instead
+	// of closing doneC when their connection ends. The error handler then receives a
+	// commonws.DisconnectedEvent and a commonws.ReconnectedEvent, after which consumers keeping
+	// a state from the stream should resync.
+	WebsocketAutoReconnect = false
+	// WebsocketMaxConnectionAge is the age after which the connection of a stream is replaced
+	// if WebsocketAutoReconnect is enabled, before the server closes it after 24 hours
+	WebsocketMaxConnectionAge = commonws.MaxConnectionAge
+)
+
 func wsServe(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	return wsServeWithConnHandler(cfg, handler, errHandler, func(ctx context.Context, c *websocket.Conn) {
+	connHandler := func(ctx context.Context, c *websocket.Conn) {
 		if WebsocketKeepalive {
 			// This function overwrites the default ping frame handler
 			// sent by the websocket API server
 			keepAliveWithPong(ctx, c, WebsocketTimeout)
 		}
-	})
+	}
+	if cfg.Replayer != nil {
+		return cfg.Replayer.Serve(cfg.Endpoint, handler, errHandler)
+	}
+	if WebsocketAutoReconnect {
+		serve := func(connErrHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return wsServeWithConnHandler(cfg, handler, connErrHandler, connHandler)
+		}
+		return commonws.ServeResilient(serve, errHandler, WebsocketMaxConnectionAge)
+	}
+	return wsServeWithConnHandler(cfg, handler, errHandler, connHandler)
 }
 
 type ConnHandler func(context.Context, *websocket.Conn)
 
 // WsServeWithConnHandler serves websocket with custom connection handler, useful for custom keepalive
 var wsServeWithConnHandler = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler, connHandler ConnHandler) (doneC, stopC chan struct{}, err error) {
+	if cfg.Replayer != nil {
+		return cfg.Replayer.Serve(cfg.Endpoint, handler, errHandler)
+	}
+	if cfg.Recorder != nil {
+		handler = cfg.Recorder.Handler(cfg.Endpoint, handler)
+	}
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
 		u, err := url.Parse(*cfg.Proxy)
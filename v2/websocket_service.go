@@ -63,7 +63,7 @@ func (c *Client) WsPartialDepthServe100Ms(symbol string, levels string, handler
 
 // WsPartialDepthServe serve websocket partial depth handler with a symbol
 func (c *Client) wsPartialDepthServe(endpoint string, symbol string, handler WsPartialDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -103,7 +103,7 @@ func (c *Client) WsCombinedPartialDepthServe(symbolLevels map[string]string, han
 		endpoint += fmt.Sprintf("%s@depth%s", strings.ToLower(s), l) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -157,42 +157,51 @@ func (c *Client) WsDepthServe100Ms(symbol string, handler WsDepthHandler, errHan
 
 // WsDepthServe serve websocket depth handler with an arbitrary endpoint address
 func (c *Client) wsDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
-		j, err := newJSON(message)
+		event, err := parseWsDepthEvent(message)
 		if err != nil {
 			errHandler(err)
 			return
 		}
-		event := new(WsDepthEvent)
-		event.Event = j.Get("e").MustString()
-		event.Time = j.Get("E").MustInt64()
-		event.Symbol = j.Get("s").MustString()
-		event.LastUpdateID = j.Get("u").MustInt64()
-		event.FirstUpdateID = j.Get("U").MustInt64()
-		bidsLen := len(j.Get("b").MustArray())
-		event.Bids = make([]Bid, bidsLen)
-		for i := 0; i < bidsLen; i++ {
-			item := j.Get("b").GetIndex(i)
-			event.Bids[i] = Bid{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
-		asksLen := len(j.Get("a").MustArray())
-		event.Asks = make([]Ask, asksLen)
-		for i := 0; i < asksLen; i++ {
-			item := j.Get("a").GetIndex(i)
-			event.Asks[i] = Ask{
-				Price:    item.GetIndex(0).MustString(),
-				Quantity: item.GetIndex(1).MustString(),
-			}
-		}
 		handler(event)
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
 
+// parseWsDepthEvent parse the message of a depth stream
+func parseWsDepthEvent(message []byte) (*WsDepthEvent, error) {
+	j, err := newJSON(message)
+	if err != nil {
+		return nil, err
+	}
+	event := new(WsDepthEvent)
+	event.Event = j.Get("e").MustString()
+	event.Time = j.Get("E").MustInt64()
+	event.Symbol = j.Get("s").MustString()
+	event.LastUpdateID = j.Get("u").MustInt64()
+	event.FirstUpdateID = j.Get("U").MustInt64()
+	bidsLen := len(j.Get("b").MustArray())
+	event.Bids = make([]Bid, bidsLen)
+	for i := 0; i < bidsLen; i++ {
+		item := j.Get("b").GetIndex(i)
+		event.Bids[i] = Bid{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	asksLen := len(j.Get("a").MustArray())
+	event.Asks = make([]Ask, asksLen)
+	for i := 0; i < asksLen; i++ {
+		item := j.Get("a").GetIndex(i)
+		event.Asks[i] = Ask{
+			Price:    item.GetIndex(0).MustString(),
+			Quantity: item.GetIndex(1).MustString(),
+		}
+	}
+	return event, nil
+}
+
 // WsDepthEvent define websocket depth event
 type WsDepthEvent struct {
 	Event         string `json:"e"`
@@ -224,7 +233,7 @@ func (c *Client) WsCombinedDepthServe100Ms(symbols []string, handler WsDepthHand
 }
 
 func (c *Client) wsCombinedDepthServe(endpoint string, handler WsDepthHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -273,7 +282,7 @@ func (c *Client) WsCombinedKlineServe(symbolIntervalPair map[string]string, hand
 		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -310,7 +319,7 @@ func (c *Client) WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]
 		}
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -341,7 +350,7 @@ func (c *Client) WsCombinedKlineServeMultiInterval(symbolIntervals map[string][]
 // WsKlineServe serve websocket kline handler with a symbol and interval like 15m, 30s
 func (c *Client) WsKlineServe(symbol string, interval string, handler WsKlineHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@kline_%s", c.getWsEndpoint(), strings.ToLower(symbol), interval)
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsKlineEvent)
 		err := json.Unmarshal(message, event)
@@ -388,7 +397,7 @@ type WsAggTradeHandler func(event *WsAggTradeEvent)
 // WsAggTradeServe serve websocket aggregate handler with a symbol
 func (c *Client) WsAggTradeServe(symbol string, handler WsAggTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@aggTrade", c.getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsAggTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -408,7 +417,7 @@ func (c *Client) WsCombinedAggTradeServe(symbols []string, handler WsAggTradeHan
 		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -461,7 +470,7 @@ type (
 // WsTradeServe serve websocket handler with a symbol
 func (c *Client) WsTradeServe(symbol string, handler WsTradeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@trade", c.getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -480,7 +489,7 @@ func (c *Client) WsCombinedTradeServe(symbols []string, handler WsCombinedTradeH
 		endpoint += fmt.Sprintf("%s@trade/", strings.ToLower(s))
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedTradeEvent)
 		err := json.Unmarshal(message, event)
@@ -631,7 +640,7 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 // Deprecated: Listen key management is deprecated. Use WsUserDataServeSignature instead.
 func (c *Client) WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", c.getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -698,7 +707,7 @@ func (c *Client) WsUserDataServeSignature(handler WsUserDataHandler, errHandler
 		uuid.New().String(),
 		c.APIKey,
 		c.SecretKey,
-		c.TimeOffset,
+		c.timeOffset(),
 		c.KeyType,
 	)
 	subscribeRequest, err := websocket.CreateRequest(
@@ -894,7 +903,7 @@ func (c *Client) WsCombinedMarketStatServe(symbols []string, handler WsMarketSta
 		endpoint += fmt.Sprintf("%s@ticker", strings.ToLower(symbols[s])) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
@@ -927,7 +936,7 @@ func (c *Client) WsCombinedMarketStatServe(symbols []string, handler WsMarketSta
 // WsMarketStatServe serve websocket that push 24hr statistics for single market every second
 func (c *Client) WsMarketStatServe(symbol string, handler WsMarketStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@ticker", c.getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsMarketStatEvent
 		err := json.Unmarshal(message, &event)
@@ -946,7 +955,7 @@ type WsAllMarketsStatHandler func(event WsAllMarketsStatEvent)
 // WsAllMarketsStatServe serve websocket that push 24hr statistics for all market every second
 func (c *Client) WsAllMarketsStatServe(handler WsAllMarketsStatHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!ticker@arr", c.getWsEndpoint())
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -995,7 +1004,7 @@ type WsAllMiniMarketsStatServeHandler func(event WsAllMiniMarketsStatEvent)
 // WsAllMiniMarketsStatServe serve websocket that push mini version of 24hr statistics for all market every second
 func (c *Client) WsAllMiniMarketsStatServe(handler WsAllMiniMarketsStatServeHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!miniTicker@arr", c.getWsEndpoint())
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		var event WsAllMiniMarketsStatEvent
 		err := json.Unmarshal(message, &event)
@@ -1045,7 +1054,7 @@ type WsBookTickerHandler func(event *WsBookTickerEvent)
 // WsBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for a specified symbol.
 func (c *Client) WsBookTickerServe(symbol string, handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s@bookTicker", c.getWsEndpoint(), strings.ToLower(symbol))
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -1065,7 +1074,7 @@ func (c *Client) WsCombinedBookTickerServe(symbols []string, handler WsBookTicke
 		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(s)) + "/"
 	}
 	endpoint = endpoint[:len(endpoint)-1]
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsCombinedBookTickerEvent)
 		err := json.Unmarshal(message, event)
@@ -1081,7 +1090,7 @@ func (c *Client) WsCombinedBookTickerServe(symbols []string, handler WsBookTicke
 // WsAllBookTickerServe serve websocket that pushes updates to the best bid or ask price or quantity in real-time for all symbols.
 func (c *Client) WsAllBookTickerServe(handler WsBookTickerHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/!bookTicker", c.getWsEndpoint())
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	wsHandler := func(message []byte) {
 		event := new(WsBookTickerEvent)
 		err := json.Unmarshal(message, &event)
@@ -1105,6 +1114,60 @@ func (c *Client) WsApiInitReadWriteConn() (*gorilla.Conn, error) {
 	return conn, err
 }
 
+// EnableWsApiConnection open a websocket API connection shared by the websocket API
+// services created from the client afterwards, so that all the requests are sent over
+// one connection. The responses of SyncDo are routed to their request by id, so services can
+// call it in parallel, and the responses of Do are read from the read channel of the returned
+// client. Close the returned client to close the connection.
+func (c *Client) EnableWsApiConnection() (websocket.Client, error) {
+	client, err := c.newWsApiConn()
+	if err != nil {
+		return nil, err
+	}
+	c.WsApiClient = client
+	return client, nil
+}
+
+// EnableWsApiSession authenticate the shared websocket API connection with 'session.logon',
+// opening it if EnableWsApiConnection was not called. The requests of the websocket API
+// services created from the client afterwards are then sent without signature. It requires
+// an Ed25519 key, the session logs on again after the connection was restored.
+func (c *Client) EnableWsApiSession() (*websocket.Session, error) {
+	if session, ok := c.WsApiClient.(*websocket.Session); ok {
+		return session, nil
+	}
+	client, err := c.newWsApiClient()
+	if err != nil {
+		return nil, err
+	}
+	session, err := websocket.NewSession(client, c.APIKey, c.SecretKey, c.KeyType, c.timeOffset)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := session.Logon(); err != nil {
+		return nil, err
+	}
+	c.WsApiClient = session
+	return session, nil
+}
+
+// newWsApiClient return the shared websocket API connection if any, or open a new one
+func (c *Client) newWsApiClient() (websocket.Client, error) {
+	if c.WsApiClient != nil {
+		return c.WsApiClient, nil
+	}
+	return c.newWsApiConn()
+}
+
+func (c *Client) newWsApiConn() (websocket.Client, error) {
+	conn, err := websocket.NewConnection(c.WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	return websocket.NewClient(conn)
+}
+
 type WsAnnouncementEvent struct {
 	CatalogID   int64  `json:"catalogId"`
 	CatalogName string `json:"catalogName"`
@@ -1139,14 +1202,17 @@ type WsAnnouncementHandler func(event *WsAnnouncementEvent)
 //	stopC - Channel that can be closed to stop the connection
 //	err - Any initial connection error
 func (c *Client) WsAnnouncementServe(params WsAnnouncementParam, handler WsAnnouncementHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	if c.UseTestnet || c.UseDemo {
+	base := BaseWsAnnouncementURL
+	if c.Endpoints != nil && c.Endpoints.Announcement != "" {
+		base = c.Endpoints.Announcement
+	} else if c.UseTestnet || c.UseDemo {
 		return nil, nil, errors.New("testnet or demo is not supported")
 	}
 	endpoint := fmt.Sprintf("%s?random=%s&topic=%s&recvWindow=%d&timestamp=%d&signature=%s",
-		BaseWsAnnouncementURL, params.Random, params.Topic, params.RecvWindow, params.Timestamp, params.Signature,
+		base, params.Random, params.Topic, params.RecvWindow, params.Timestamp, params.Signature,
 	)
 
-	cfg := newWsConfig(endpoint, c.getProxyUrl())
+	cfg := c.wsConfig(endpoint)
 	cfg.Header.Set("X-MBX-APIKEY", params.ApiKey)
 	wsHandler := func(message []byte) {
 		event := struct {